@@ -0,0 +1,40 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package confluence
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/internal/cli"
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+// NewCommand returns a new cobra.Command for 'confluence' formatter
+func NewCommand(runtime *cli.Runtime, config *print.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:        cobra.ExactArgs(1),
+		Use:         "confluence [PATH]",
+		Short:       "Generate Confluence Storage Format of inputs and outputs",
+		Annotations: cli.Annotations("confluence"),
+		PreRunE:     runtime.PreRunEFunc,
+		RunE:        runtime.RunEFunc,
+	}
+
+	// flags
+	cmd.PersistentFlags().BoolVar(&config.Settings.Default, "default", true, "show Default column")
+	cmd.PersistentFlags().BoolVar(&config.Settings.HideEmpty, "hide-empty", false, "hide empty sections (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Required, "required", true, "show Required column")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Sensitive, "sensitive", true, "show Sensitive column")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Type, "type", true, "show Type column")
+	cmd.PersistentFlags().StringVar(&config.Settings.ConfluenceTableStyle, "confluence-table-style", "default", "style of tables ["+print.ConfluenceTableStyles+"]")
+
+	return cmd
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package html
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/internal/cli"
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+// NewCommand returns a new cobra.Command for 'html' formatter
+func NewCommand(runtime *cli.Runtime, config *print.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:        cobra.ExactArgs(1),
+		Use:         "html [PATH]",
+		Short:       "Generate HTML of inputs and outputs",
+		Annotations: cli.Annotations("html"),
+		PreRunE:     runtime.PreRunEFunc,
+		RunE:        runtime.RunEFunc,
+	}
+
+	// flags
+	cmd.PersistentFlags().BoolVar(&config.Settings.DarkMode, "with-dark-mode", false, "include colors of dark color scheme in stylesheet of standalone page (default false)")
+	cmd.PersistentFlags().StringVar(&config.Settings.DarkModeCSSFile, "dark-mode-css-file", "", "relative path of file containing CSS rules of '--with-dark-mode' instead of the default palette")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Default, "default", true, "show Default column")
+	cmd.PersistentFlags().BoolVar(&config.Settings.HideEmpty, "hide-empty", false, "hide empty sections (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.InputTablePagination, "with-input-table-pagination", false, "paginate Inputs table by inline JavaScript if it has more rows than '--pagination-size' in standalone page (default false)")
+	cmd.PersistentFlags().IntVar(&config.Settings.PaginationSize, "pagination-size", 20, "number of rows per page of Inputs table of '--with-input-table-pagination'")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Required, "required", true, "show Required column")
+	cmd.PersistentFlags().BoolVar(&config.Settings.SearchableTable, "with-searchable-table", false, "embed search box filtering Inputs table by inline JavaScript in standalone page (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Sensitive, "sensitive", true, "show Sensitive column")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Type, "type", true, "show Type column")
+	cmd.PersistentFlags().StringVar(&config.Settings.HTMLMode, "html-mode", "standalone", "mode of html ["+print.HTMLModes+"]")
+
+	return cmd
+}
@@ -29,6 +29,7 @@ func NewCommand(runtime *cli.Runtime, config *print.Config) *cobra.Command {
 	}
 
 	// flags
+	cmd.PersistentFlags().BoolVar(&config.Settings.HideEmpty, "hide-empty", false, "hide empty sections (default false)")
 	cmd.PersistentFlags().BoolVar(&config.Settings.Escape, "escape", true, "escape special characters")
 
 	return cmd
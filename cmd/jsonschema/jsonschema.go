@@ -0,0 +1,36 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package jsonschema
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/internal/cli"
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+// NewCommand returns a new cobra.Command for 'jsonschema' formatter
+func NewCommand(runtime *cli.Runtime, config *print.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:        cobra.ExactArgs(1),
+		Use:         "jsonschema [PATH]",
+		Short:       "Generate JSON Schema of inputs",
+		Long:        "Generate JSON Schema (draft 2020-12) of inputs, including the constraints of their validation blocks",
+		Annotations: cli.Annotations("jsonschema"),
+		PreRunE:     runtime.PreRunEFunc,
+		RunE:        runtime.RunEFunc,
+	}
+
+	// flags
+	cmd.PersistentFlags().BoolVar(&config.Settings.RequiredJSONSchemaProperties, "with-required-json-schema-properties", true, "always emit required properties, even if empty")
+
+	return cmd
+}
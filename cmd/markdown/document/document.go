@@ -28,5 +28,9 @@ func NewCommand(runtime *cli.Runtime, config *print.Config) *cobra.Command {
 		PreRunE:     runtime.PreRunEFunc,
 		RunE:        runtime.RunEFunc,
 	}
+
+	// flags
+	cmd.PersistentFlags().BoolVar(&config.Settings.Compact, "compact", false, "suppress blank lines between entries (default false)")
+
 	return cmd
 }
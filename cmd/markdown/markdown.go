@@ -33,14 +33,40 @@ func NewCommand(runtime *cli.Runtime, config *print.Config) *cobra.Command {
 
 	// flags
 	cmd.PersistentFlags().BoolVar(&config.Settings.Anchor, "anchor", true, "create anchor links")
+	cmd.PersistentFlags().BoolVar(&config.Settings.ASCIITypeDiagrams, "with-ascii-type-diagrams", false, "render complex object types of inputs as ASCII tree diagrams (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.AzureDevOpsWiki, "with-azure-devops-wiki", false, "generate Markdown compatible with Azure DevOps Wiki (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.DarkMode, "with-dark-mode", false, "include stylesheet of tables and code blocks for dark color scheme in HTML mode (default false)")
+	cmd.PersistentFlags().StringVar(&config.Settings.DarkModeCSSFile, "dark-mode-css-file", "", "relative path of file containing CSS rules of '--with-dark-mode' instead of the default palette")
 	cmd.PersistentFlags().BoolVar(&config.Settings.Default, "default", true, "show Default column or section")
 	cmd.PersistentFlags().BoolVar(&config.Settings.Escape, "escape", true, "escape special characters")
+	cmd.PersistentFlags().StringVar(&config.Settings.EscapedPipes, "with-escaped-pipes", "github", "escape pipes in tables for Markdown renderer ["+print.EscapedPipes+"]")
+	cmd.PersistentFlags().BoolVar(&config.Settings.ExternalLinks, "with-external-links", false, "link Git sources of module calls to their repository and local sources to their path (default false)")
+	cmd.PersistentFlags().StringVar(&config.Settings.InputCardinality, "with-input-cardinality", "", "include cardinality of inputs in their type in given notation ["+print.CardinalityNotations+"]")
+	cmd.PersistentFlags().StringVar(&config.Settings.GraphFormat, "with-graph-format", "", "include dependency graph of the module in given format ["+print.GraphFormats+"]")
+	cmd.PersistentFlags().BoolVar(&config.Settings.HCLExamples, "with-hcl-examples", false, "show Quick Start example of calling the module with its required inputs (default false)")
 	cmd.PersistentFlags().BoolVar(&config.Settings.HTML, "html", true, "use HTML tags in genereted output")
 	cmd.PersistentFlags().BoolVar(&config.Settings.HideEmpty, "hide-empty", false, "hide empty sections (default false)")
 	cmd.PersistentFlags().IntVar(&config.Settings.Indent, "indent", 2, "indention level of Markdown sections [1, 2, 3, 4, 5]")
+	cmd.PersistentFlags().IntVar(&config.Settings.IndentationLevel, "indentation-level", 2, "indentation level of Markdown section headers [1, 2, 3, 4, 5, 6]")
+	cmd.PersistentFlags().BoolVar(&config.Settings.MarkdownLint, "with-markdown-lint", false, "lint generated content by markdownlint and print violations to stderr (default false)")
+	cmd.PersistentFlags().BoolVar(&config.FailOn.MarkdownLint, "strict", false, "exit with code 2 if generated content has violations of '--with-markdown-lint' (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.ModuleCallGraph, "with-module-call-graph", false, "include tree of nested module calls of local sources as Mermaid diagram (default false)")
+	cmd.PersistentFlags().IntVar(&config.Settings.CallGraphDepth, "call-graph-depth", 3, "maximum depth of tree of nested module calls of '--with-module-call-graph'")
+	cmd.PersistentFlags().BoolVar(&config.Settings.InputHCL, "with-input-hcl", false, "show collapsible terraform.tfvars snippet of each input (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.InputTablePagination, "with-input-table-pagination", false, "paginate Inputs table by inline JavaScript if it has more rows than '--pagination-size' in HTML mode (default false)")
+	cmd.PersistentFlags().IntVar(&config.Settings.PaginationSize, "pagination-size", 20, "number of rows per page of Inputs table of '--with-input-table-pagination'")
+	cmd.PersistentFlags().BoolVar(&config.Settings.ProviderSourceNamespace, "with-provider-source-namespace", false, "mark official providers of HashiCorp and show namespace of community providers (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.ProviderVersionBadges, "with-provider-version-badges", false, "show badge of version constraint of each provider (default false)")
 	cmd.PersistentFlags().BoolVar(&config.Settings.Required, "required", true, "show Required column or section")
+	cmd.PersistentFlags().BoolVar(&config.Settings.RequiredVersionBadge, "with-required-version-badge", false, "show badge of required version of Terraform (default false)")
 	cmd.PersistentFlags().BoolVar(&config.Settings.Sensitive, "sensitive", true, "show Sensitive column or section")
+	cmd.PersistentFlags().BoolVar(&config.Settings.SearchableTable, "with-searchable-table", false, "embed search box filtering Inputs table by inline JavaScript in HTML mode (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.SectionSeparators, "with-section-separators", false, "insert horizontal rules between sections (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.ShowLifecycleConditions, "show-lifecycle-conditions", false, "show preconditions and postconditions of outputs (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.ShowDefaultsType, "with-show-defaults-type", false, "show whether default of inputs is literal, expression or null (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.TableOfContents, "with-table-of-contents", false, "show table of contents linking to sections, inputs and outputs (default false)")
 	cmd.PersistentFlags().BoolVar(&config.Settings.Type, "type", true, "show Type column or section")
+	cmd.PersistentFlags().BoolVar(&config.Settings.VariableExampleBlock, "with-variable-example-block", false, "show example variables.tf block of inputs (default false)")
 
 	// subcommands
 	cmd.AddCommand(document.NewCommand(runtime, config))
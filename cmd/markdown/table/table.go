@@ -11,6 +11,8 @@ the root directory of this source tree.
 package table
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
 
 	"github.com/terraform-docs/terraform-docs/internal/cli"
@@ -25,8 +27,25 @@ func NewCommand(runtime *cli.Runtime, config *print.Config) *cobra.Command {
 		Aliases:     []string{"tbl"},
 		Short:       "Generate Markdown tables of inputs and outputs",
 		Annotations: cli.Annotations("markdown table"),
-		PreRunE:     runtime.PreRunEFunc,
 		RunE:        runtime.RunEFunc,
 	}
+
+	// Name column is always visible, flag only exists for the sake of consistency
+	showName := true
+
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if !showName {
+			return fmt.Errorf("value of '--show-column-name' can't be false, Name column is always visible")
+		}
+		return runtime.PreRunEFunc(cmd, args)
+	}
+
+	// flags
+	cmd.PersistentFlags().BoolVar(&showName, "show-column-name", true, "show Name column")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Default, "show-column-default", true, "show Default column")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Required, "show-column-required", true, "show Required column")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Sensitive, "show-column-sensitive", true, "show Sensitive column")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Type, "show-column-type", true, "show Type column")
+
 	return cmd
 }
@@ -30,6 +30,7 @@ func NewCommand(runtime *cli.Runtime, config *print.Config) *cobra.Command {
 
 	// flags
 	cmd.PersistentFlags().BoolVar(&config.Settings.Color, "color", true, "colorize printed result")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Compact, "compact", false, "suppress blank lines between entries (default false)")
 
 	return cmd
 }
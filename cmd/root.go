@@ -18,7 +18,10 @@ import (
 
 	"github.com/terraform-docs/terraform-docs/cmd/asciidoc"
 	"github.com/terraform-docs/terraform-docs/cmd/completion"
+	"github.com/terraform-docs/terraform-docs/cmd/confluence"
+	"github.com/terraform-docs/terraform-docs/cmd/html"
 	"github.com/terraform-docs/terraform-docs/cmd/json"
+	"github.com/terraform-docs/terraform-docs/cmd/jsonschema"
 	"github.com/terraform-docs/terraform-docs/cmd/markdown"
 	"github.com/terraform-docs/terraform-docs/cmd/pretty"
 	"github.com/terraform-docs/terraform-docs/cmd/tfvars"
@@ -44,6 +47,7 @@ func Execute() error {
 // NewCommand returns a new cobra.Command for 'root' command
 func NewCommand() *cobra.Command {
 	config := print.DefaultConfig()
+	config.Cache.Enabled = true
 	runtime := cli.NewRuntime(config)
 	cmd := &cobra.Command{
 		Args:          cobra.MaximumNArgs(1),
@@ -62,6 +66,8 @@ func NewCommand() *cobra.Command {
 	cmd.PersistentFlags().StringVarP(&config.File, "config", "c", ".terraform-docs.yml", "config file name")
 	cmd.PersistentFlags().BoolVar(&config.Recursive.Enabled, "recursive", false, "update submodules recursively (default false)")
 	cmd.PersistentFlags().StringVar(&config.Recursive.Path, "recursive-path", "modules", "submodules path to recursively update")
+	cmd.PersistentFlags().BoolVar(&config.Recursive.ModuleMap, "with-module-map", false, "generate overview document of submodules with '--recursive' (default false)")
+	cmd.PersistentFlags().IntVar(&config.Recursive.Parallelism, "parallelism", 1, "number of submodules to process concurrently with '--recursive'")
 
 	cmd.PersistentFlags().StringSliceVar(&config.Sections.Show, "show", []string{}, "show section ["+print.AllSections+"]")
 	cmd.PersistentFlags().StringSliceVar(&config.Sections.Hide, "hide", []string{}, "hide section ["+print.AllSections+"]")
@@ -69,24 +75,103 @@ func NewCommand() *cobra.Command {
 	cmd.PersistentFlags().StringVar(&config.Output.File, "output-file", "", "file path to insert output into (default \"\")")
 	cmd.PersistentFlags().StringVar(&config.Output.Mode, "output-mode", "inject", "output to file method ["+print.OutputModes+"]")
 	cmd.PersistentFlags().StringVar(&config.Output.Template, "output-template", print.OutputTemplate, "output template")
+	cmd.PersistentFlags().StringVar(&config.Output.TemplateFile, "output-template-file", "", "path of a Go template file to render the whole output file with (default \"\")")
+	cmd.PersistentFlags().StringVar(&config.Output.Notion, "output-notion", "", "ID of Notion page to replace its content with output, using NOTION_TOKEN (default \"\")")
 	cmd.PersistentFlags().BoolVar(&config.Output.Check, "output-check", false, "check if content of output file is up to date (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Output.DryRun, "with-dry-run", false, "print files which would be written without writing them (default false)")
 
 	cmd.PersistentFlags().BoolVar(&config.Sort.Enabled, "sort", true, "sort items")
 	cmd.PersistentFlags().StringVar(&config.Sort.By, "sort-by", "name", "sort items by criteria ["+print.SortTypes+"]")
+	cmd.PersistentFlags().Bool("with-sorted-providers", false, "sort providers by name, even if sorting is disabled (default false)")
+	cmd.PersistentFlags().Bool("with-sorted-requirements", false, "sort requirements by name, including terraform (default false)")
+	cmd.PersistentFlags().StringSliceVar(&config.Sort.PinnedBottomVariables, "with-pinned-variables", []string{}, "inputs to always show at the bottom regardless of sorting (default [])")
 
 	cmd.PersistentFlags().StringVar(&config.HeaderFrom, "header-from", "main.tf", "relative path of a file to read header from")
 	cmd.PersistentFlags().StringVar(&config.FooterFrom, "footer-from", "", "relative path of a file to read footer from (default \"\")")
+	cmd.PersistentFlags().StringVar(&config.ContentFrom, "with-readme-template", "", "path of a Go template file to render the whole content with (default \"\")")
+	cmd.PersistentFlags().StringVar(&config.DeprecationsFrom, "with-module-deprecations-file", "", "relative path of a file to read deprecations of inputs from, e.g. DEPRECATIONS.md (default \"\")")
+	cmd.PersistentFlags().StringSliceVar(&config.VarFileDefaults, "with-var-file-defaults", []string{}, "relative paths of tfvars files to show their merged values as Env Default of inputs, later ones override earlier ones")
 
+	cmd.PersistentFlags().StringVar(&config.TerraformVersion, "terraform-version", "", "target version of Terraform, blocks of newer versions are not parsed (default \"\")")
 	cmd.PersistentFlags().BoolVar(&config.Settings.LockFile, "lockfile", true, "read .terraform.lock.hcl if exist")
+	cmd.PersistentFlags().BoolVar(&config.Settings.AutoDetectRegions, "with-auto-detect-regions", false, "annotate inputs which are region of AWS, GCP or Azure (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.CostEstimate, "with-cost-estimate", false, "include monthly cost estimate of the module by infracost (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.ExampleOutputs, "with-example-outputs", false, "include output values of initialized examples by terraform output (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.ExamplesRunner, "with-examples-runner", false, "include validation status of examples by terraform validate (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Gitignore, "with-gitignore", false, "add path of '--output-file' to .gitignore of the repository if missing (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.GruntworkLinks, "with-gruntwork-links", false, "link sources of module calls to their documentation by source-link-templates (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.HelmValuesOutput, "with-helm-values-output", false, "write outputs matching '--helm-values-pattern' as helm-values.yaml in the module root (default false)")
+	cmd.PersistentFlags().StringVar(&config.Settings.HelmValuesPattern, "helm-values-pattern", ".*", "regular expression of names of outputs to include in helm-values.yaml")
+	cmd.PersistentFlags().BoolVar(&config.Settings.InputHistory, "with-input-history", false, "include previous descriptions of inputs from git history (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.License, "with-license", false, "read license type of the module from LICENSE if exist (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.ModuleInputDefaultsFile, "with-module-input-defaults-file", false, "write default values of optional inputs as defaults.auto.tfvars in the module root (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.ModulePurpose, "with-module-purpose", false, "include first sentence of the header as purpose of the module (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.OutputConsumers, "with-output-consumers", false, "include sibling modules which consume each output through their module calls (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.OutputDeprecation, "with-output-deprecation", false, "read deprecation of outputs from '@deprecated' annotation of their comment (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.OutputValueType, "with-output-value-type", false, "include type of outputs inferred from their value expression (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.ProviderSourceVersionMatrix, "with-provider-source-version-matrix", false, "read compatibility_matrix.yml if exist (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.RunTests, "with-run-tests", false, "run terraform test and include pass/fail counts of the tests (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.TagPolicy, "with-tag-policy", false, "check tags of resources against required tags of tag_policy.yml if exist (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.TfsecResults, "with-tfsec-results", false, "run tfsec and include its security findings of the module (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.TftestExamples, "with-tftest-examples", false, "read run blocks of tests/*.tftest.hcl as usage examples (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.VariableExport, "with-variable-export", false, "write inputs of the module as variables_export.json next to the docs (default false)")
 
 	cmd.PersistentFlags().BoolVar(&config.OutputValues.Enabled, "output-values", false, "inject output values into outputs (default false)")
 	cmd.PersistentFlags().StringVar(&config.OutputValues.From, "output-values-from", "", "inject output values from file into outputs (default \"\")")
 
+	cmd.PersistentFlags().Bool("no-cache", false, "don't use cache of loaded modules (default false)")
+	cmd.PersistentFlags().StringVar(&config.Cache.Dir, "cache-dir", "", "directory of cache of loaded modules (default \"$XDG_CACHE_HOME/terraform-docs\")")
+
+	cmd.PersistentFlags().BoolVar(&config.Changelog.Enabled, "with-changelog-file", false, "include content of CHANGELOG.md of the module as Changelog section (default false)")
+	cmd.PersistentFlags().IntVar(&config.Changelog.Versions, "changelog-version", 0, "number of the most recent versions of changelog to include, 0 for all")
+
+	cmd.PersistentFlags().BoolVar(&config.ConfigLink.Enabled, "with-tfdocs-config-link", false, "include link to the config file the docs are generated with (default false)")
+	cmd.PersistentFlags().StringVar(&config.ConfigLink.RepoURL, "repo-url", "", "base URL of the repository to link the config file in")
+
+	cmd.PersistentFlags().StringSliceVar(&config.Exclude.Variables, "exclude-variable", []string{}, "glob patterns of names of inputs to exclude, can be repeated (default [])")
+	cmd.PersistentFlags().StringSliceVar(&config.Exclude.Outputs, "exclude-output", []string{}, "glob patterns of names of outputs to exclude, can be repeated (default [])")
+	cmd.PersistentFlags().StringVar(&config.Exclude.File, "exclude-file", "", "relative path of a YAML file to read patterns of inputs and outputs to exclude from (default \"\")")
+
+	cmd.PersistentFlags().BoolVar(&config.HealthScore.Enabled, "with-module-health-score", false, "include documentation completeness score of the module (default false)")
+
+	cmd.PersistentFlags().BoolVar(&config.Registry.Enabled, "with-module-registry-link", false, "include badge and link to the module in the Terraform Registry (default false)")
+	cmd.PersistentFlags().StringVar(&config.Registry.Namespace, "registry-namespace", "", "namespace of the module in the Terraform Registry")
+	cmd.PersistentFlags().StringVar(&config.Registry.Name, "registry-name", "", "name of the module in the Terraform Registry")
+	cmd.PersistentFlags().StringVar(&config.Registry.Provider, "registry-provider", "", "provider of the module in the Terraform Registry")
+
+	cmd.PersistentFlags().BoolVar(&config.Sensitive.RedactDefaults, "with-sensitive-defaults-redacted", false, "replace default values of inputs matching sensitive patterns with [REDACTED] (default false)")
+	cmd.PersistentFlags().StringSliceVar(&config.Sensitive.Patterns, "sensitive-patterns", []string{}, "regular expressions of names or default values of sensitive inputs (default built-in patterns)")
+
+	cmd.PersistentFlags().BoolVar(&config.Experimental.OpenTelemetry, "with-experimental-opentelemetry", false, "emit OpenTelemetry traces of generating the content to OTEL_EXPORTER_OTLP_ENDPOINT (default false)")
+
+	cmd.PersistentFlags().BoolVar(&config.FailOn.UndocumentedInputs, "fail-on-undocumented-inputs", false, "exit with code 2 if any input has no description (default false)")
+	cmd.PersistentFlags().BoolVar(&config.FailOn.UndocumentedOutputs, "fail-on-undocumented-outputs", false, "exit with code 2 if any output has no description (default false)")
+
+	cmd.PersistentFlags().BoolVar(&config.ExamplePlan.Enabled, "with-example-plan", false, "include summary of terraform plan of example inputs (default false)")
+	cmd.PersistentFlags().StringVar(&config.ExamplePlan.Vars, "example-plan-vars", "", "path of tfvars file (relative to module root) to generate example plan with (default \"\")")
+
+	cmd.PersistentFlags().BoolVar(&config.LockDiff.Enabled, "with-dependency-lock-diff", false, "include changes of provider versions in .terraform.lock.hcl between two Git refs (default false)")
+	cmd.PersistentFlags().StringVar(&config.LockDiff.From, "lock-diff-from", "HEAD~1", "Git ref to compare .terraform.lock.hcl from of '--with-dependency-lock-diff'")
+	cmd.PersistentFlags().StringVar(&config.LockDiff.To, "lock-diff-to", "HEAD", "Git ref to compare .terraform.lock.hcl to of '--with-dependency-lock-diff'")
+
 	cmd.PersistentFlags().BoolVar(&config.Settings.ReadComments, "read-comments", true, "use comments as description when description is empty")
+	cmd.PersistentFlags().BoolVar(&config.Settings.S3BackendDocs, "with-s3-backend-docs", false, "show configuration of S3 backend of the module, if any (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.SensitiveSummary, "with-sensitive-summary", false, "show callout of counts of sensitive inputs and outputs (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.ShowAttributes, "show-attributes", false, "show sensitive and nullable attributes of inputs and outputs (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.ShowChecks, "show-checks", false, "show check blocks of the module (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.ShowMoved, "show-moved", false, "show moved blocks of the module (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.ShowSummary, "show-summary", false, "show summary of counts of inputs, outputs, resources and providers (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.ShowValidations, "show-validations", false, "show validation blocks of inputs (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.ShowCoreVersion, "show-core-version", true, "show required and pinned version of Terraform")
+	cmd.PersistentFlags().BoolVar(&config.Settings.ShowEphemeralResources, "show-ephemeral-resources", true, "show ephemeral resources of the module")
+	cmd.PersistentFlags().BoolVar(&config.Settings.VariableSummaryTable, "with-variable-summary-table", false, "show one-row table of counts of inputs before them (default false)")
 
 	// formatter subcommands
 	cmd.AddCommand(asciidoc.NewCommand(runtime, config))
+	cmd.AddCommand(confluence.NewCommand(runtime, config))
+	cmd.AddCommand(html.NewCommand(runtime, config))
 	cmd.AddCommand(json.NewCommand(runtime, config))
+	cmd.AddCommand(jsonschema.NewCommand(runtime, config))
 	cmd.AddCommand(markdown.NewCommand(runtime, config))
 	cmd.AddCommand(pretty.NewCommand(runtime, config))
 	cmd.AddCommand(tfvars.NewCommand(runtime, config))
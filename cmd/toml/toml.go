@@ -27,5 +27,9 @@ func NewCommand(runtime *cli.Runtime, config *print.Config) *cobra.Command {
 		PreRunE:     runtime.PreRunEFunc,
 		RunE:        runtime.RunEFunc,
 	}
+
+	// flags
+	cmd.PersistentFlags().BoolVar(&config.Settings.HideEmpty, "hide-empty", false, "hide empty sections (default false)")
+
 	return cmd
 }
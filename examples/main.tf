@@ -84,3 +84,15 @@ module "baz" {
 module "foobar" {
   source = "git@github.com:module/path?ref=v7.8.9"
 }
+
+check "health" {
+  assert {
+    condition     = length(var.list-1) > 0
+    error_message = "The list must not be empty."
+  }
+
+  assert {
+    condition     = var.number-1 == 42 || var.number-2 != null
+    error_message = "Either number-1 must be 42 or number-2 must be set, got ${var.number-1}."
+  }
+}
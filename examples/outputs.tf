@@ -16,4 +16,9 @@ output "output-1" {
 output "output-0.12" {
   value       = join(",", var.list-3)
   description = "terraform 0.12 only"
+
+  precondition {
+    condition     = length(var.list-3) == 0
+    error_message = "The list-3 must be empty."
+  }
 }
@@ -0,0 +1,15 @@
+run "defaults" {
+  command = plan
+
+  variables {
+    unquoted               = "foo"
+    input_with_underscores = "bar"
+    string_no_default      = "baz"
+    list-3                 = ["a", "b"]
+  }
+
+  assert {
+    condition     = output.output-1 != ""
+    error_message = "Output must not be empty."
+  }
+}
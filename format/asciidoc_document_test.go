@@ -157,3 +157,52 @@ func TestAsciidocDocument(t *testing.T) {
 		})
 	}
 }
+
+func TestAsciidocDocumentInputValidations(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Inputs = true
+		c.Settings.ShowValidations = true
+	})
+
+	expected, err := testutil.GetExpected("asciidoc", "document-InputValidations")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	populateInputValidations(module)
+
+	formatter := NewAsciidocDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestAsciidocDocumentAttributes(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Inputs = true
+		c.Sections.Outputs = true
+		c.Settings.ShowAttributes = true
+	})
+
+	expected, err := testutil.GetExpected("asciidoc", "document-Attributes")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	populateAttributes(module)
+
+	formatter := NewAsciidocDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
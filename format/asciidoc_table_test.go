@@ -157,3 +157,52 @@ func TestAsciidocTable(t *testing.T) {
 		})
 	}
 }
+
+func TestAsciidocTableInputValidations(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Inputs = true
+		c.Settings.ShowValidations = true
+	})
+
+	expected, err := testutil.GetExpected("asciidoc", "table-InputValidations")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	populateInputValidations(module)
+
+	formatter := NewAsciidocTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestAsciidocTableAttributes(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Inputs = true
+		c.Sections.Outputs = true
+		c.Settings.ShowAttributes = true
+	})
+
+	expected, err := testutil.GetExpected("asciidoc", "table-Attributes")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	populateAttributes(module)
+
+	formatter := NewAsciidocTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
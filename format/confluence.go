@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"embed"
+	gotemplate "text/template"
+
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/template"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+//go:embed templates/confluence*.tmpl
+var confluenceFS embed.FS
+
+// confluence represents Confluence Storage Format.
+type confluence struct {
+	*generator
+
+	config   *print.Config
+	template *template.Template
+}
+
+// NewConfluence returns new instance of Confluence.
+func NewConfluence(config *print.Config) Type {
+	items := readTemplateItems(confluenceFS, "confluence")
+
+	tt := template.New(config, items...)
+	tt.CustomFunc(gotemplate.FuncMap{
+		"type": func(t string) string {
+			return printHTMLCode(t)
+		},
+		"value": func(v string) string {
+			if v == "" {
+				return "n/a"
+			}
+			return printHTMLCode(v)
+		},
+		"sanitizeConfluence": func(s string) string {
+			return sanitizeHTML(s)
+		},
+		"tableBegin": func() string {
+			if config.Settings.ConfluenceTableStyle == print.ConfluenceTableSortable {
+				return "<ac:structured-macro ac:name=\"table-filter\">\n" +
+					"<ac:parameter ac:name=\"sortable\">true</ac:parameter>\n" +
+					"<ac:rich-text-body>\n" +
+					"<table>\n<tbody>"
+			}
+			return "<table>\n<tbody>"
+		},
+		"tableEnd": func() string {
+			if config.Settings.ConfluenceTableStyle == print.ConfluenceTableSortable {
+				return "</tbody>\n</table>\n" +
+					"</ac:rich-text-body>\n" +
+					"</ac:structured-macro>"
+			}
+			return "</tbody>\n</table>"
+		},
+	})
+
+	return &confluence{
+		generator: newGenerator(config, true),
+		config:    config,
+		template:  tt,
+	}
+}
+
+// Generate a Terraform module as Confluence Storage Format.
+func (c *confluence) Generate(module *terraform.Module) error {
+	err := c.generator.forEach(func(name string) (string, error) {
+		rendered, err := c.template.Render(name, module)
+		if err != nil {
+			return "", err
+		}
+		return sanitize(rendered), nil
+	})
+
+	c.generator.funcs(withModule(module))
+
+	return err
+}
+
+func init() {
+	register(map[string]initializerFn{
+		"confluence": NewConfluence,
+	})
+}
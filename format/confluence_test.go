@@ -0,0 +1,169 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	xmlsdk "encoding/xml"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/testutil"
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+func TestConfluence(t *testing.T) {
+	tests := map[string]struct {
+		config print.Config
+	}{
+		// Base
+		"Base": {
+			config: testutil.WithSections(),
+		},
+		"Empty": {
+			config: testutil.WithDefaultSections(
+				testutil.With(func(c *print.Config) {
+					c.ModuleRoot = "empty"
+				}),
+			),
+		},
+		"HideEmpty": {
+			config: testutil.WithDefaultSections(
+				testutil.WithHideEmpty(),
+				testutil.With(func(c *print.Config) {
+					c.ModuleRoot = "empty"
+				}),
+			),
+		},
+		"HideAll": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Header = false // Since we don't show the header, the file won't be loaded at all
+				c.HeaderFrom = "bad.tf"
+			}),
+		},
+
+		// Settings
+		"WithRequired": {
+			config: testutil.WithSections(
+				testutil.With(func(c *print.Config) {
+					c.Settings.Required = true
+				}),
+			),
+		},
+		"SortableTable": {
+			config: testutil.WithSections(
+				testutil.With(func(c *print.Config) {
+					c.Settings.ConfluenceTableStyle = print.ConfluenceTableSortable
+				}),
+			),
+		},
+		"OutputValues": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Outputs = true
+				c.OutputValues.Enabled = true
+				c.OutputValues.From = "output_values.json"
+				c.Settings.Sensitive = true
+			}),
+		},
+
+		// Only section
+		"OnlyHeader": {
+			config: testutil.With(func(c *print.Config) { c.Sections.Header = true }),
+		},
+		"OnlyInputs": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Inputs = true
+				c.Settings.Default = true
+				c.Settings.Type = true
+			}),
+		},
+		"OnlyOutputs": {
+			config: testutil.With(func(c *print.Config) { c.Sections.Outputs = true }),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			expected, err := testutil.GetExpected("confluence", "confluence-"+name)
+			assert.Nil(err)
+
+			module, err := testutil.GetModule(&tt.config)
+			assert.Nil(err)
+
+			formatter := NewConfluence(&tt.config)
+
+			err = formatter.Generate(module)
+			assert.Nil(err)
+
+			assert.Equal(expected, formatter.Content())
+		})
+	}
+}
+
+func TestConfluenceWellFormed(t *testing.T) {
+	tests := map[string]struct {
+		config print.Config
+	}{
+		"DefaultTable": {
+			config: testutil.WithSections(
+				testutil.With(func(c *print.Config) {
+					c.Settings.Required = true
+				}),
+			),
+		},
+		"SortableTable": {
+			config: testutil.WithSections(
+				testutil.With(func(c *print.Config) {
+					c.Settings.ConfluenceTableStyle = print.ConfluenceTableSortable
+				}),
+			),
+		},
+		"OutputValues": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Outputs = true
+				c.OutputValues.Enabled = true
+				c.OutputValues.From = "output_values.json"
+				c.Settings.Sensitive = true
+			}),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			module, err := testutil.GetModule(&tt.config)
+			assert.Nil(err)
+
+			formatter := NewConfluence(&tt.config)
+
+			err = formatter.Generate(module)
+			assert.Nil(err)
+
+			// Storage Format is a fragment (i.e. multiple root elements), wrap
+			// it in a single element to be able to parse it as a document.
+			content := "<root>" + formatter.Content() + "</root>"
+
+			decoder := xmlsdk.NewDecoder(strings.NewReader(content))
+			decoder.Strict = true
+
+			for {
+				_, err = decoder.Token()
+				if err != nil {
+					break
+				}
+			}
+			assert.Equal(io.EOF, err)
+		})
+	}
+}
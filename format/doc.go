@@ -44,6 +44,7 @@ the root directory of this source tree.
 //
 // • `NewAsciidocDocument`
 // • `NewAsciidocTable`
+// • `NewConfluence`
 // • `NewJSON`
 // • `NewMarkdownDocument`
 // • `NewMarkdownTable`
@@ -174,6 +174,7 @@ func TestGeneratorFuncModule(t *testing.T) {
 
 		config := print.DefaultConfig()
 		config.ModuleRoot = filepath.Join("..", "terraform", "testdata", "full-example")
+		config.Cache.Enabled = false
 
 		module, err := terraform.LoadWithOptions(config)
 
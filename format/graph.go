@@ -0,0 +1,199 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+// Graph represents a directed graph of Terraform module, e.g. the module calls
+// and the providers which the module depends on. The graph is laid out from left
+// to right, or from top to bottom if 'TopDown' is set.
+type Graph struct {
+	Nodes   []GraphNode
+	Edges   []GraphEdge
+	TopDown bool
+}
+
+// GraphNode is a node of Graph. 'ID' is unique in the graph and only contains
+// letters, digits and underscores, so it's a valid identifier in all the
+// diagram languages.
+type GraphNode struct {
+	ID    string
+	Label string
+}
+
+// GraphEdge is a directed edge of Graph from one node to another by their ID.
+type GraphEdge struct {
+	From string
+	To   string
+}
+
+// GraphRenderer renders Graph as a diagram in a diagram language.
+type GraphRenderer interface {
+	// Language returns name of the diagram language, e.g. to be used as the
+	// language of the fenced code block of the diagram.
+	Language() string
+
+	// Render returns the diagram of 'graph' in the diagram language.
+	Render(graph *Graph) string
+}
+
+// NewGraphRenderer returns GraphRenderer of the given graph format, which is
+// one of 'mermaid', 'dot' (Graphviz) or 'd2'.
+func NewGraphRenderer(format string) (GraphRenderer, error) {
+	switch format {
+	case print.GraphFormatMermaid:
+		return mermaidRenderer{}, nil
+	case print.GraphFormatDot:
+		return dotRenderer{}, nil
+	case print.GraphFormatD2:
+		return d2Renderer{}, nil
+	}
+	return nil, fmt.Errorf("'%s' is not a valid graph format", format)
+}
+
+// rootNodeID is ID of the node of the module itself in the dependency graph.
+const rootNodeID = "root"
+
+var invalidNodeIDChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+func graphNodeID(kind string, name string) string {
+	return kind + "_" + invalidNodeIDChars.ReplaceAllString(name, "_")
+}
+
+// newDependencyGraph returns the graph of dependencies of the module, i.e. an
+// edge from the module to each of its module calls and providers.
+func newDependencyGraph(module *terraform.Module) *Graph {
+	graph := &Graph{
+		Nodes: []GraphNode{{ID: rootNodeID, Label: "root module"}},
+		Edges: []GraphEdge{},
+	}
+	for _, modulecall := range module.ModuleCalls {
+		id := graphNodeID("module", modulecall.Name)
+		graph.Nodes = append(graph.Nodes, GraphNode{ID: id, Label: "module." + modulecall.Name})
+		graph.Edges = append(graph.Edges, GraphEdge{From: rootNodeID, To: id})
+	}
+	for _, provider := range module.Providers {
+		id := graphNodeID("provider", provider.FullName())
+		graph.Nodes = append(graph.Nodes, GraphNode{ID: id, Label: "provider." + provider.FullName()})
+		graph.Edges = append(graph.Edges, GraphEdge{From: rootNodeID, To: id})
+	}
+	return graph
+}
+
+// newModuleCallGraph returns the graph of the tree of nested module calls of the
+// module, i.e. an edge from the module to each of its module calls, and from each
+// of them to the module calls of the called module.
+func newModuleCallGraph(module *terraform.Module) *Graph {
+	graph := &Graph{
+		Nodes:   []GraphNode{{ID: rootNodeID, Label: "root module"}},
+		Edges:   []GraphEdge{},
+		TopDown: true,
+	}
+	var add func(parent string, prefix string, nodes []*terraform.ModuleCallNode)
+	add = func(parent string, prefix string, nodes []*terraform.ModuleCallNode) {
+		for _, node := range nodes {
+			id := prefix + "_" + invalidNodeIDChars.ReplaceAllString(node.Name, "_")
+			graph.Nodes = append(graph.Nodes, GraphNode{ID: id, Label: "module." + node.Name})
+			graph.Edges = append(graph.Edges, GraphEdge{From: parent, To: id})
+			add(id, id+"_", node.Children)
+		}
+	}
+	add(rootNodeID, "module", module.ModuleCallTree)
+	return graph
+}
+
+// printModuleCallGraph prints the tree of nested module calls of the module as
+// Mermaid diagram in fenced code block.
+func printModuleCallGraph(module *terraform.Module) string {
+	renderer := mermaidRenderer{}
+	return fmt.Sprintf("```%s\n%s\n```", renderer.Language(), renderer.Render(newModuleCallGraph(module)))
+}
+
+// printDependencyGraph prints the dependency graph of the module in the graph
+// format of '--with-graph-format' as fenced code block, or empty if not set.
+func printDependencyGraph(config *print.Config, module *terraform.Module) string {
+	renderer, err := NewGraphRenderer(config.Settings.GraphFormat)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("```%s\n%s\n```", renderer.Language(), renderer.Render(newDependencyGraph(module)))
+}
+
+// mermaidRenderer renders Graph as Mermaid flowchart.
+type mermaidRenderer struct{}
+
+func (mermaidRenderer) Language() string {
+	return "mermaid"
+}
+
+func (mermaidRenderer) Render(graph *Graph) string {
+	lines := []string{"graph LR"}
+	if graph.TopDown {
+		lines = []string{"graph TD"}
+	}
+	for _, node := range graph.Nodes {
+		lines = append(lines, fmt.Sprintf("  %s[\"%s\"]", node.ID, strings.ReplaceAll(node.Label, `"`, "#quot;")))
+	}
+	for _, edge := range graph.Edges {
+		lines = append(lines, fmt.Sprintf("  %s --> %s", edge.From, edge.To))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// dotRenderer renders Graph as Graphviz DOT digraph.
+type dotRenderer struct{}
+
+func (dotRenderer) Language() string {
+	return "dot"
+}
+
+func (dotRenderer) Render(graph *Graph) string {
+	lines := []string{"digraph {", "  rankdir=LR;"}
+	if graph.TopDown {
+		lines = []string{"digraph {", "  rankdir=TB;"}
+	}
+	for _, node := range graph.Nodes {
+		lines = append(lines, fmt.Sprintf("  %s [label=%q];", node.ID, node.Label))
+	}
+	for _, edge := range graph.Edges {
+		lines = append(lines, fmt.Sprintf("  %s -> %s;", edge.From, edge.To))
+	}
+	lines = append(lines, "}")
+	return strings.Join(lines, "\n")
+}
+
+// d2Renderer renders Graph as D2 diagram.
+type d2Renderer struct{}
+
+func (d2Renderer) Language() string {
+	return "d2"
+}
+
+func (d2Renderer) Render(graph *Graph) string {
+	lines := []string{"direction: right"}
+	if graph.TopDown {
+		lines = []string{"direction: down"}
+	}
+	for _, node := range graph.Nodes {
+		lines = append(lines, fmt.Sprintf("%s: %q", node.ID, node.Label))
+	}
+	for _, edge := range graph.Edges {
+		lines = append(lines, fmt.Sprintf("%s -> %s", edge.From, edge.To))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+func TestGraphRenderer(t *testing.T) {
+	graph := &Graph{
+		Nodes: []GraphNode{
+			{ID: "root", Label: "root module"},
+			{ID: "module_vpc", Label: `module."vpc"`},
+		},
+		Edges: []GraphEdge{
+			{From: "root", To: "module_vpc"},
+		},
+	}
+	tests := map[string]struct {
+		language string
+		expected string
+	}{
+		"mermaid": {
+			language: "mermaid",
+			expected: "graph LR\n  root[\"root module\"]\n  module_vpc[\"module.#quot;vpc#quot;\"]\n  root --> module_vpc",
+		},
+		"dot": {
+			language: "dot",
+			expected: "digraph {\n  rankdir=LR;\n  root [label=\"root module\"];\n  module_vpc [label=\"module.\\\"vpc\\\"\"];\n  root -> module_vpc;\n}",
+		},
+		"d2": {
+			language: "d2",
+			expected: "direction: right\nroot: \"root module\"\nmodule_vpc: \"module.\\\"vpc\\\"\"\nroot -> module_vpc",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			renderer, err := NewGraphRenderer(name)
+			assert.Nil(err)
+
+			assert.Equal(tt.language, renderer.Language())
+			assert.Equal(tt.expected, renderer.Render(graph))
+		})
+	}
+}
+
+func TestNewGraphRendererInvalid(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := NewGraphRenderer("svg")
+	assert.NotNil(err)
+	assert.Equal("'svg' is not a valid graph format", err.Error())
+}
+
+func TestNewDependencyGraph(t *testing.T) {
+	assert := assert.New(t)
+
+	module := &terraform.Module{
+		ModuleCalls: []*terraform.ModuleCall{{Name: "vpc"}},
+		Providers:   []*terraform.Provider{{Name: "aws"}, {Name: "aws", Alias: "us-east-1"}},
+	}
+
+	graph := newDependencyGraph(module)
+
+	assert.Equal([]GraphNode{
+		{ID: "root", Label: "root module"},
+		{ID: "module_vpc", Label: "module.vpc"},
+		{ID: "provider_aws", Label: "provider.aws"},
+		{ID: "provider_aws_us_east_1", Label: "provider.aws.us-east-1"},
+	}, graph.Nodes)
+	assert.Equal([]GraphEdge{
+		{From: "root", To: "module_vpc"},
+		{From: "root", To: "provider_aws"},
+		{From: "root", To: "provider_aws_us_east_1"},
+	}, graph.Edges)
+}
+
+func TestGraphRendererTopDown(t *testing.T) {
+	graph := &Graph{
+		Nodes: []GraphNode{
+			{ID: "root", Label: "root module"},
+		},
+		Edges:   []GraphEdge{},
+		TopDown: true,
+	}
+	tests := map[string]string{
+		"mermaid": "graph TD\n  root[\"root module\"]",
+		"dot":     "digraph {\n  rankdir=TB;\n  root [label=\"root module\"];\n}",
+		"d2":      "direction: down\nroot: \"root module\"",
+	}
+	for name, expected := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			renderer, err := NewGraphRenderer(name)
+			assert.Nil(err)
+
+			assert.Equal(expected, renderer.Render(graph))
+		})
+	}
+}
+
+func TestNewModuleCallGraph(t *testing.T) {
+	assert := assert.New(t)
+
+	module := &terraform.Module{
+		ModuleCallTree: []*terraform.ModuleCallNode{
+			{
+				Name:   "network",
+				Source: "./modules/network",
+				Children: []*terraform.ModuleCallNode{
+					{Name: "vpc", Source: "terraform-aws-modules/vpc/aws"},
+				},
+			},
+			{Name: "vpc", Source: "./modules/vpc"},
+		},
+	}
+
+	graph := newModuleCallGraph(module)
+
+	assert.True(graph.TopDown)
+	assert.Equal([]GraphNode{
+		{ID: "root", Label: "root module"},
+		{ID: "module_network", Label: "module.network"},
+		{ID: "module_network__vpc", Label: "module.vpc"},
+		{ID: "module_vpc", Label: "module.vpc"},
+	}, graph.Nodes)
+	assert.Equal([]GraphEdge{
+		{From: "root", To: "module_network"},
+		{From: "module_network", To: "module_network__vpc"},
+		{From: "root", To: "module_vpc"},
+	}, graph.Edges)
+}
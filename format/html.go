@@ -0,0 +1,151 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"embed"
+	"fmt"
+	"html"
+	"path/filepath"
+	"strings"
+	gotemplate "text/template"
+
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/template"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+//go:embed templates/html*.tmpl
+var htmlFS embed.FS
+
+// htmlDocument represents HTML format, either as a standalone page or as a
+// fragment to be embedded in another page.
+type htmlDocument struct {
+	*generator
+
+	config   *print.Config
+	template *template.Template
+}
+
+// NewHTML returns new instance of HTML.
+func NewHTML(config *print.Config) Type {
+	items := readTemplateItems(htmlFS, "html")
+
+	// scripts and stylesheets only work in a page of its own, embedding pages
+	// (e.g. a wiki) are responsible for their own
+	standalone := func() bool {
+		return config.Settings.HTMLMode != print.HTMLModeFragment
+	}
+
+	tt := template.New(config, items...)
+	tt.CustomFunc(gotemplate.FuncMap{
+		"type": func(t string) string {
+			return printHTMLCode(t)
+		},
+		"value": func(v string) string {
+			if v == "" {
+				return "n/a"
+			}
+			return printHTMLCode(v)
+		},
+		"sanitizeHTML": func(s string) string {
+			return sanitizeHTML(s)
+		},
+		"standalone": standalone,
+		"darkModeStyle": func() (string, error) {
+			if !standalone() || !config.Settings.DarkMode {
+				return "", nil
+			}
+			return printHTMLDarkModeStyle(config)
+		},
+		"inputsSearch": func(inputs []*terraform.Input) string {
+			if !standalone() || !config.Settings.SearchableTable || len(inputs) == 0 {
+				return ""
+			}
+			return inputsSearchScript
+		},
+		"inputsPagination": func(inputs []*terraform.Input) string {
+			s := config.Settings
+			if !standalone() || !s.InputTablePagination || len(inputs) <= s.PaginationSize {
+				return ""
+			}
+			return fmt.Sprintf(inputsPaginationScript, s.PaginationSize)
+		},
+		"moduleName": func() string {
+			root, err := filepath.Abs(config.ModuleRoot)
+			if err != nil {
+				root = config.ModuleRoot
+			}
+			return html.EscapeString(filepath.Base(root))
+		},
+	})
+
+	return &htmlDocument{
+		generator: newGenerator(config, true),
+		config:    config,
+		template:  tt,
+	}
+}
+
+// Generate a Terraform module as HTML.
+func (h *htmlDocument) Generate(module *terraform.Module) error {
+	err := h.generator.forEach(func(name string) (string, error) {
+		rendered, err := h.template.Render(name, module)
+		if err != nil {
+			return "", err
+		}
+		return sanitize(rendered), nil
+	})
+
+	h.generator.funcs(withModule(module))
+
+	return err
+}
+
+// sanitizeHTML escapes HTML entities of the given string and converts line
+// breaks to '<br />' so it can be safely placed in a table cell.
+func sanitizeHTML(s string) string {
+	s = html.EscapeString(strings.TrimSuffix(s, "\n"))
+	return strings.ReplaceAll(s, "\n", "<br />")
+}
+
+// printHTMLCode prints escaped code in '<pre>' block if it contains '\n',
+// otherwise it wraps the code inside '<code>' element.
+func printHTMLCode(code string) string {
+	if strings.Contains(code, "\n") {
+		return fmt.Sprintf("<pre>%s</pre>", html.EscapeString(code))
+	}
+	return fmt.Sprintf("<code>%s</code>", html.EscapeString(code))
+}
+
+// htmlDarkModeBody is the colors of the page itself in dark mode, which are not
+// part of the palette of tables and code blocks shared with Markdown.
+const htmlDarkModeBody = `  body {
+    color: #c9d1d9;
+    background-color: #0d1117;
+  }`
+
+// printHTMLDarkModeStyle prints the CSS rules of the page in dark mode (i.e.
+// 'prefers-color-scheme: dark' media query) to be placed in its stylesheet, with
+// the palette of '--dark-mode-css-file' if provided.
+func printHTMLDarkModeStyle(config *print.Config) (string, error) {
+	palette, err := readDarkModePalette(config)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("@media (prefers-color-scheme: dark) {\n%s\n%s\n}", htmlDarkModeBody, palette), nil
+}
+
+func init() {
+	register(map[string]initializerFn{
+		"html": NewHTML,
+	})
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	xmlsdk "encoding/xml"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/testutil"
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+func TestHTML(t *testing.T) {
+	tests := map[string]struct {
+		config print.Config
+	}{
+		// Base
+		"Base": {
+			config: testutil.WithSections(),
+		},
+		"Empty": {
+			config: testutil.WithDefaultSections(
+				testutil.With(func(c *print.Config) {
+					c.ModuleRoot = "empty"
+				}),
+			),
+		},
+		"HideEmpty": {
+			config: testutil.WithDefaultSections(
+				testutil.WithHideEmpty(),
+				testutil.With(func(c *print.Config) {
+					c.ModuleRoot = "empty"
+				}),
+			),
+		},
+		"HideAll": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Header = false // Since we don't show the header, the file won't be loaded at all
+				c.HeaderFrom = "bad.tf"
+			}),
+		},
+
+		// Settings
+		"Fragment": {
+			config: testutil.WithSections(
+				testutil.With(func(c *print.Config) {
+					c.Settings.HTMLMode = print.HTMLModeFragment
+				}),
+			),
+		},
+		"WithRequired": {
+			config: testutil.WithSections(
+				testutil.With(func(c *print.Config) {
+					c.Settings.Required = true
+				}),
+			),
+		},
+		"DarkMode": {
+			config: testutil.WithSections(
+				testutil.With(func(c *print.Config) {
+					c.Settings.DarkMode = true
+				}),
+			),
+		},
+		"SearchableTable": {
+			config: testutil.WithSections(
+				testutil.With(func(c *print.Config) {
+					c.Settings.SearchableTable = true
+				}),
+			),
+		},
+		"InputTablePagination": {
+			config: testutil.WithSections(
+				testutil.With(func(c *print.Config) {
+					c.Settings.InputTablePagination = true
+					c.Settings.PaginationSize = 10
+				}),
+			),
+		},
+		"FragmentWithScripts": {
+			config: testutil.WithSections(
+				testutil.With(func(c *print.Config) {
+					c.Settings.HTMLMode = print.HTMLModeFragment
+					c.Settings.DarkMode = true
+					c.Settings.InputTablePagination = true
+					c.Settings.PaginationSize = 10
+					c.Settings.SearchableTable = true
+				}),
+			),
+		},
+		"OutputValues": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Outputs = true
+				c.OutputValues.Enabled = true
+				c.OutputValues.From = "output_values.json"
+				c.Settings.Sensitive = true
+			}),
+		},
+
+		// Only section
+		"OnlyHeader": {
+			config: testutil.With(func(c *print.Config) { c.Sections.Header = true }),
+		},
+		"OnlyInputs": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Inputs = true
+				c.Settings.Default = true
+				c.Settings.Type = true
+			}),
+		},
+		"OnlyOutputs": {
+			config: testutil.With(func(c *print.Config) { c.Sections.Outputs = true }),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			expected, err := testutil.GetExpected("html", "html-"+name)
+			assert.Nil(err)
+
+			module, err := testutil.GetModule(&tt.config)
+			assert.Nil(err)
+
+			formatter := NewHTML(&tt.config)
+
+			err = formatter.Generate(module)
+			assert.Nil(err)
+
+			assert.Equal(expected, formatter.Content())
+		})
+	}
+}
+
+func TestHTMLFragmentWellFormed(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.WithSections(
+		testutil.With(func(c *print.Config) {
+			c.Settings.HTMLMode = print.HTMLModeFragment
+			c.Settings.Required = true
+		}),
+	)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	formatter := NewHTML(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	decoder := xmlsdk.NewDecoder(strings.NewReader(formatter.Content()))
+	decoder.Strict = true
+
+	for {
+		_, err = decoder.Token()
+		if err != nil {
+			break
+		}
+	}
+	assert.Equal(io.EOF, err)
+}
+
+func TestPrintHTMLDarkModeStyle(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "dark.css"), []byte("  table {\n    color: #fff;\n  }\n"), 0644) //nolint:errcheck,gosec
+
+	tests := map[string]struct {
+		file     string
+		expected string
+		wantErr  bool
+	}{
+		"DefaultPalette": {
+			file:     "",
+			expected: "@media (prefers-color-scheme: dark) {\n" + htmlDarkModeBody + "\n" + darkModePalette + "\n}",
+			wantErr:  false,
+		},
+		"CSSFile": {
+			file:     "dark.css",
+			expected: "@media (prefers-color-scheme: dark) {\n" + htmlDarkModeBody + "\n  table {\n    color: #fff;\n  }\n}",
+			wantErr:  false,
+		},
+		"MissingFile": {
+			file:     "missing.css",
+			expected: "",
+			wantErr:  true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.DefaultConfig()
+			config.ModuleRoot = dir
+			config.Settings.DarkMode = true
+			config.Settings.DarkModeCSSFile = tt.file
+
+			actual, err := printHTMLDarkModeStyle(config)
+			if tt.wantErr {
+				assert.NotNil(err)
+				return
+			}
+			assert.Nil(err)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
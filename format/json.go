@@ -43,7 +43,7 @@ func (j *json) Generate(module *terraform.Module) error {
 	encoder.SetIndent("", "  ")
 	encoder.SetEscapeHTML(j.config.Settings.Escape)
 
-	if err := encoder.Encode(copy); err != nil {
+	if err := encoder.Encode(hideEmptySections(j.config, copy)); err != nil {
 		return err
 	}
 
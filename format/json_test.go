@@ -16,7 +16,9 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/terraform-docs/terraform-docs/internal/testutil"
+	"github.com/terraform-docs/terraform-docs/internal/types"
 	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
 )
 
 func TestJson(t *testing.T) {
@@ -34,6 +36,14 @@ func TestJson(t *testing.T) {
 				}),
 			),
 		},
+		"HideEmpty": {
+			config: testutil.WithDefaultSections(
+				testutil.WithHideEmpty(),
+				testutil.With(func(c *print.Config) {
+					c.ModuleRoot = "empty"
+				}),
+			),
+		},
 		"HideAll": {
 			config: testutil.With(func(c *print.Config) {
 				c.Sections.Header = false // Since we don't show the header, the file won't be loaded at all
@@ -49,6 +59,11 @@ func TestJson(t *testing.T) {
 				}),
 			),
 		},
+		"ShowSummary": {
+			config: testutil.With(func(c *print.Config) {
+				c.Settings.ShowSummary = true
+			}),
+		},
 		"OutputValues": {
 			config: testutil.With(func(c *print.Config) {
 				c.Sections.Outputs = true
@@ -57,11 +72,22 @@ func TestJson(t *testing.T) {
 				c.Settings.Sensitive = true
 			}),
 		},
+		"OutputValueType": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Outputs = true
+				c.Settings.OutputValueType = true
+			}),
+		},
 
 		// Only section
 		"OnlyDataSources": {
 			config: testutil.With(func(c *print.Config) { c.Sections.DataSources = true }),
 		},
+		"ModulePurpose": {
+			config: testutil.With(func(c *print.Config) {
+				c.Settings.ModulePurpose = true
+			}),
+		},
 		"OnlyHeader": {
 			config: testutil.With(func(c *print.Config) { c.Sections.Header = true }),
 		},
@@ -109,3 +135,81 @@ func TestJson(t *testing.T) {
 		})
 	}
 }
+
+func TestJsonMoved(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {})
+
+	expected, err := testutil.GetExpected("json", "json-Moved")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// examples module doesn't have any moved blocks, populate them directly
+	module.Moved = []*terraform.Moved{
+		{From: types.String("null_resource.foo"), To: types.String("null_resource.bar[0]")},
+		{From: types.String(`module.foo["key"]`), To: types.String("module.baz")},
+	}
+
+	formatter := NewJSON(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestJsonEphemeralResources(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Resources = true
+		c.Settings.ShowEphemeralResources = true
+	})
+
+	expected, err := testutil.GetExpected("json", "json-EphemeralResources")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// examples module doesn't have any ephemeral blocks, populate them directly
+	module.EphemeralResources = []*terraform.EphemeralResource{
+		{Type: "password", Name: "db", ProviderName: "random", ProviderSource: "hashicorp/random", Version: types.String("latest")},
+		{Type: "secret_version", Name: "db", ProviderName: "vault", ProviderSource: "acme.com/acme/vault", Version: types.String("1.0.0")},
+	}
+
+	formatter := NewJSON(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestJsonAttributes(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Inputs = true
+		c.Sections.Outputs = true
+		c.Settings.ShowAttributes = true
+	})
+
+	expected, err := testutil.GetExpected("json", "json-Attributes")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	populateAttributes(module)
+
+	formatter := NewJSON(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
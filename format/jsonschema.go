@@ -0,0 +1,265 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"bytes"
+	jsonsdk "encoding/json"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/iancoleman/orderedmap"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+// jsonSchemaDraft is the version of JSON Schema generated by 'jsonschema'.
+const jsonSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// jsonSchema represents JSON Schema format of the inputs.
+type jsonSchema struct {
+	*generator
+
+	config *print.Config
+}
+
+// NewJSONSchema returns new instance of JSONSchema.
+func NewJSONSchema(config *print.Config) Type {
+	return &jsonSchema{
+		generator: newGenerator(config, false),
+		config:    config,
+	}
+}
+
+// Generate JSON Schema of the inputs of a Terraform module.
+func (j *jsonSchema) Generate(module *terraform.Module) error {
+	properties := newSchema()
+	required := make([]string, 0)
+	for _, i := range module.Inputs {
+		properties.Set(i.Name, inputSchema(i))
+		if i.Required {
+			required = append(required, i.Name)
+		}
+	}
+
+	schema := newSchema()
+	schema.Set("$schema", jsonSchemaDraft)
+	schema.Set("type", "object")
+	schema.Set("properties", properties)
+	if len(required) > 0 || j.config.Settings.RequiredJSONSchemaProperties {
+		schema.Set("required", required)
+	}
+	schema.Set("additionalProperties", false)
+
+	buffer := new(bytes.Buffer)
+	encoder := jsonsdk.NewEncoder(buffer)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(schema); err != nil {
+		return err
+	}
+
+	j.generator.funcs(withContent(strings.TrimSuffix(buffer.String(), "\n")))
+
+	return nil
+}
+
+func newSchema() *orderedmap.OrderedMap {
+	schema := orderedmap.New()
+	schema.SetEscapeHTML(false)
+	return schema
+}
+
+// inputSchema returns the schema of the input, which is the schema of its type
+// constraint (accepting 'null' too if the input is nullable) annotated with
+// description, default value and the constraints of its 'validation' blocks
+// which can be expressed in JSON Schema.
+func inputSchema(input *terraform.Input) *orderedmap.OrderedMap {
+	schema := newSchema()
+	if input.Description != "" {
+		schema.Set("description", string(input.Description))
+	}
+
+	constraint := typeSchema(string(input.Type))
+	for _, k := range constraint.Keys() {
+		v, _ := constraint.Get(k)
+		if k == "type" && input.IsNullable() {
+			v = []interface{}{v, "null"}
+		}
+		schema.Set(k, v)
+	}
+
+	if !input.Required {
+		schema.Set("default", input.Default)
+	}
+	if input.Deprecated != "" {
+		schema.Set("deprecated", true)
+	}
+
+	for _, validation := range input.Validations {
+		setValidationSchema(schema, input.Name, string(validation.Expression))
+	}
+	if len(input.Validations) > 0 {
+		schema.Set("x-terraform-validations", input.Validations)
+	}
+
+	return schema
+}
+
+// typeSchema returns the schema of the type constraint 't' (e.g. 'list(string)'),
+// which is empty (i.e. any value) for 'any' or if it can't be parsed.
+func typeSchema(t string) *orderedmap.OrderedMap {
+	src := []byte(t)
+	expr, diags := hclsyntax.ParseExpression(src, "", hcl.InitialPos)
+	if diags.HasErrors() {
+		return newSchema()
+	}
+	return parseTypeSchema(expr)
+}
+
+// parseTypeSchema returns the schema of the type constraint expression. Default
+// value of 'optional' attributes of objects is ignored.
+func parseTypeSchema(expr hclsyntax.Expression) *orderedmap.OrderedMap {
+	schema := newSchema()
+
+	call, ok := expr.(*hclsyntax.FunctionCallExpr)
+	if !ok || len(call.Args) == 0 {
+		switch keyword := hcl.ExprAsKeyword(expr); keyword {
+		case "string", "number":
+			schema.Set("type", keyword)
+		case "bool":
+			schema.Set("type", "boolean")
+		case "list", "set", "tuple":
+			schema.Set("type", "array")
+		case "map", "object":
+			schema.Set("type", "object")
+		}
+		return schema
+	}
+
+	switch call.Name {
+	case "list", "set":
+		schema.Set("type", "array")
+		schema.Set("items", parseTypeSchema(call.Args[0]))
+		if call.Name == "set" {
+			schema.Set("uniqueItems", true)
+		}
+	case "map":
+		schema.Set("type", "object")
+		schema.Set("additionalProperties", parseTypeSchema(call.Args[0]))
+	case "tuple":
+		schema.Set("type", "array")
+		elements, ok := call.Args[0].(*hclsyntax.TupleConsExpr)
+		if !ok {
+			break
+		}
+		items := make([]*orderedmap.OrderedMap, 0, len(elements.Exprs))
+		for _, element := range elements.Exprs {
+			items = append(items, parseTypeSchema(element))
+		}
+		schema.Set("prefixItems", items)
+		schema.Set("minItems", len(items))
+		schema.Set("maxItems", len(items))
+	case "object":
+		schema.Set("type", "object")
+		object, ok := call.Args[0].(*hclsyntax.ObjectConsExpr)
+		if !ok {
+			break
+		}
+		properties := newSchema()
+		required := make([]string, 0)
+		for _, item := range object.Items {
+			name := hcl.ExprAsKeyword(item.KeyExpr)
+			attribute := item.ValueExpr
+			if optional, ok := attribute.(*hclsyntax.FunctionCallExpr); ok && optional.Name == "optional" && len(optional.Args) > 0 {
+				attribute = optional.Args[0]
+			} else {
+				required = append(required, name)
+			}
+			properties.Set(name, parseTypeSchema(attribute))
+		}
+		schema.Set("properties", properties)
+		if len(required) > 0 {
+			schema.Set("required", required)
+		}
+	}
+
+	return schema
+}
+
+// setValidationSchema adds the constraint of the 'condition' of a validation of
+// the input 'name' to the schema, if it's one of the forms known to be expressed
+// in JSON Schema:
+//
+//	contains(["foo", "bar"], var.name) => enum
+//	can(regex("^foo", var.name))       => pattern
+//
+// Any other condition is kept as is in 'x-terraform-validations' only.
+func setValidationSchema(schema *orderedmap.OrderedMap, name string, condition string) {
+	expr, diags := hclsyntax.ParseExpression([]byte(condition), "", hcl.InitialPos)
+	if diags.HasErrors() {
+		return
+	}
+	call, ok := expr.(*hclsyntax.FunctionCallExpr)
+	if !ok {
+		return
+	}
+
+	switch {
+	case call.Name == "contains" && len(call.Args) == 2 && isVariable(call.Args[1], name):
+		values, diags := call.Args[0].Value(nil)
+		if diags.HasErrors() || !values.IsWhollyKnown() || !values.CanIterateElements() {
+			return
+		}
+		enum := make([]jsonsdk.RawMessage, 0, values.LengthInt())
+		for it := values.ElementIterator(); it.Next(); {
+			_, value := it.Element()
+			raw, err := ctyjson.Marshal(value, value.Type())
+			if err != nil {
+				return
+			}
+			enum = append(enum, raw)
+		}
+		schema.Set("enum", enum)
+	case call.Name == "can" && len(call.Args) == 1:
+		regex, ok := call.Args[0].(*hclsyntax.FunctionCallExpr)
+		if !ok || regex.Name != "regex" || len(regex.Args) != 2 || !isVariable(regex.Args[1], name) {
+			return
+		}
+		pattern, diags := regex.Args[0].Value(nil)
+		if diags.HasErrors() || pattern.Type() != cty.String || !pattern.IsKnown() || pattern.IsNull() {
+			return
+		}
+		schema.Set("pattern", pattern.AsString())
+	}
+}
+
+// isVariable returns true if the expression is a reference to the input 'name',
+// i.e. 'var.name'.
+func isVariable(expr hclsyntax.Expression, name string) bool {
+	traversal, diags := hcl.AbsTraversalForExpr(expr)
+	if diags.HasErrors() || len(traversal) != 2 || traversal.RootName() != "var" {
+		return false
+	}
+	attr, ok := traversal[1].(hcl.TraverseAttr)
+	return ok && attr.Name == name
+}
+
+func init() {
+	register(map[string]initializerFn{
+		"jsonschema": NewJSONSchema,
+	})
+}
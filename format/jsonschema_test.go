@@ -0,0 +1,146 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/testutil"
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+func TestJSONSchema(t *testing.T) {
+	tests := map[string]struct {
+		config print.Config
+	}{
+		// Base
+		"Base": {
+			config: testutil.WithSections(),
+		},
+		"Empty": {
+			config: testutil.WithDefaultSections(
+				testutil.With(func(c *print.Config) {
+					c.ModuleRoot = "empty"
+					c.Settings.RequiredJSONSchemaProperties = true
+				}),
+			),
+		},
+		"JSONModule": {
+			config: testutil.WithDefaultSections(
+				testutil.With(func(c *print.Config) {
+					c.ModuleRoot = "json-module"
+					c.Settings.ShowAttributes = true
+					c.Settings.ShowValidations = true
+				}),
+			),
+		},
+
+		// Settings
+		"SortByRequired": {
+			config: testutil.WithSections(
+				testutil.With(func(c *print.Config) {
+					c.Sort.Enabled = true
+					c.Sort.By = print.SortRequired
+				}),
+			),
+		},
+		"WithoutRequiredProperties": {
+			config: testutil.WithDefaultSections(
+				testutil.With(func(c *print.Config) {
+					c.ModuleRoot = "empty"
+				}),
+			),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			expected, err := testutil.GetExpected("jsonschema", "jsonschema-"+name)
+			assert.Nil(err)
+
+			module, err := testutil.GetModule(&tt.config)
+			assert.Nil(err)
+
+			formatter := NewJSONSchema(&tt.config)
+
+			err = formatter.Generate(module)
+			assert.Nil(err)
+
+			assert.Equal(expected, formatter.Content())
+		})
+	}
+}
+
+func TestJSONSchemaValidations(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.WithSections()
+
+	expected, err := testutil.GetExpected("jsonschema", "jsonschema-Validations")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// examples module doesn't have any validation blocks, populate them directly
+	for _, input := range module.Inputs {
+		switch input.Name {
+		case "input_with_underscores":
+			input.Validations = []*terraform.Condition{
+				{Expression: `contains(["foo", "bar"], var.input_with_underscores)`, ErrorMessage: "It must be foo or bar."},
+			}
+		case "string_no_default":
+			input.Validations = []*terraform.Condition{
+				{Expression: `can(regex("^[a-z]+$", var.string_no_default))`, ErrorMessage: "It must be lowercase."},
+				{Expression: "length(var.string_no_default) < 8", ErrorMessage: "It must be shorter than 8 characters."},
+			}
+		}
+	}
+
+	formatter := NewJSONSchema(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestJSONSchemaNullable(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.WithSections()
+
+	expected, err := testutil.GetExpected("jsonschema", "jsonschema-Nullable")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// inputs are nullable unless 'nullable = false' is set explicitly
+	nullable := false
+	for _, input := range module.Inputs {
+		switch input.Name {
+		case "bool-1", "list-1", "string-1":
+			input.Nullable = &nullable
+		}
+	}
+
+	formatter := NewJSONSchema(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
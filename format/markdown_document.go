@@ -28,6 +28,7 @@ type markdownDocument struct {
 
 	config   *print.Config
 	template *template.Template
+	sections []string
 }
 
 // NewMarkdownDocument returns new instance of Markdown Document.
@@ -36,9 +37,71 @@ func NewMarkdownDocument(config *print.Config) Type {
 
 	tt := template.New(config, items...)
 	tt.CustomFunc(gotemplate.FuncMap{
+		"variableExampleBlock": func(inputs []*terraform.Input) string {
+			return printVariableExampleBlock(inputs)
+		},
+		"inputHCL": func(input *terraform.Input) string {
+			code, _ := PrintFencedCodeBlock(printInputHCL(input), "hcl")
+			return code
+		},
+		"cardinality": func(input *terraform.Input) string {
+			return printCardinality(config, input)
+		},
+		"cloudEmoji": func(cloud string) string {
+			return printCloudEmoji(cloud)
+		},
+		"tableOfContents": func(module *terraform.Module) string {
+			return printTableOfContents(config, module, true)
+		},
+		"providerNamespace": func(provider *terraform.Provider) string {
+			return printProviderNamespace(provider)
+		},
+		"tagCompliance": func(compliance *terraform.TagCompliance) string {
+			return printTagCompliance(compliance)
+		},
+		"severity": func(severity string) string {
+			return printSeverity(severity)
+		},
+		"testStatus": func(status string) string {
+			return printTestStatus(status)
+		},
+		"testVariables": func(variables []*terraform.TestVariable) string {
+			return printTestVariables(variables)
+		},
+		"badges": func(module *terraform.Module) string {
+			return printBadges(config, module)
+		},
+		"changelog": func(changelog string) string {
+			return printChangelog(config, changelog)
+		},
+		"moduleSource": func(modulecall *terraform.ModuleCall) string {
+			return printModuleSource(config, modulecall)
+		},
+		"backendConfig": func(backend *terraform.Backend) string {
+			return printBackendConfig(backend)
+		},
+		"hclExample": func(module *terraform.Module) string {
+			return printHCLExample(config, module)
+		},
+		"dependencyGraph": func(module *terraform.Module) string {
+			return printDependencyGraph(config, module)
+		},
+		"moduleCallGraph": func(module *terraform.Module) string {
+			return printModuleCallGraph(module)
+		},
+		"darkModeStyle": func() (string, error) {
+			return printDarkModeStyle(config)
+		},
+		"configLink": func() string {
+			return printConfigLink(config)
+		},
 		"type": func(t string) string {
-			result, extraline := PrintFencedCodeBlock(t, "hcl")
-			if !extraline {
+			language := "hcl"
+			if node := newTypeNode(t); node != nil && config.Settings.ASCIITypeDiagrams {
+				t, language = node.Render(""), "text"
+			}
+			result, extraline := PrintFencedCodeBlock(t, language)
+			if !extraline && !config.Settings.Compact {
 				result += "\n"
 			}
 			return result
@@ -48,7 +111,7 @@ func NewMarkdownDocument(config *print.Config) Type {
 				return v
 			}
 			result, extraline := PrintFencedCodeBlock(v, "json")
-			if !extraline {
+			if !extraline && !config.Settings.Compact {
 				result += "\n"
 			}
 			return result
@@ -62,13 +125,20 @@ func NewMarkdownDocument(config *print.Config) Type {
 		generator: newGenerator(config, true),
 		config:    config,
 		template:  tt,
+		sections:  readSectionNames(items),
 	}
 }
 
 // Generate a Terraform module as Markdown document.
 func (d *markdownDocument) Generate(module *terraform.Module) error {
 	err := d.generator.forEach(func(name string) (string, error) {
-		rendered, err := d.template.Render(name, module)
+		var rendered string
+		var err error
+		if name == "all" && d.config.Settings.SectionSeparators {
+			rendered, err = renderSections(d.template, d.sections, module)
+		} else {
+			rendered, err = d.template.Render(name, module)
+		}
 		if err != nil {
 			return "", err
 		}
@@ -11,12 +11,15 @@ the root directory of this source tree.
 package format
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/terraform-docs/terraform-docs/internal/testutil"
+	"github.com/terraform-docs/terraform-docs/internal/types"
 	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
 )
 
 func TestMarkdownDocument(t *testing.T) {
@@ -118,6 +121,177 @@ func TestMarkdownDocument(t *testing.T) {
 				}),
 			),
 		},
+		"IndentationLevelOne": {
+			config: testutil.WithSections(
+				testutil.WithHTML(),
+				testutil.With(func(c *print.Config) {
+					c.Settings.IndentationLevel = 1
+				}),
+			),
+		},
+		"IndentationLevelTwo": {
+			config: testutil.WithSections(
+				testutil.WithHTML(),
+				testutil.With(func(c *print.Config) {
+					c.Settings.IndentationLevel = 2
+				}),
+			),
+		},
+		"IndentationLevelThree": {
+			config: testutil.WithSections(
+				testutil.WithHTML(),
+				testutil.With(func(c *print.Config) {
+					c.Settings.IndentationLevel = 3
+				}),
+			),
+		},
+		"Compact": {
+			config: testutil.WithSections(
+				testutil.WithHTML(),
+				testutil.With(func(c *print.Config) {
+					c.Settings.Compact = true
+				}),
+			),
+		},
+		"CompatibilityMatrix": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Requirements = true
+				c.Settings.ProviderSourceVersionMatrix = true
+			}),
+		},
+		"ProviderVersionBadges": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Header = true
+				c.Settings.ProviderVersionBadges = true
+				c.Settings.RequiredVersionBadge = true
+			}),
+		},
+		"RequiredVersionBadge": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Header = true
+				c.Settings.RequiredVersionBadge = true
+			}),
+		},
+		"ModuleRegistryLink": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Header = true
+				c.Registry.Enabled = true
+				c.Registry.Namespace = "terraform-docs"
+				c.Registry.Name = "example"
+				c.Registry.Provider = "aws"
+			}),
+		},
+		"ShowSummary": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Header = true
+				c.Sections.Inputs = true
+				c.Settings.ShowSummary = true
+			}),
+		},
+		"TftestExamples": {
+			config: testutil.With(func(c *print.Config) {
+				c.Settings.TftestExamples = true
+			}),
+		},
+		"GraphFormatMermaid": {
+			config: testutil.With(func(c *print.Config) {
+				c.Settings.GraphFormat = print.GraphFormatMermaid
+			}),
+		},
+		"HCLExamples": {
+			config: testutil.With(func(c *print.Config) {
+				c.Settings.HCLExamples = true
+			}),
+		},
+		"InputHCL": {
+			config: testutil.WithHTML(
+				testutil.With(func(c *print.Config) {
+					c.Sections.Inputs = true
+					c.Settings.Type = true
+					c.Settings.Default = true
+					c.Settings.InputHCL = true
+				}),
+			),
+		},
+		"VariableExampleBlock": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Inputs = true
+				c.Settings.Type = true
+				c.Settings.Default = true
+				c.Settings.VariableExampleBlock = true
+			}),
+		},
+		"Changelog": {
+			config: testutil.WithSections(
+				testutil.WithHTML(),
+				testutil.With(func(c *print.Config) {
+					c.Changelog.Enabled = true
+					c.Changelog.Versions = 2
+				}),
+			),
+		},
+		"ConfigLink": {
+			config: testutil.WithSections(
+				testutil.WithHTML(),
+				testutil.With(func(c *print.Config) {
+					c.ConfigLink.Enabled = true
+					c.ConfigLink.Path = "examples/.terraform-docs.yml"
+				}),
+			),
+		},
+		"ConfigLinkRepoURL": {
+			config: testutil.WithSections(
+				testutil.WithHTML(),
+				testutil.With(func(c *print.Config) {
+					c.ConfigLink.Enabled = true
+					c.ConfigLink.RepoURL = "https://github.com/terraform-docs/terraform-docs/blob/main/"
+					c.ConfigLink.Path = "examples/.terraform-docs.yml"
+				}),
+			),
+		},
+		"Deprecations": {
+			config: testutil.WithSections(
+				testutil.WithHTML(),
+				testutil.With(func(c *print.Config) {
+					c.DeprecationsFrom = "DEPRECATIONS.md"
+				}),
+			),
+		},
+		"SectionSeparators": {
+			config: testutil.WithSections(
+				testutil.WithHTML(),
+				testutil.With(func(c *print.Config) {
+					c.Settings.SectionSeparators = true
+				}),
+			),
+		},
+		"TableOfContents": {
+			config: testutil.WithSections(
+				testutil.With(func(c *print.Config) {
+					c.Settings.Anchor = true
+					c.Settings.Required = true
+					c.Settings.TableOfContents = true
+				}),
+			),
+		},
+		"TableOfContentsWithoutAnchor": {
+			config: testutil.WithSections(
+				testutil.WithHideEmpty(),
+				testutil.With(func(c *print.Config) {
+					c.Settings.Anchor = false
+					c.Settings.TableOfContents = true
+				}),
+			),
+		},
+		"AzureDevOpsWiki": {
+			config: testutil.WithSections(
+				testutil.WithHTML(),
+				testutil.With(func(c *print.Config) {
+					c.Settings.Anchor = true
+					c.Settings.AzureDevOpsWiki = true
+				}),
+			),
+		},
 		"OutputValues": {
 			config: testutil.WithHTML(
 				testutil.With(func(c *print.Config) {
@@ -138,6 +312,12 @@ func TestMarkdownDocument(t *testing.T) {
 				}),
 			),
 		},
+		"OutputValueType": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Outputs = true
+				c.Settings.OutputValueType = true
+			}),
+		},
 
 		// Only section
 		"OnlyDataSources": {
@@ -194,3 +374,748 @@ func TestMarkdownDocument(t *testing.T) {
 		})
 	}
 }
+
+func TestMarkdownDocumentCompactEntries(t *testing.T) {
+	assert := assert.New(t)
+
+	count := func(compact bool) int {
+		config := testutil.WithSections(
+			testutil.WithHTML(),
+			testutil.With(func(c *print.Config) {
+				c.Settings.Compact = compact
+				c.OutputValues.Enabled = true
+				c.OutputValues.From = "output_values.json"
+			}),
+		)
+
+		module, err := testutil.GetModule(&config)
+		assert.Nil(err)
+
+		formatter := NewMarkdownDocument(&config)
+
+		err = formatter.Generate(module)
+		assert.Nil(err)
+
+		entries := 0
+		for _, line := range strings.Split(formatter.Content(), "\n") {
+			if strings.HasPrefix(line, "### ") {
+				entries++
+			}
+		}
+		return entries
+	}
+
+	expected := count(false)
+
+	assert.NotZero(expected)
+	assert.Equal(expected, count(true))
+}
+
+func TestMarkdownDocumentExamplePlan(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) { c.Sections.Resources = true })
+
+	expected, err := testutil.GetExpected("markdown", "document-ExamplePlan")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// 'terraform plan' can't be run in tests, populate summary of it directly
+	module.ExamplePlan = []*terraform.PlannedResource{
+		{Type: "null_resource", Count: 2},
+		{Type: "tls_private_key", Count: 1},
+	}
+
+	formatter := NewMarkdownDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownDocumentLicense(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Header = true
+		c.Settings.License = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "document-License")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// examples module doesn't have a LICENSE file, populate it directly
+	module.License = "MIT"
+
+	formatter := NewMarkdownDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownDocumentCostEstimate(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) { c.Sections.Header = true })
+
+	expected, err := testutil.GetExpected("markdown", "document-CostEstimate")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// infracost is not available in tests, populate the estimate directly
+	module.CostEstimate = &terraform.CostEstimate{
+		Currency:             "USD",
+		TotalMonthlyCost:     "42.851",
+		DiffTotalMonthlyCost: "42.851",
+	}
+
+	formatter := NewMarkdownDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownDocumentModuleHealthScore(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Header = true
+		c.HealthScore = print.DefaultConfig().HealthScore
+		c.HealthScore.Enabled = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "document-ModuleHealthScore")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	formatter := NewMarkdownDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownDocumentSensitiveSummary(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) { c.Sections.Header = true })
+
+	expected, err := testutil.GetExpected("markdown", "document-SensitiveSummary")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// examples module doesn't have sensitive inputs, populate the counts directly
+	module.SensitiveSummary = &terraform.SensitiveSummary{
+		Inputs:  3,
+		Outputs: 2,
+	}
+
+	formatter := NewMarkdownDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownDocumentInputCardinality(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Inputs = true
+		c.Settings.Type = true
+		c.Settings.InputCardinality = print.CardinalityUML
+	})
+
+	expected, err := testutil.GetExpected("markdown", "document-InputCardinality")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	formatter := NewMarkdownDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownDocumentAutoDetectRegions(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Inputs = true
+		c.Settings.AutoDetectRegions = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "document-AutoDetectRegions")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// examples module doesn't have region inputs, populate the clouds directly
+	clouds := map[string]string{
+		"string-1": terraform.CloudAWS,
+		"string-2": terraform.CloudGCP,
+		"string-3": terraform.CloudAzure,
+	}
+	for _, input := range module.Inputs {
+		input.Cloud = clouds[input.Name]
+	}
+
+	formatter := NewMarkdownDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownDocumentOutputDeprecation(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Outputs = true
+		c.Settings.OutputDeprecation = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "document-OutputDeprecation")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// examples module doesn't have deprecated outputs, populate it directly
+	for _, output := range module.Outputs {
+		if output.Name == "output-1" {
+			output.Deprecated = "Use module.new_module.output-1 instead."
+		}
+	}
+
+	formatter := NewMarkdownDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownDocumentRunTests(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {})
+
+	expected, err := testutil.GetExpected("markdown", "document-RunTests")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// running the tests requires terraform, populate the results directly
+	config.Settings.RunTests = true
+	module.TestResults = []*terraform.TestSuite{
+		{
+			Path:    "tests/main.tftest.hcl",
+			Passed:  1,
+			Failed:  1,
+			Skipped: 1,
+			Tests: []*terraform.TestResult{
+				{Name: "valid", Status: terraform.TestStatusPass},
+				{Name: "invalid", Status: terraform.TestStatusFail},
+				{Name: "skipped", Status: terraform.TestStatusSkip},
+			},
+		},
+	}
+
+	formatter := NewMarkdownDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownDocumentExampleOutputs(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Outputs = true
+		c.Settings.ExampleOutputs = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "document-ExampleOutputs")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// reading the outputs requires terraform, populate the values directly
+	module.ExampleOutputs = []*terraform.ExampleOutput{
+		{Example: "complete", Name: "output-1", Value: "vpc-0123456789abcdef"},
+		{Example: "complete", Name: "output-2", Value: []interface{}{"subnet-1", "subnet-2"}},
+		{Example: "complete", Name: "output-3", Sensitive: true},
+		{Example: "simple", Name: "output-1", Value: float64(42)},
+	}
+
+	formatter := NewMarkdownDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownDocumentInputValidations(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Inputs = true
+		c.Settings.ShowValidations = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "document-InputValidations")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	populateInputValidations(module)
+
+	formatter := NewMarkdownDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownDocumentAttributes(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Inputs = true
+		c.Sections.Outputs = true
+		c.Settings.ShowAttributes = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "document-Attributes")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	populateAttributes(module)
+
+	formatter := NewMarkdownDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownDocumentExternalLinks(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.ModuleCalls = true
+		c.Settings.ExternalLinks = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "document-ExternalLinks")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	formatter := NewMarkdownDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownDocumentOutputConsumers(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Outputs = true
+		c.Settings.OutputConsumers = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "document-OutputConsumers")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// the testdata has no sibling modules, populate the consumers directly
+	for _, output := range module.Outputs {
+		switch output.Name {
+		case "output-1":
+			output.Consumers = []string{"app", "web"}
+		case "output-2":
+			output.Consumers = []string{"web"}
+		}
+	}
+
+	formatter := NewMarkdownDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownDocumentInputHistory(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Inputs = true
+		c.Settings.HTML = true
+		c.Settings.InputHistory = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "document-InputHistory")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// not every checkout has the history of the testdata, populate it directly
+	for _, input := range module.Inputs {
+		switch input.Name {
+		case "bool-1":
+			input.DescriptionHistory = []*terraform.DescriptionChange{
+				{Description: "It's bool one.", Date: "2021-05-03"},
+				{Description: "The first bool.", Date: "2021-02-01"},
+			}
+		case "string-2":
+			input.DescriptionHistory = []*terraform.DescriptionChange{
+				{Description: "It's string | two.", Date: "2021-03-15"},
+			}
+		}
+	}
+
+	formatter := NewMarkdownDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownDocumentDarkMode(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Outputs = true
+		c.Settings.HTML = true
+		c.Settings.DarkMode = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "document-DarkMode")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	formatter := NewMarkdownDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownDocumentProviderSourceNamespace(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Providers = true
+		c.Settings.ProviderSourceNamespace = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "document-ProviderSourceNamespace")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	formatter := NewMarkdownDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownDocumentExamplesRunner(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {})
+
+	expected, err := testutil.GetExpected("markdown", "document-ExamplesRunner")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// running the examples requires terraform, populate the validations directly
+	config.Settings.ExamplesRunner = true
+	module.ExampleValidations = []*terraform.ExampleValidation{
+		{Example: "complete", Valid: true},
+		{Example: "minimal", Valid: false, ErrorCount: 2},
+	}
+
+	formatter := NewMarkdownDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownDocumentASCIITypeDiagrams(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Inputs = true
+		c.Settings.Type = true
+		c.Settings.ASCIITypeDiagrams = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "document-ASCIITypeDiagrams")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	formatter := NewMarkdownDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownDocumentVarFileDefaults(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Inputs = true
+		c.Settings.Default = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "document-VarFileDefaults")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// examples doesn't have any tfvars file, populate the values directly
+	config.VarFileDefaults = []string{"prod.tfvars"}
+	for _, input := range module.Inputs {
+		switch input.Name {
+		case "string-1", "string-2":
+			input.EnvDefault = types.ValueOf("prod")
+		case "number-2":
+			input.EnvDefault = types.ValueOf(float64(3))
+		}
+	}
+
+	formatter := NewMarkdownDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownDocumentShowDefaultsType(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Inputs = true
+		c.Settings.Default = true
+		c.Settings.ShowDefaultsType = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "document-ShowDefaultsType")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	formatter := NewMarkdownDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownDocumentModuleCallGraph(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.ModuleCalls = true
+		c.Settings.ModuleCallGraph = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "document-ModuleCallGraph")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// examples doesn't call local modules, populate the tree directly
+	module.ModuleCallTree = []*terraform.ModuleCallNode{
+		{Name: "bar", Source: "baz"},
+		{Name: "baz", Source: "baz"},
+		{
+			Name:   "foo",
+			Source: "bar",
+			Children: []*terraform.ModuleCallNode{
+				{Name: "network", Source: "./modules/network"},
+			},
+		},
+		{Name: "foobar", Source: "git@github.com:module/path"},
+	}
+
+	formatter := NewMarkdownDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownDocumentTfsecResults(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {})
+
+	expected, err := testutil.GetExpected("markdown", "document-TfsecResults")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// running the scanner requires tfsec, populate the findings directly
+	config.Settings.TfsecResults = true
+	module.SecurityFindings = []*terraform.SecurityFinding{
+		{RuleID: "AVD-AWS-0107", Severity: terraform.SeverityCritical, Description: "Security group rule allows ingress from public internet.", File: "main.tf", Line: 5},
+		{RuleID: "AVD-AWS-0088", Severity: terraform.SeverityHigh, Description: "Bucket does not have encryption enabled", File: "main.tf", Line: 12},
+		{RuleID: "AVD-AWS-0089", Severity: terraform.SeverityMedium, Description: "S3 Bucket does not have logging | enabled.", File: "modules/logs/main.tf", Line: 1},
+	}
+
+	formatter := NewMarkdownDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownDocumentTagPolicy(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Resources = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "document-TagPolicy")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// examples doesn't have a tag policy, populate the compliance directly
+	compliance := map[string]*terraform.TagCompliance{
+		"tls_private_key.baz": {Status: terraform.TagsCompliant},
+		"foo_resource.baz":    {Status: terraform.TagsNonCompliant, Missing: []string{"owner", "cost-centre"}},
+		"null_resource.foo":   {Status: terraform.TagsCheckManually},
+	}
+	for _, r := range module.Resources {
+		r.TagCompliance = compliance[r.Spec()]
+	}
+
+	formatter := NewMarkdownDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownDocumentS3Backend(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) { c.Settings.S3BackendDocs = true })
+
+	expected, err := testutil.GetExpected("markdown", "document-S3Backend")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// examples module doesn't have a backend, populate it directly
+	module.Backend = &terraform.Backend{
+		Type: "s3",
+		Attributes: []*terraform.BackendAttribute{
+			{Name: "bucket", Value: `"<bucket>"`},
+			{Name: "key", Value: `"<key>"`},
+			{Name: "region", Value: `"eu-west-1"`},
+		},
+	}
+
+	formatter := NewMarkdownDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownDocumentPinnedCoreVersion(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) { c.Sections.Requirements = true })
+
+	expected, err := testutil.GetExpected("markdown", "document-PinnedCoreVersion")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// examples module doesn't have a .terraform-version file, populate it directly
+	module.PinnedCoreVersion = "1.5.7"
+
+	formatter := NewMarkdownDocument(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
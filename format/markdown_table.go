@@ -28,6 +28,7 @@ type markdownTable struct {
 
 	config   *print.Config
 	template *template.Template
+	sections []string
 }
 
 // NewMarkdownTable returns new instance of Markdown Table.
@@ -36,7 +37,78 @@ func NewMarkdownTable(config *print.Config) Type {
 
 	tt := template.New(config, items...)
 	tt.CustomFunc(gotemplate.FuncMap{
+		"variableExampleBlock": func(inputs []*terraform.Input) string {
+			return printVariableExampleBlock(inputs)
+		},
+		"inputHCL": func(input *terraform.Input) string {
+			code, _ := PrintFencedCodeBlock(printInputHCL(input), "")
+			return code
+		},
+		"cardinality": func(input *terraform.Input) string {
+			return printCardinality(config, input)
+		},
+		"cloudEmoji": func(cloud string) string {
+			return printCloudEmoji(cloud)
+		},
+		"inputsPagination": func(inputs []*terraform.Input) string {
+			return printInputsPagination(config, inputs)
+		},
+		"tableOfContents": func(module *terraform.Module) string {
+			return printTableOfContents(config, module, false)
+		},
+		"inputsSearch": func(inputs []*terraform.Input) string {
+			return printInputsSearch(config, inputs)
+		},
+		"providerNamespace": func(provider *terraform.Provider) string {
+			return printProviderNamespace(provider)
+		},
+		"tagCompliance": func(compliance *terraform.TagCompliance) string {
+			return printTagCompliance(compliance)
+		},
+		"severity": func(severity string) string {
+			return printSeverity(severity)
+		},
+		"testStatus": func(status string) string {
+			return printTestStatus(status)
+		},
+		"testVariables": func(variables []*terraform.TestVariable) string {
+			return printTestVariables(variables)
+		},
+		"badges": func(module *terraform.Module) string {
+			return printBadges(config, module)
+		},
+		"changelog": func(changelog string) string {
+			return printChangelog(config, changelog)
+		},
+		"moduleSource": func(modulecall *terraform.ModuleCall) string {
+			return printModuleSource(config, modulecall)
+		},
+		"backendConfig": func(backend *terraform.Backend) string {
+			return printBackendConfig(backend)
+		},
+		"hclExample": func(module *terraform.Module) string {
+			return printHCLExample(config, module)
+		},
+		"dependencyGraph": func(module *terraform.Module) string {
+			return printDependencyGraph(config, module)
+		},
+		"moduleCallGraph": func(module *terraform.Module) string {
+			return printModuleCallGraph(module)
+		},
+		"typeDiagram": func(input *terraform.Input) string {
+			return printTypeDiagram(config, input.Name, string(input.Type))
+		},
+		"darkModeStyle": func() (string, error) {
+			return printDarkModeStyle(config)
+		},
+		"configLink": func() string {
+			return printConfigLink(config)
+		},
 		"type": func(t string) string {
+			// complex types are rendered as diagram below the table instead
+			if node := newTypeNode(t); node != nil && config.Settings.ASCIITypeDiagrams {
+				t = node.Label
+			}
 			inputType, _ := PrintFencedCodeBlock(t, "")
 			return inputType
 		},
@@ -53,13 +125,20 @@ func NewMarkdownTable(config *print.Config) Type {
 		generator: newGenerator(config, true),
 		config:    config,
 		template:  tt,
+		sections:  readSectionNames(items),
 	}
 }
 
 // Generate a Terraform module as Markdown tables.
 func (t *markdownTable) Generate(module *terraform.Module) error {
 	err := t.generator.forEach(func(name string) (string, error) {
-		rendered, err := t.template.Render(name, module)
+		var rendered string
+		var err error
+		if name == "all" && t.config.Settings.SectionSeparators {
+			rendered, err = renderSections(t.template, t.sections, module)
+		} else {
+			rendered, err = t.template.Render(name, module)
+		}
 		if err != nil {
 			return "", err
 		}
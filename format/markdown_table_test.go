@@ -11,12 +11,16 @@ the root directory of this source tree.
 package format
 
 import (
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/terraform-docs/terraform-docs/internal/testutil"
+	"github.com/terraform-docs/terraform-docs/internal/types"
 	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
 )
 
 func TestMarkdownTable(t *testing.T) {
@@ -118,6 +122,190 @@ func TestMarkdownTable(t *testing.T) {
 				}),
 			),
 		},
+		"IndentationLevelOne": {
+			config: testutil.WithSections(
+				testutil.WithHTML(),
+				testutil.With(func(c *print.Config) {
+					c.Settings.IndentationLevel = 1
+				}),
+			),
+		},
+		"IndentationLevelTwo": {
+			config: testutil.WithSections(
+				testutil.WithHTML(),
+				testutil.With(func(c *print.Config) {
+					c.Settings.IndentationLevel = 2
+				}),
+			),
+		},
+		"IndentationLevelThree": {
+			config: testutil.WithSections(
+				testutil.WithHTML(),
+				testutil.With(func(c *print.Config) {
+					c.Settings.IndentationLevel = 3
+				}),
+			),
+		},
+		"CompatibilityMatrix": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Requirements = true
+				c.Settings.ProviderSourceVersionMatrix = true
+			}),
+		},
+		"ShowChecks": {
+			config: testutil.With(func(c *print.Config) {
+				c.Settings.ShowChecks = true
+			}),
+		},
+		"ProviderVersionBadges": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Header = true
+				c.Settings.ProviderVersionBadges = true
+				c.Settings.RequiredVersionBadge = true
+			}),
+		},
+		"RequiredVersionBadge": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Header = true
+				c.Settings.RequiredVersionBadge = true
+			}),
+		},
+		"ModuleRegistryLink": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Header = true
+				c.Registry.Enabled = true
+				c.Registry.Namespace = "terraform-docs"
+				c.Registry.Name = "example"
+				c.Registry.Provider = "aws"
+			}),
+		},
+		"ShowSummary": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Header = true
+				c.Sections.Inputs = true
+				c.Settings.ShowSummary = true
+			}),
+		},
+		"TftestExamples": {
+			config: testutil.With(func(c *print.Config) {
+				c.Settings.TftestExamples = true
+			}),
+		},
+		"GraphFormatMermaid": {
+			config: testutil.With(func(c *print.Config) {
+				c.Settings.GraphFormat = print.GraphFormatMermaid
+			}),
+		},
+		"GraphFormatDot": {
+			config: testutil.With(func(c *print.Config) {
+				c.Settings.GraphFormat = print.GraphFormatDot
+			}),
+		},
+		"GraphFormatD2": {
+			config: testutil.With(func(c *print.Config) {
+				c.Settings.GraphFormat = print.GraphFormatD2
+			}),
+		},
+		"HCLExamples": {
+			config: testutil.With(func(c *print.Config) {
+				c.Settings.HCLExamples = true
+			}),
+		},
+		"InputHCL": {
+			config: testutil.WithHTML(
+				testutil.With(func(c *print.Config) {
+					c.Sections.Inputs = true
+					c.Settings.Type = true
+					c.Settings.Default = true
+					c.Settings.InputHCL = true
+				}),
+			),
+		},
+		"VariableExampleBlock": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Inputs = true
+				c.Settings.Type = true
+				c.Settings.Default = true
+				c.Settings.VariableExampleBlock = true
+			}),
+		},
+		"Changelog": {
+			config: testutil.WithSections(
+				testutil.WithHTML(),
+				testutil.With(func(c *print.Config) {
+					c.Changelog.Enabled = true
+					c.Changelog.Versions = 2
+				}),
+			),
+		},
+		"ConfigLink": {
+			config: testutil.WithSections(
+				testutil.WithHTML(),
+				testutil.With(func(c *print.Config) {
+					c.ConfigLink.Enabled = true
+					c.ConfigLink.Path = "examples/.terraform-docs.yml"
+				}),
+			),
+		},
+		"ConfigLinkRepoURL": {
+			config: testutil.WithSections(
+				testutil.WithHTML(),
+				testutil.With(func(c *print.Config) {
+					c.ConfigLink.Enabled = true
+					c.ConfigLink.RepoURL = "https://github.com/terraform-docs/terraform-docs/blob/main/"
+					c.ConfigLink.Path = "examples/.terraform-docs.yml"
+				}),
+			),
+		},
+		"Deprecations": {
+			config: testutil.WithSections(
+				testutil.WithHTML(),
+				testutil.With(func(c *print.Config) {
+					c.DeprecationsFrom = "DEPRECATIONS.md"
+				}),
+			),
+		},
+		"SectionSeparators": {
+			config: testutil.WithSections(
+				testutil.WithHTML(),
+				testutil.With(func(c *print.Config) {
+					c.Settings.SectionSeparators = true
+				}),
+			),
+		},
+		"TableOfContents": {
+			config: testutil.WithSections(
+				testutil.With(func(c *print.Config) {
+					c.Settings.Anchor = true
+					c.Settings.Required = true
+					c.Settings.TableOfContents = true
+				}),
+			),
+		},
+		"TableOfContentsWithoutAnchor": {
+			config: testutil.WithSections(
+				testutil.WithHideEmpty(),
+				testutil.With(func(c *print.Config) {
+					c.Settings.Anchor = false
+					c.Settings.TableOfContents = true
+				}),
+			),
+		},
+		"ShowLifecycleConditions": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Outputs = true
+				c.Settings.ShowLifecycleConditions = true
+			}),
+		},
+		"AzureDevOpsWiki": {
+			config: testutil.WithSections(
+				testutil.WithHTML(),
+				testutil.With(func(c *print.Config) {
+					c.Settings.Anchor = true
+					c.Settings.AzureDevOpsWiki = true
+				}),
+			),
+		},
 		"OutputValues": {
 			config: testutil.WithHTML(
 				testutil.With(func(c *print.Config) {
@@ -138,6 +326,12 @@ func TestMarkdownTable(t *testing.T) {
 				}),
 			),
 		},
+		"OutputValueType": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Outputs = true
+				c.Settings.OutputValueType = true
+			}),
+		},
 
 		// Only section
 		"OnlyDataSources": {
@@ -194,3 +388,960 @@ func TestMarkdownTable(t *testing.T) {
 		})
 	}
 }
+
+func TestMarkdownTableExamplePlan(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) { c.Sections.Resources = true })
+
+	expected, err := testutil.GetExpected("markdown", "table-ExamplePlan")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// 'terraform plan' can't be run in tests, populate summary of it directly
+	module.ExamplePlan = []*terraform.PlannedResource{
+		{Type: "null_resource", Count: 2},
+		{Type: "tls_private_key", Count: 1},
+	}
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableProviderVersionChanges(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Providers = true
+		c.LockDiff.Enabled = true
+		c.LockDiff.From = "HEAD~1"
+		c.LockDiff.To = "HEAD"
+	})
+
+	expected, err := testutil.GetExpected("markdown", "table-ProviderVersionChanges")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// examples module is not a Git repository, populate the changes directly
+	module.LockChanges = []*terraform.ProviderVersionChange{
+		{Name: "hashicorp/aws", OldVersion: "5.79.0", NewVersion: "5.80.0"},
+		{Name: "hashicorp/random", OldVersion: "3.6.0", NewVersion: ""},
+		{Name: "hashicorp/tls", OldVersion: "", NewVersion: "4.0.6"},
+	}
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableEphemeralResources(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Resources = true
+		c.Settings.ShowEphemeralResources = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "table-EphemeralResources")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// examples module doesn't have any ephemeral blocks, populate them directly
+	module.EphemeralResources = []*terraform.EphemeralResource{
+		{Type: "password", Name: "db", ProviderName: "random", ProviderSource: "hashicorp/random", Version: types.String("latest")},
+		{Type: "secret_version", Name: "db", ProviderName: "vault", ProviderSource: "acme.com/acme/vault", Version: types.String("1.0.0")},
+	}
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableLicense(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Header = true
+		c.Settings.License = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "table-License")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// examples module doesn't have a LICENSE file, populate it directly
+	module.License = "MIT"
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableCostEstimate(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) { c.Sections.Header = true })
+
+	expected, err := testutil.GetExpected("markdown", "table-CostEstimate")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// infracost is not available in tests, populate the estimate directly
+	module.CostEstimate = &terraform.CostEstimate{
+		Currency:             "USD",
+		TotalMonthlyCost:     "42.851",
+		DiffTotalMonthlyCost: "42.851",
+	}
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableModuleHealthScore(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Header = true
+		c.HealthScore = print.DefaultConfig().HealthScore
+		c.HealthScore.Enabled = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "table-ModuleHealthScore")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableSensitiveSummary(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) { c.Sections.Header = true })
+
+	expected, err := testutil.GetExpected("markdown", "table-SensitiveSummary")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// examples module doesn't have sensitive inputs, populate the counts directly
+	module.SensitiveSummary = &terraform.SensitiveSummary{
+		Inputs:  3,
+		Outputs: 2,
+	}
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableInputCardinality(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Inputs = true
+		c.Settings.Type = true
+		c.Settings.InputCardinality = print.CardinalityUML
+	})
+
+	expected, err := testutil.GetExpected("markdown", "table-InputCardinality")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableAutoDetectRegions(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Inputs = true
+		c.Settings.AutoDetectRegions = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "table-AutoDetectRegions")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// examples module doesn't have region inputs, populate the clouds directly
+	clouds := map[string]string{
+		"string-1": terraform.CloudAWS,
+		"string-2": terraform.CloudGCP,
+		"string-3": terraform.CloudAzure,
+	}
+	for _, input := range module.Inputs {
+		input.Cloud = clouds[input.Name]
+	}
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableOutputDeprecation(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Outputs = true
+		c.Settings.OutputDeprecation = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "table-OutputDeprecation")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// examples module doesn't have deprecated outputs, populate it directly
+	for _, output := range module.Outputs {
+		if output.Name == "output-1" {
+			output.Deprecated = "Use module.new_module.output-1 instead."
+		}
+	}
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableRunTests(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {})
+
+	expected, err := testutil.GetExpected("markdown", "table-RunTests")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// running the tests requires terraform, populate the results directly
+	config.Settings.RunTests = true
+	module.TestResults = []*terraform.TestSuite{
+		{
+			Path:    "tests/main.tftest.hcl",
+			Passed:  1,
+			Failed:  1,
+			Skipped: 1,
+			Tests: []*terraform.TestResult{
+				{Name: "valid", Status: terraform.TestStatusPass},
+				{Name: "invalid", Status: terraform.TestStatusFail},
+				{Name: "skipped", Status: terraform.TestStatusSkip},
+			},
+		},
+	}
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableExampleOutputs(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Outputs = true
+		c.Settings.ExampleOutputs = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "table-ExampleOutputs")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// reading the outputs requires terraform, populate the values directly
+	module.ExampleOutputs = []*terraform.ExampleOutput{
+		{Example: "complete", Name: "output-1", Value: "vpc-0123456789abcdef"},
+		{Example: "complete", Name: "output-2", Value: []interface{}{"subnet-1", "subnet-2"}},
+		{Example: "complete", Name: "output-3", Sensitive: true},
+		{Example: "simple", Name: "output-1", Value: float64(42)},
+	}
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableInputValidations(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Inputs = true
+		c.Settings.ShowValidations = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "table-InputValidations")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	populateInputValidations(module)
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableAttributes(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Inputs = true
+		c.Sections.Outputs = true
+		c.Settings.ShowAttributes = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "table-Attributes")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	populateAttributes(module)
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableVariableSummaryTable(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Inputs = true
+		c.Settings.VariableSummaryTable = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "table-VariableSummaryTable")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableExternalLinks(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.ModuleCalls = true
+		c.Settings.ExternalLinks = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "table-ExternalLinks")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableOutputConsumers(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Outputs = true
+		c.Settings.OutputConsumers = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "table-OutputConsumers")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// the testdata has no sibling modules, populate the consumers directly
+	for _, output := range module.Outputs {
+		switch output.Name {
+		case "output-1":
+			output.Consumers = []string{"app", "web"}
+		case "output-2":
+			output.Consumers = []string{"web"}
+		}
+	}
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableInputHistory(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Inputs = true
+		c.Settings.HTML = true
+		c.Settings.InputHistory = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "table-InputHistory")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// not every checkout has the history of the testdata, populate it directly
+	for _, input := range module.Inputs {
+		switch input.Name {
+		case "bool-1":
+			input.DescriptionHistory = []*terraform.DescriptionChange{
+				{Description: "It's bool one.", Date: "2021-05-03"},
+				{Description: "The first bool.", Date: "2021-02-01"},
+			}
+		case "string-2":
+			input.DescriptionHistory = []*terraform.DescriptionChange{
+				{Description: "It's string | two.", Date: "2021-03-15"},
+			}
+		}
+	}
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableDarkMode(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Outputs = true
+		c.Settings.HTML = true
+		c.Settings.DarkMode = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "table-DarkMode")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableSearchableTable(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Inputs = true
+		c.Settings.HTML = true
+		c.Settings.SearchableTable = true
+		c.Settings.Type = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "table-SearchableTable")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableInputTablePagination(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Inputs = true
+		c.Settings.HTML = true
+		c.Settings.InputTablePagination = true
+		c.Settings.PaginationSize = 5
+	})
+
+	expected, err := testutil.GetExpected("markdown", "table-InputTablePagination")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableProviderSourceNamespace(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Providers = true
+		c.Settings.ProviderSourceNamespace = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "table-ProviderSourceNamespace")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableExamplesRunner(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {})
+
+	expected, err := testutil.GetExpected("markdown", "table-ExamplesRunner")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// running the examples requires terraform, populate the validations directly
+	config.Settings.ExamplesRunner = true
+	module.ExampleValidations = []*terraform.ExampleValidation{
+		{Example: "complete", Valid: true},
+		{Example: "minimal", Valid: false, ErrorCount: 2},
+	}
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableASCIITypeDiagrams(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Inputs = true
+		c.Settings.Type = true
+		c.Settings.ASCIITypeDiagrams = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "table-ASCIITypeDiagrams")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableVarFileDefaults(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Inputs = true
+		c.Settings.Default = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "table-VarFileDefaults")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// examples doesn't have any tfvars file, populate the values directly
+	config.VarFileDefaults = []string{"prod.tfvars"}
+	for _, input := range module.Inputs {
+		switch input.Name {
+		case "string-1", "string-2":
+			input.EnvDefault = types.ValueOf("prod")
+		case "number-2":
+			input.EnvDefault = types.ValueOf(float64(3))
+		}
+	}
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableShowDefaultsType(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Inputs = true
+		c.Settings.Default = true
+		c.Settings.ShowDefaultsType = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "table-ShowDefaultsType")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableModuleCallGraph(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.ModuleCalls = true
+		c.Settings.ModuleCallGraph = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "table-ModuleCallGraph")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// examples doesn't call local modules, populate the tree directly
+	module.ModuleCallTree = []*terraform.ModuleCallNode{
+		{Name: "bar", Source: "baz"},
+		{Name: "baz", Source: "baz"},
+		{
+			Name:   "foo",
+			Source: "bar",
+			Children: []*terraform.ModuleCallNode{
+				{Name: "network", Source: "./modules/network"},
+			},
+		},
+		{Name: "foobar", Source: "git@github.com:module/path"},
+	}
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableTfsecResults(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {})
+
+	expected, err := testutil.GetExpected("markdown", "table-TfsecResults")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// running the scanner requires tfsec, populate the findings directly
+	config.Settings.TfsecResults = true
+	module.SecurityFindings = []*terraform.SecurityFinding{
+		{RuleID: "AVD-AWS-0107", Severity: terraform.SeverityCritical, Description: "Security group rule allows ingress from public internet.", File: "main.tf", Line: 5},
+		{RuleID: "AVD-AWS-0088", Severity: terraform.SeverityHigh, Description: "Bucket does not have encryption enabled", File: "main.tf", Line: 12},
+		{RuleID: "AVD-AWS-0089", Severity: terraform.SeverityMedium, Description: "S3 Bucket does not have logging | enabled.", File: "modules/logs/main.tf", Line: 1},
+	}
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableTagPolicy(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Resources = true
+	})
+
+	expected, err := testutil.GetExpected("markdown", "table-TagPolicy")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// examples doesn't have a tag policy, populate the compliance directly
+	compliance := map[string]*terraform.TagCompliance{
+		"tls_private_key.baz": {Status: terraform.TagsCompliant},
+		"foo_resource.baz":    {Status: terraform.TagsNonCompliant, Missing: []string{"owner", "cost-centre"}},
+		"null_resource.foo":   {Status: terraform.TagsCheckManually},
+	}
+	for _, r := range module.Resources {
+		r.TagCompliance = compliance[r.Spec()]
+	}
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableS3Backend(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) { c.Settings.S3BackendDocs = true })
+
+	expected, err := testutil.GetExpected("markdown", "table-S3Backend")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// examples module doesn't have a backend, populate it directly
+	module.Backend = &terraform.Backend{
+		Type: "s3",
+		Attributes: []*terraform.BackendAttribute{
+			{Name: "bucket", Value: `"<bucket>"`},
+			{Name: "key", Value: `"<key>"`},
+			{Name: "region", Value: `"eu-west-1"`},
+		},
+	}
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTablePinnedCoreVersion(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) { c.Sections.Requirements = true })
+
+	expected, err := testutil.GetExpected("markdown", "table-PinnedCoreVersion")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// examples module doesn't have a .terraform-version file, populate it directly
+	module.PinnedCoreVersion = "1.5.7"
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestMarkdownTableColumns(t *testing.T) {
+	tests := map[string]func(c *print.Config){
+		"All":           func(c *print.Config) {},
+		"HideType":      func(c *print.Config) { c.Settings.Type = false },
+		"HideDefault":   func(c *print.Config) { c.Settings.Default = false },
+		"HideRequired":  func(c *print.Config) { c.Settings.Required = false },
+		"HideSensitive": func(c *print.Config) { c.Settings.Sensitive = false },
+		"HideAll": func(c *print.Config) {
+			c.Settings.Type = false
+			c.Settings.Default = false
+			c.Settings.Required = false
+			c.Settings.Sensitive = false
+		},
+	}
+	for name, hide := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := testutil.WithHTML(
+				testutil.With(func(c *print.Config) {
+					c.Sections.Inputs = true
+					c.Sections.Outputs = true
+					c.OutputValues.Enabled = true
+					c.OutputValues.From = "output_values.json"
+					c.Settings.Type = true
+					c.Settings.Default = true
+					c.Settings.Required = true
+					c.Settings.Sensitive = true
+					hide(c)
+				}),
+			)
+
+			module, err := testutil.GetModule(&config)
+			assert.Nil(err)
+
+			formatter := NewMarkdownTable(&config)
+
+			err = formatter.Generate(module)
+			assert.Nil(err)
+
+			tables := markdownTables(formatter.Content())
+			assert.Len(tables, 2)
+
+			for _, table := range tables {
+				assertMarkdownTable(t, table)
+			}
+		})
+	}
+}
+
+// markdownTables returns the Markdown tables (i.e. consecutive lines starting
+// with '|') found in the given content.
+func markdownTables(content string) [][]string {
+	tables := [][]string{}
+	current := []string{}
+
+	for _, line := range strings.Split(content+"\n", "\n") {
+		if strings.HasPrefix(line, "|") {
+			current = append(current, line)
+			continue
+		}
+		if len(current) > 0 {
+			tables = append(tables, current)
+			current = []string{}
+		}
+	}
+
+	return tables
+}
+
+// assertMarkdownTable asserts the header, the delimiter row and all the rows
+// of the table have the same number of cells.
+func assertMarkdownTable(t *testing.T, table []string) {
+	assert := assert.New(t)
+
+	// unescaped pipe, escaped ones (i.e. '\|') are part of the cell content
+	pipe := regexp.MustCompile(`(^|[^\\])\|`)
+	cells := func(line string) int {
+		return len(pipe.FindAllStringIndex(line, -1)) - 1
+	}
+
+	if !assert.GreaterOrEqual(len(table), 2) {
+		return
+	}
+
+	header := cells(table[0])
+	assert.GreaterOrEqual(header, 2, "Name and Description columns must be visible")
+	assert.True(strings.HasPrefix(table[0], "| Name | Description |"))
+	assert.Regexp(`^\|(:?-+:?\|)+$`, table[1])
+	for _, row := range table[1:] {
+		assert.Equal(header, cells(row), row)
+	}
+}
+
+func TestMarkdownTableMoved(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) { c.Settings.ShowMoved = true })
+
+	expected, err := testutil.GetExpected("markdown", "table-Moved")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// examples module doesn't have any moved blocks, populate them directly
+	module.Moved = []*terraform.Moved{
+		{From: types.String("null_resource.foo"), To: types.String("null_resource.bar[0]")},
+		{From: types.String(`module.foo["key"]`), To: types.String("module.baz")},
+	}
+
+	formatter := NewMarkdownTable(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
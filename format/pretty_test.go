@@ -11,6 +11,7 @@ the root directory of this source tree.
 package format
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -49,6 +50,13 @@ func TestPretty(t *testing.T) {
 				}),
 			),
 		},
+		"Compact": {
+			config: testutil.WithSections(
+				testutil.With(func(c *print.Config) {
+					c.Settings.Compact = true
+				}),
+			),
+		},
 		"OutputValues": {
 			config: testutil.With(func(c *print.Config) {
 				c.Sections.Outputs = true
@@ -109,3 +117,85 @@ func TestPretty(t *testing.T) {
 		})
 	}
 }
+
+func TestPrettyCompactEntries(t *testing.T) {
+	assert := assert.New(t)
+
+	count := func(compact bool) int {
+		config := testutil.WithSections(
+			testutil.With(func(c *print.Config) {
+				c.Settings.Compact = compact
+			}),
+		)
+
+		module, err := testutil.GetModule(&config)
+		assert.Nil(err)
+
+		formatter := NewPretty(&config)
+
+		err = formatter.Generate(module)
+		assert.Nil(err)
+
+		entries := 0
+		for _, line := range strings.Split(formatter.Content(), "\n") {
+			if strings.HasPrefix(line, "input.") || strings.HasPrefix(line, "output.") {
+				entries++
+			}
+		}
+		return entries
+	}
+
+	expected := count(false)
+
+	assert.NotZero(expected)
+	assert.Equal(expected, count(true))
+}
+
+func TestPrettyInputValidations(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Inputs = true
+		c.Settings.ShowValidations = true
+	})
+
+	expected, err := testutil.GetExpected("pretty", "pretty-InputValidations")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	populateInputValidations(module)
+
+	formatter := NewPretty(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestPrettyAttributes(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Inputs = true
+		c.Sections.Outputs = true
+		c.Settings.ShowAttributes = true
+	})
+
+	expected, err := testutil.GetExpected("pretty", "pretty-Attributes")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	populateAttributes(module)
+
+	formatter := NewPretty(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
@@ -42,7 +42,7 @@ func (t *toml) Generate(module *terraform.Module) error {
 	buffer := new(bytes.Buffer)
 	encoder := tomlsdk.NewEncoder(buffer)
 
-	if err := encoder.Encode(copy); err != nil {
+	if err := encoder.Encode(hideEmptySections(t.config, copy)); err != nil {
 		return err
 	}
 
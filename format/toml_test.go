@@ -16,7 +16,9 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/terraform-docs/terraform-docs/internal/testutil"
+	"github.com/terraform-docs/terraform-docs/internal/types"
 	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
 )
 
 func TestToml(t *testing.T) {
@@ -34,6 +36,14 @@ func TestToml(t *testing.T) {
 				}),
 			),
 		},
+		"HideEmpty": {
+			config: testutil.WithDefaultSections(
+				testutil.WithHideEmpty(),
+				testutil.With(func(c *print.Config) {
+					c.ModuleRoot = "empty"
+				}),
+			),
+		},
 		"HideAll": {
 			config: testutil.With(func(c *print.Config) {
 				c.Sections.Header = false // Since we don't show the header, the file won't be loaded at all
@@ -102,3 +112,31 @@ func TestToml(t *testing.T) {
 		})
 	}
 }
+
+func TestTomlEphemeralResources(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Resources = true
+		c.Settings.ShowEphemeralResources = true
+	})
+
+	expected, err := testutil.GetExpected("toml", "toml-EphemeralResources")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// examples module doesn't have any ephemeral blocks, populate them directly
+	module.EphemeralResources = []*terraform.EphemeralResource{
+		{Type: "password", Name: "db", ProviderName: "random", ProviderSource: "hashicorp/random", Version: types.String("latest")},
+		{Type: "secret_version", Name: "db", ProviderName: "vault", ProviderSource: "acme.com/acme/vault", Version: types.String("1.0.0")},
+	}
+
+	formatter := NewTOML(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
@@ -12,6 +12,8 @@ package format
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/terraform-docs/terraform-docs/print"
 	"github.com/terraform-docs/terraform-docs/terraform"
@@ -51,6 +53,16 @@ func register(e map[string]initializerFn) {
 	}
 }
 
+// names returns the sorted list of names of all registered formatters.
+func names() []string {
+	list := make([]string, 0, len(initializers))
+	for name := range initializers {
+		list = append(list, name)
+	}
+	sort.Strings(list)
+	return list
+}
+
 // New initializes and returns the concrete implementation of
 // format.Engine based on the provided 'name', for example for name
 // of 'json' it will return '*format.JSON' through 'format.NewJSON'
@@ -59,7 +71,7 @@ func New(config *print.Config) (Type, error) {
 	name := config.Formatter
 	fn, ok := initializers[name]
 	if !ok {
-		return nil, fmt.Errorf("formatter '%s' not found", name)
+		return nil, fmt.Errorf("unknown formatter %q; available formatters: %s", name, strings.Join(names(), ", "))
 	}
 	return fn(config), nil
 }
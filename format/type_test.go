@@ -12,10 +12,12 @@ package format
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/terraform-docs/terraform-docs/internal/testutil"
 	"github.com/terraform-docs/terraform-docs/print"
 )
 
@@ -92,6 +94,18 @@ func TestFormatType(t *testing.T) {
 			expected: "*format.json",
 			wantErr:  false,
 		},
+		{
+			name:     "format type from name",
+			format:   "html",
+			expected: "*format.htmlDocument",
+			wantErr:  false,
+		},
+		{
+			name:     "format type from name",
+			format:   "jsonschema",
+			expected: "*format.jsonSchema",
+			wantErr:  false,
+		},
 		{
 			name:     "format type from name",
 			format:   "markdown",
@@ -210,3 +224,85 @@ func TestFormatType(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatTypeUnknown(t *testing.T) {
+	assert := assert.New(t)
+
+	config := print.DefaultConfig()
+	config.Formatter = "markdwon"
+
+	_, err := New(config)
+
+	assert.NotNil(err)
+	assert.Equal(
+		`unknown formatter "markdwon"; available formatters: `+
+			"adoc, adoc doc, adoc document, adoc table, adoc tbl, "+
+			"asciidoc, asciidoc doc, asciidoc document, asciidoc table, asciidoc tbl, "+
+			"confluence, html, json, jsonschema, "+
+			"markdown, markdown doc, markdown document, markdown table, markdown tbl, "+
+			"md, md doc, md document, md table, md tbl, "+
+			"pretty, tfvars hcl, tfvars json, toml, xml, yaml",
+		err.Error(),
+	)
+}
+
+func TestFormatTypeExcludes(t *testing.T) {
+	config := testutil.WithSections()
+	config.Exclude.Variables = []string{"number-*", "*_empty"}
+	config.Exclude.Outputs = []string{"output-*"}
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(t, err)
+
+	for _, name := range names() {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			cfg := config
+			cfg.Formatter = name
+
+			formatter, err := New(&cfg)
+			assert.Nil(err)
+
+			err = formatter.Generate(module)
+			assert.Nil(err)
+
+			// names are escaped by some of the formatters (e.g. 'string\_default\_empty')
+			content := strings.ReplaceAll(formatter.Content(), "\\", "")
+
+			assert.Contains(content, "string-1")
+			for _, excluded := range []string{"number-1", "number-4", "string_default_empty", "list_default_empty", "output-1", "output-0.12"} {
+				assert.NotContains(content, excluded)
+			}
+		})
+	}
+}
+
+func TestFormatTypeRedactedDefaults(t *testing.T) {
+	config := testutil.WithSections()
+	config.Sensitive.RedactDefaults = true
+	config.Sensitive.Patterns = []string{"^string-1$"}
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(t, err)
+
+	for _, name := range names() {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			cfg := config
+			cfg.Formatter = name
+
+			formatter, err := New(&cfg)
+			assert.Nil(err)
+
+			err = formatter.Generate(module)
+			assert.Nil(err)
+
+			// redacted value is escaped by some of the formatters (e.g. '\[REDACTED\]')
+			content := strings.ReplaceAll(formatter.Content(), "\\", "")
+
+			assert.Contains(content, "[REDACTED]")
+		})
+	}
+}
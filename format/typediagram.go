@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// typeNode is a node of the ASCII tree diagram of a type constraint, e.g. an
+// attribute of an 'object' type. Only 'object' types (including the ones which
+// are the element of collections) have children.
+type typeNode struct {
+	Label    string
+	Children []*typeNode
+}
+
+// newTypeNode returns the tree of the type constraint 't' (e.g. 'object({ name
+// = string })'), or nil if it's not complex (i.e. doesn't contain any 'object')
+// or can't be parsed.
+func newTypeNode(t string) *typeNode {
+	src := []byte(t)
+	expr, diags := hclsyntax.ParseExpression(src, "", hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil
+	}
+	node := parseTypeNode(expr, src)
+	if len(node.Children) == 0 {
+		return nil
+	}
+	return node
+}
+
+// parseTypeNode returns the node of the type constraint expression. Type of
+// collections is flattened into the label of their element, e.g. a list of
+// objects is 'list(object)' with the attributes of the object as children.
+func parseTypeNode(expr hclsyntax.Expression, src []byte) *typeNode {
+	call, ok := expr.(*hclsyntax.FunctionCallExpr)
+	if !ok || len(call.Args) == 0 {
+		return &typeNode{Label: typeSource(expr, src)}
+	}
+
+	switch call.Name {
+	case "object":
+		object, ok := call.Args[0].(*hclsyntax.ObjectConsExpr)
+		if !ok {
+			break
+		}
+		node := &typeNode{Label: "object", Children: make([]*typeNode, 0, len(object.Items))}
+		for _, item := range object.Items {
+			attribute := parseTypeNode(item.ValueExpr, src)
+			attribute.Label = fmt.Sprintf("%s: %s", hcl.ExprAsKeyword(item.KeyExpr), attribute.Label)
+			node.Children = append(node.Children, attribute)
+		}
+		return node
+	case "list", "map", "set", "optional":
+		// default value of 'optional' (i.e. its second argument) is ignored
+		element := parseTypeNode(call.Args[0], src)
+		if len(element.Children) == 0 {
+			break
+		}
+		return &typeNode{
+			Label:    fmt.Sprintf("%s(%s)", call.Name, element.Label),
+			Children: element.Children,
+		}
+	}
+
+	return &typeNode{Label: typeSource(expr, src)}
+}
+
+// typeSource returns the source of the type constraint expression in a single
+// line, e.g. 'list(string)'.
+func typeSource(expr hclsyntax.Expression, src []byte) string {
+	return strings.Join(strings.Fields(string(expr.Range().SliceBytes(src))), " ")
+}
+
+// Render returns the ASCII tree diagram of the node with box-drawing characters,
+// with 'root' as the label of the node itself if provided.
+func (n *typeNode) Render(root string) string {
+	var b strings.Builder
+	if root == "" {
+		root = n.Label
+	}
+	b.WriteString(root)
+	b.WriteString("\n")
+	n.renderChildren(&b, "")
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func (n *typeNode) renderChildren(b *strings.Builder, prefix string) {
+	for i, child := range n.Children {
+		connector, indent := "├── ", "│   "
+		if i == len(n.Children)-1 {
+			connector, indent = "└── ", "    "
+		}
+		b.WriteString(prefix + connector + child.Label + "\n")
+		child.renderChildren(b, prefix+indent)
+	}
+}
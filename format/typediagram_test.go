@@ -0,0 +1,90 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+func TestTypeDiagram(t *testing.T) {
+	tests := map[string]struct {
+		t        string
+		expected string
+	}{
+		"Primitive": {
+			t:        "string",
+			expected: "",
+		},
+		"Collection": {
+			t:        "list(string)",
+			expected: "",
+		},
+		"EmptyObject": {
+			t:        "object({})",
+			expected: "",
+		},
+		"Invalid": {
+			t:        "object({",
+			expected: "",
+		},
+		"Object": {
+			t: "object({\n  name = string\n  tags = map(string)\n})",
+			expected: "```text\n" +
+				"foo: object\n" +
+				"├── name: string\n" +
+				"└── tags: map(string)\n" +
+				"```",
+		},
+		"NestedObject": {
+			t: "object({ name = string, network = object({ cidr = string, subnets = list(string) }), port = number })",
+			expected: "```text\n" +
+				"foo: object\n" +
+				"├── name: string\n" +
+				"├── network: object\n" +
+				"│   ├── cidr: string\n" +
+				"│   └── subnets: list(string)\n" +
+				"└── port: number\n" +
+				"```",
+		},
+		"ListOfObjects": {
+			t: "list(object({ name = string, rules = map(object({ port = optional(number, 80) })) }))",
+			expected: "```text\n" +
+				"foo: list(object)\n" +
+				"├── name: string\n" +
+				"└── rules: map(object)\n" +
+				"    └── port: optional(number, 80)\n" +
+				"```",
+		},
+		"OptionalObject": {
+			t: "object({ settings = optional(object({ enabled = bool }), {}) })",
+			expected: "```text\n" +
+				"foo: object\n" +
+				"└── settings: optional(object)\n" +
+				"    └── enabled: bool\n" +
+				"```",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.DefaultConfig()
+			config.Settings.ASCIITypeDiagrams = true
+
+			actual := printTypeDiagram(config, "foo", tt.t)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
@@ -14,6 +14,10 @@ import (
 	"embed"
 	"fmt"
 	"io/fs"
+	"io/ioutil"
+	"net/url"
+	"path/filepath"
+	"reflect"
 	"regexp"
 	"strings"
 
@@ -22,6 +26,10 @@ import (
 	"github.com/terraform-docs/terraform-docs/terraform"
 )
 
+// sectionSeparator is the horizontal rule inserted between the sections of
+// Markdown document with 'settings.section-separators' enabled.
+const sectionSeparator = "---\n\n"
+
 // sanitize cleans a Markdown document to soothe linters.
 func sanitize(markdown string) string {
 	result := markdown
@@ -58,6 +66,20 @@ func PrintFencedCodeBlock(code string, language string) (string, bool) {
 	return fmt.Sprintf("`%s`", code), false
 }
 
+// printTypeDiagram prints the ASCII tree diagram of the complex type 't' of the
+// input 'name' in a fenced code block, or nothing if the type is not complex or
+// 'settings.ascii-type-diagrams' is disabled.
+func printTypeDiagram(config *print.Config, name string, t string) string {
+	if !config.Settings.ASCIITypeDiagrams {
+		return ""
+	}
+	node := newTypeNode(t)
+	if node == nil {
+		return ""
+	}
+	return fmt.Sprintf("```text\n%s\n```", node.Render(fmt.Sprintf("%s: %s", name, node.Label)))
+}
+
 // PrintFencedAsciidocCodeBlock prints codes in fences, it automatically detects if
 // the input 'code' contains '\n' it will use multi line fence, otherwise it
 // wraps the 'code' inside single-tick block.
@@ -70,6 +92,671 @@ func PrintFencedAsciidocCodeBlock(code string, language string) (string, bool) {
 	return fmt.Sprintf("`%s`", code), false
 }
 
+// printVariableExampleBlock prints a complete 'variables.tf' example of given
+// inputs, with their default value if optional or a placeholder value based on
+// their type if required.
+func printVariableExampleBlock(inputs []*terraform.Input) string {
+	blocks := make([]string, 0, len(inputs))
+
+	for _, input := range inputs {
+		value := exampleValue(input)
+
+		var b strings.Builder
+		b.WriteString(fmt.Sprintf("variable %q {\n", input.Name))
+		if input.Type != "" && input.Type != "any" {
+			b.WriteString(fmt.Sprintf("  type    = %s\n", input.Type))
+		}
+		b.WriteString(fmt.Sprintf("  default = %s\n", strings.ReplaceAll(value, "\n", "\n  ")))
+		b.WriteString("}")
+
+		blocks = append(blocks, b.String())
+	}
+
+	return fmt.Sprintf("```hcl\n%s\n```", strings.Join(blocks, "\n\n"))
+}
+
+// printHCLExample prints a minimal but complete 'main.tf' example of calling the
+// module (e.g. as 'examples/basic/main.tf') as 'hcl' code block, i.e. 'terraform'
+// block with the required versions and 'module' block with the required inputs
+// set to a placeholder value based on their type. The module is sourced from the
+// Terraform Registry if its address is provided, or from the root of the module
+// relative to 'examples/basic' otherwise.
+func printHCLExample(config *print.Config, module *terraform.Module) string {
+	var b strings.Builder
+
+	core := []string{}
+	providers := []string{}
+	constraints := map[string][]string{}
+	for _, requirement := range module.Requirements {
+		if requirement.Version == "" {
+			continue
+		}
+		if requirement.Name == "terraform" {
+			core = append(core, string(requirement.Version))
+			continue
+		}
+		if _, ok := constraints[requirement.Name]; !ok {
+			providers = append(providers, requirement.Name)
+		}
+		constraints[requirement.Name] = append(constraints[requirement.Name], string(requirement.Version))
+	}
+
+	if len(core) > 0 || len(providers) > 0 {
+		b.WriteString("terraform {\n")
+		if len(core) > 0 {
+			b.WriteString(fmt.Sprintf("  required_version = %q\n", strings.Join(core, ", ")))
+		}
+		if len(providers) > 0 {
+			if len(core) > 0 {
+				b.WriteString("\n")
+			}
+			b.WriteString("  required_providers {\n")
+			for _, name := range providers {
+				b.WriteString(fmt.Sprintf("    %s = {\n", name))
+				b.WriteString(fmt.Sprintf("      version = %q\n", strings.Join(constraints[name], ", ")))
+				b.WriteString("    }\n")
+			}
+			b.WriteString("  }\n")
+		}
+		b.WriteString("}\n\n")
+	}
+
+	name := "example"
+	source := "../.."
+	if r := config.Registry; r.Namespace != "" && r.Name != "" && r.Provider != "" {
+		name = r.Name
+		source = fmt.Sprintf("%s/%s/%s", r.Namespace, r.Name, r.Provider)
+	}
+
+	b.WriteString(fmt.Sprintf("module %q {\n", name))
+	b.WriteString(fmt.Sprintf("  source = %q\n", source))
+
+	width := 0
+	for _, input := range module.RequiredInputs {
+		if len(input.Name) > width {
+			width = len(input.Name)
+		}
+	}
+	if len(module.RequiredInputs) > 0 {
+		b.WriteString("\n")
+	}
+	for _, input := range module.RequiredInputs {
+		b.WriteString(fmt.Sprintf("  %-*s = %s\n", width, input.Name, examplePlaceholder(string(input.Type))))
+	}
+	b.WriteString("}")
+
+	return fmt.Sprintf("```hcl\n%s\n```", b.String())
+}
+
+// printBackendConfig prints the partial configuration of the backend (i.e. the
+// content of 'backend.hcl') as 'hcl' code block.
+func printBackendConfig(backend *terraform.Backend) string {
+	return fmt.Sprintf("```hcl\n%s\n```", backend.Config())
+}
+
+// printInputHCL prints the assignment of given input in 'terraform.tfvars',
+// with the same value as in printVariableExampleBlock.
+func printInputHCL(input *terraform.Input) string {
+	return fmt.Sprintf("# In terraform.tfvars:\n%s = %s", input.Name, exampleValue(input))
+}
+
+// cardinalities are the cardinality of inputs in each notation, by whether
+// they're collection and required.
+var cardinalities = map[string]map[bool]map[bool]string{
+	print.CardinalityUML: {
+		false: {false: "0..1", true: "1"},
+		true:  {false: "0..*", true: "1..*"},
+	},
+	print.CardinalityEnglish: {
+		false: {false: "zero or one", true: "exactly one"},
+		true:  {false: "zero or more", true: "one or more"},
+	},
+	print.CardinalityNumeric: {
+		false: {false: "0-1", true: "1-1"},
+		true:  {false: "0-n", true: "1-n"},
+	},
+}
+
+// printCardinality prints the cardinality of given input in the notation of
+// '--with-input-cardinality', or an empty string if it's not set. Inputs of
+// list, set, map and tuple types are collections, everything else is single.
+func printCardinality(config *print.Config, input *terraform.Input) string {
+	notation, ok := cardinalities[config.Settings.InputCardinality]
+	if !ok {
+		return ""
+	}
+	return notation[isCollectionType(string(input.Type))][input.Required]
+}
+
+// isCollectionType indicates if the Terraform type 't' is a collection type
+// which accepts multiple values, e.g. 'list(string)' or 'map(number)'.
+func isCollectionType(t string) bool {
+	for _, prefix := range []string{"list", "set", "map", "tuple"} {
+		if t == prefix || strings.HasPrefix(t, prefix+"(") {
+			return true
+		}
+	}
+	return false
+}
+
+// printCloudEmoji prints the logo emoji of given cloud provider of a region
+// input, or an empty string if the cloud is unknown.
+func printCloudEmoji(cloud string) string {
+	switch cloud {
+	case terraform.CloudAWS:
+		return "🟠"
+	case terraform.CloudGCP:
+		return "🔵"
+	case terraform.CloudAzure:
+		return "🔷"
+	}
+	return ""
+}
+
+// inputsPaginationScript is the inline JavaScript paginating the table right
+// before it, showing '--pagination-size' rows at a time with Previous and Next
+// controls below the table. Renderers wrapping the table (e.g. in a scrollable
+// div) are handled as well.
+const inputsPaginationScript = `<script>
+(function () {
+  var size = %d;
+  var previous = document.currentScript && document.currentScript.previousElementSibling;
+  var table = previous && (previous.tagName === "TABLE" ? previous : previous.querySelector("table"));
+  if (!table || !table.tBodies.length) {
+    return;
+  }
+  var rows = table.tBodies[0].rows;
+  var pages = 1;
+  var page = 0;
+  var controls = document.createElement("div");
+  var prev = document.createElement("button");
+  var next = document.createElement("button");
+  var status = document.createElement("span");
+  prev.textContent = "Previous";
+  next.textContent = "Next";
+  controls.appendChild(prev);
+  controls.appendChild(status);
+  controls.appendChild(next);
+  function show() {
+    var visible = [];
+    for (var i = 0; i < rows.length; i++) {
+      rows[i].style.display = "none";
+      if (rows[i].dataset.filtered !== "true") {
+        visible.push(rows[i]);
+      }
+    }
+    pages = Math.max(Math.ceil(visible.length / size), 1);
+    page = Math.min(page, pages - 1);
+    for (var j = page * size; j < Math.min((page + 1) * size, visible.length); j++) {
+      visible[j].style.display = "";
+    }
+    status.textContent = " Page " + (page + 1) + " of " + pages + " ";
+    prev.disabled = page === 0;
+    next.disabled = page === pages - 1;
+  }
+  prev.onclick = function () {
+    page = Math.max(page - 1, 0);
+    show();
+  };
+  next.onclick = function () {
+    page = Math.min(page + 1, pages - 1);
+    show();
+  };
+  table.addEventListener("terraform-docs-filter", function () {
+    page = 0;
+    show();
+  });
+  previous.insertAdjacentElement("afterend", controls);
+  show();
+})();
+</script>`
+
+// inputsSearchScript is the search box and the inline JavaScript filtering the
+// rows of the table right after it by their name, type or description. Rows are
+// hidden as they're typed, and "terraform-docs-filter" event is dispatched on
+// the table for the pagination of inputsPaginationScript to pick the rows of
+// its pages again.
+const inputsSearchScript = `<div><input type="search" placeholder="Search inputs by name, type or description" aria-label="Search inputs"></div>
+<script>
+(function () {
+  var script = document.currentScript;
+  var search = script && script.previousElementSibling && script.previousElementSibling.querySelector("input");
+  function init() {
+    var next = script.nextElementSibling;
+    var table = next && (next.tagName === "TABLE" ? next : next.querySelector("table"));
+    if (!search || !table || !table.tHead || !table.tBodies.length) {
+      return;
+    }
+    var columns = [];
+    var headers = table.tHead.rows[0].cells;
+    for (var i = 0; i < headers.length; i++) {
+      if (["Name", "Type", "Description"].indexOf(headers[i].textContent.trim()) !== -1) {
+        columns.push(i);
+      }
+    }
+    search.addEventListener("input", function () {
+      var query = search.value.trim().toLowerCase();
+      var rows = table.tBodies[0].rows;
+      for (var i = 0; i < rows.length; i++) {
+        var text = "";
+        for (var j = 0; j < columns.length; j++) {
+          text += " " + rows[i].cells[columns[j]].textContent.toLowerCase();
+        }
+        var matched = text.indexOf(query) !== -1;
+        rows[i].dataset.filtered = matched ? "false" : "true";
+        rows[i].style.display = matched ? "" : "none";
+      }
+      table.dispatchEvent(new Event("terraform-docs-filter"));
+    });
+  }
+  if (document.readyState === "loading") {
+    document.addEventListener("DOMContentLoaded", init);
+  } else {
+    init();
+  }
+})();
+</script>`
+
+// printInputsSearch prints the search box filtering Inputs table, if
+// '--with-searchable-table' is set in HTML mode and the module has any input,
+// otherwise an empty string.
+func printInputsSearch(config *print.Config, inputs []*terraform.Input) string {
+	if !config.Settings.HTML || !config.Settings.SearchableTable || len(inputs) == 0 {
+		return ""
+	}
+	return inputsSearchScript
+}
+
+// printInputsPagination prints the inline script paginating Inputs table, if
+// '--with-input-table-pagination' is set in HTML mode and the module has more
+// inputs than '--pagination-size', otherwise an empty string.
+func printInputsPagination(config *print.Config, inputs []*terraform.Input) string {
+	s := config.Settings
+	if !s.HTML || !s.InputTablePagination || len(inputs) <= s.PaginationSize {
+		return ""
+	}
+	return fmt.Sprintf(inputsPaginationScript, s.PaginationSize)
+}
+
+// printProviderNamespace prints a ✓ badge for the official providers of
+// HashiCorp, and the source with full namespace of community providers (e.g.
+// `integrations/github`), or an empty string if the source is unknown.
+func printProviderNamespace(provider *terraform.Provider) string {
+	switch {
+	case provider.Source == "":
+		return ""
+	case provider.IsOfficial():
+		return "✓"
+	}
+	return fmt.Sprintf("`%s`", provider.Source)
+}
+
+// printSeverity prints the severity of a security finding with emoji, critical
+// ones are highlighted in bold as well.
+func printSeverity(severity string) string {
+	switch severity {
+	case terraform.SeverityCritical:
+		return "🚨 **CRITICAL**"
+	case terraform.SeverityHigh:
+		return "🔴 HIGH"
+	case terraform.SeverityMedium:
+		return "🟠 MEDIUM"
+	case terraform.SeverityLow:
+		return "🟡 LOW"
+	}
+	return severity
+}
+
+// printTagCompliance prints compliance of tags of a resource with the tag policy
+// as emoji, with the missing tags if it's not compliant.
+func printTagCompliance(compliance *terraform.TagCompliance) string {
+	switch compliance.Status {
+	case terraform.TagsCompliant:
+		return "✅"
+	case terraform.TagsNonCompliant:
+		missing := make([]string, 0, len(compliance.Missing))
+		for _, tag := range compliance.Missing {
+			missing = append(missing, "`"+tag+"`")
+		}
+		return fmt.Sprintf("❌ missing %s", strings.Join(missing, ", "))
+	}
+	return "⚠️ check manually"
+}
+
+// printTestStatus prints the status of a test as emoji, with the status itself
+// if it's unknown.
+func printTestStatus(status string) string {
+	switch status {
+	case terraform.TestStatusPass:
+		return "✅ pass"
+	case terraform.TestStatusFail:
+		return "❌ fail"
+	case terraform.TestStatusError:
+		return "❌ error"
+	case terraform.TestStatusSkip:
+		return "⏭️ skip"
+	}
+	return status
+}
+
+// printTestVariables prints the variable assignments of a test 'run' block as
+// HCL code block, with their values as is.
+func printTestVariables(variables []*terraform.TestVariable) string {
+	width := 0
+	for _, v := range variables {
+		if len(v.Name) > width {
+			width = len(v.Name)
+		}
+	}
+
+	lines := make([]string, 0, len(variables))
+	for _, v := range variables {
+		lines = append(lines, fmt.Sprintf("%-*s = %s", width, v.Name, v.Value))
+	}
+
+	return fmt.Sprintf("```hcl\n%s\n```", strings.Join(lines, "\n"))
+}
+
+// terraformBrandColor is the official purple color of Terraform brand.
+const terraformBrandColor = "7B42BC"
+
+// printBadges prints the enabled shields.io badges of the module in one line,
+// i.e. badge of the module in the Terraform Registry, badge of required version
+// of Terraform followed by badge per provider requirement.
+func printBadges(config *print.Config, module *terraform.Module) string {
+	badges := make([]string, 0)
+
+	if config.Registry.Enabled {
+		if badge := printRegistryBadge(config); badge != "" {
+			badges = append(badges, badge)
+		}
+	}
+
+	if config.Settings.RequiredVersionBadge && module.RequiredCoreVersion != "" {
+		badges = append(badges, printRequiredVersionBadge(module.RequiredCoreVersion))
+	}
+	if config.Settings.ProviderVersionBadges {
+		for _, requirement := range module.Requirements {
+			if requirement.Name == "terraform" {
+				continue
+			}
+			badges = append(badges, printProviderVersionBadge(requirement.Name, string(requirement.Version)))
+		}
+	}
+	if module.HealthScore != nil {
+		badges = append(badges, printHealthScoreBadge(module.HealthScore.Score))
+	}
+
+	return strings.Join(badges, " ")
+}
+
+// printRegistryBadge prints shields.io badge of the Terraform Registry linking
+// to the module, or empty if any of its namespace, name or provider is missing.
+func printRegistryBadge(config *print.Config) string {
+	r := config.Registry
+	if r.Namespace == "" || r.Name == "" || r.Provider == "" {
+		return ""
+	}
+	badge := shieldsBadgeURL("terraform", "registry", terraformBrandColor) + "?logo=terraform"
+	url := fmt.Sprintf("https://registry.terraform.io/modules/%s/%s/%s", r.Namespace, r.Name, r.Provider)
+	return fmt.Sprintf("[![View on Terraform Registry](%s)](%s)", badge, url)
+}
+
+// printHealthScoreBadge prints shields.io badge of the documentation health
+// score, green from 80%, yellow from 50% and red otherwise.
+func printHealthScoreBadge(score int) string {
+	color := "red"
+	switch {
+	case score >= 80:
+		color = "brightgreen"
+	case score >= 50:
+		color = "yellow"
+	}
+	return fmt.Sprintf("![Documentation Health](%s)", shieldsBadgeURL("docs health", fmt.Sprintf("%d%%", score), color))
+}
+
+// printProviderVersionBadge prints shields.io badge of the given provider and
+// its version constraint, or 'any' if there's no constraint.
+func printProviderVersionBadge(name string, version string) string {
+	if version == "" {
+		version = "any"
+	}
+	badge := shieldsBadgeURL(name, version, "blue")
+	return fmt.Sprintf("![%s](%s)", name, badge)
+}
+
+// printRequiredVersionBadge prints shields.io badge of the given Terraform
+// version constraint, with Terraform logo and brand color.
+func printRequiredVersionBadge(version string) string {
+	badge := shieldsBadgeURL("terraform", version, terraformBrandColor) + "?logo=terraform"
+	return fmt.Sprintf("[![Terraform](%s)](https://www.terraform.io)", badge)
+}
+
+// shieldsBadgeURL returns the URL of shields.io static badge. Dashes and
+// underscores are escaped with doubling them as required by shields.io.
+func shieldsBadgeURL(label string, message string, color string) string {
+	escape := func(s string) string {
+		s = strings.ReplaceAll(s, "-", "--")
+		s = strings.ReplaceAll(s, "_", "__")
+		return url.PathEscape(s)
+	}
+	return fmt.Sprintf("https://img.shields.io/badge/%s-%s-%s", escape(label), escape(message), color)
+}
+
+// printModuleSource prints the source of the modulecall, as a link to its
+// documentation if it's known (i.e. '--with-gruntwork-links'), or to the module
+// in the Terraform Registry if it's a registry address. With '--with-external-links'
+// Git sources are linked to their repository and local paths to themselves.
+func printModuleSource(config *print.Config, modulecall *terraform.ModuleCall) string {
+	if modulecall.DocsURL != "" {
+		return fmt.Sprintf("[%s](%s)", modulecall.Source, modulecall.DocsURL)
+	}
+	if url := modulecall.RegistryURL(); url != "" {
+		return fmt.Sprintf("[%s](%s)", modulecall.Source, url)
+	}
+	if config.Settings.ExternalLinks {
+		if url := modulecall.RepositoryURL(); url != "" {
+			return fmt.Sprintf("[%s](%s)", modulecall.Source, url)
+		}
+		if modulecall.IsLocal() {
+			return fmt.Sprintf("[%s](%s)", modulecall.Source, modulecall.Source)
+		}
+	}
+	return modulecall.Source
+}
+
+// printConfigLink prints the link to the config file which the docs are
+// generated with, as a note linking to the file in the repository if its URL
+// is provided, or as an HTML comment otherwise.
+func printConfigLink(config *print.Config) string {
+	path := config.ConfigLink.Path
+	if path == "" {
+		return ""
+	}
+	if config.ConfigLink.RepoURL == "" {
+		return fmt.Sprintf("<!-- Generated using terraform-docs config: %s -->", path)
+	}
+	url := strings.TrimSuffix(config.ConfigLink.RepoURL, "/") + "/" + path
+	return fmt.Sprintf("Generated using terraform-docs config [%s](%s).", path, url)
+}
+
+// printTableOfContents prints the list of links to the sections of the module
+// which are going to be rendered, if '--with-table-of-contents' is set. Inputs
+// and outputs are listed as nested links to their anchors if '--anchor' is set.
+// Inputs of Markdown document are split into Required and Optional sections if
+// '--required' is set, the same as the document itself.
+func printTableOfContents(config *print.Config, module *terraform.Module, document bool) string {
+	if !config.Settings.TableOfContents {
+		return ""
+	}
+
+	s := config.Settings
+	visible := func(enabled bool, count int) bool {
+		return enabled && (count > 0 || !s.HideEmpty)
+	}
+
+	var buf strings.Builder
+	section := func(title string, prefix string, names []string) {
+		slug := strings.ReplaceAll(strings.ToLower(title), " ", "-")
+		fmt.Fprintf(&buf, "- [%s](#%s)\n", title, slug)
+		if !s.Anchor {
+			return
+		}
+		for _, name := range names {
+			anchor := template.SanitizeName(prefix+"_"+name, s.Escape)
+			fmt.Fprintf(&buf, "  - [%s](#%s)\n", template.SanitizeName(name, s.Escape), anchor)
+		}
+	}
+	inputNames := func(inputs []*terraform.Input) []string {
+		names := make([]string, 0, len(inputs))
+		for _, input := range inputs {
+			names = append(names, input.Name)
+		}
+		return names
+	}
+
+	sections := config.Sections
+	if visible(sections.Requirements, len(module.Requirements)) {
+		section("Requirements", "", nil)
+	}
+	if visible(sections.Providers, len(module.Providers)) {
+		section("Providers", "", nil)
+	}
+	if visible(sections.ModuleCalls, len(module.ModuleCalls)) {
+		section("Modules", "", nil)
+	}
+	if visible(sections.Resources || sections.DataSources, len(module.Resources)) {
+		section("Resources", "", nil)
+	}
+	if document && s.Required {
+		if visible(sections.Inputs, len(module.RequiredInputs)) {
+			section("Required Inputs", "input", inputNames(module.RequiredInputs))
+		}
+		if visible(sections.Inputs, len(module.OptionalInputs)) {
+			section("Optional Inputs", "input", inputNames(module.OptionalInputs))
+		}
+	} else if visible(sections.Inputs, len(module.Inputs)) {
+		section("Inputs", "input", inputNames(module.Inputs))
+	}
+	if visible(sections.Outputs, len(module.Outputs)) {
+		names := make([]string, 0, len(module.Outputs))
+		for _, output := range module.Outputs {
+			names = append(names, output.Name)
+		}
+		section("Outputs", "output", names)
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// darkModePalette is the default colors of tables and code blocks in dark mode,
+// similar to the dark theme of GitHub.
+const darkModePalette = `  table, th, td {
+    color: #c9d1d9;
+    background-color: #0d1117;
+    border-color: #30363d;
+  }
+  tr:nth-child(2n) td {
+    background-color: #161b22;
+  }
+  pre, code {
+    color: #c9d1d9;
+    background-color: #161b22;
+  }`
+
+// printDarkModeStyle prints the stylesheet of tables and code blocks in dark
+// mode (i.e. 'prefers-color-scheme: dark' media query), if '--with-dark-mode' is
+// set in HTML mode. The palette is read from '--dark-mode-css-file' if provided,
+// relative to the root of the module.
+func printDarkModeStyle(config *print.Config) (string, error) {
+	if !config.Settings.HTML || !config.Settings.DarkMode {
+		return "", nil
+	}
+	palette, err := readDarkModePalette(config)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("<style>\n@media (prefers-color-scheme: dark) {\n%s\n}\n</style>", palette), nil
+}
+
+// readDarkModePalette returns the CSS rules of dark mode, which are read from
+// '--dark-mode-css-file' if provided, or the default palette otherwise.
+func readDarkModePalette(config *print.Config) (string, error) {
+	file := config.Settings.DarkModeCSSFile
+	if file == "" {
+		return darkModePalette, nil
+	}
+	content, err := ioutil.ReadFile(filepath.Clean(filepath.Join(config.ModuleRoot, file)))
+	if err != nil {
+		return "", fmt.Errorf("unable to read dark mode css file, %w", err)
+	}
+	return strings.TrimRight(string(content), "\n"), nil
+}
+
+// changelogHeading matches the Markdown headings in the changelog.
+var changelogHeading = regexp.MustCompile(`^(#{1,6})(\s)`)
+
+// printChangelog prints the changelog of the module with its headings shifted
+// to be nested under the 'Changelog' heading, i.e. its version headings are
+// one level below the heading of the section. Headings in code blocks are kept
+// as they are.
+func printChangelog(config *print.Config, changelog string) string {
+	level := len(template.GenerateMarkdownIndentation(config.Settings.IndentationLevel, config.Settings.Indent, 1))
+	shift := level - 2
+
+	lines := strings.Split(changelog, "\n")
+	fenced := false
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			fenced = !fenced
+			continue
+		}
+		if fenced || shift == 0 {
+			continue
+		}
+		lines[i] = changelogHeading.ReplaceAllStringFunc(line, func(heading string) string {
+			hashes := len(strings.TrimRight(heading, " \t")) + shift
+			if hashes < 1 {
+				hashes = 1
+			}
+			if hashes > 6 {
+				hashes = 6
+			}
+			return strings.Repeat("#", hashes) + heading[len(heading)-1:]
+		})
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// exampleValue returns the default value of given input if optional, or a
+// placeholder value based on its type if required.
+func exampleValue(input *terraform.Input) string {
+	if input.Required {
+		return examplePlaceholder(string(input.Type))
+	}
+	return input.GetValue()
+}
+
+// examplePlaceholder returns an empty value which is appropriate for the
+// given variable type.
+func examplePlaceholder(t string) string {
+	switch {
+	case t == "string":
+		return `""`
+	case t == "number":
+		return "0"
+	case t == "bool":
+		return "false"
+	case strings.HasPrefix(t, "list"), strings.HasPrefix(t, "set"), strings.HasPrefix(t, "tuple"):
+		return "[]"
+	case strings.HasPrefix(t, "map"), strings.HasPrefix(t, "object"):
+		return "{}"
+	default:
+		return "null"
+	}
+}
+
 // readTemplateItems reads all static formatter .tmpl files prefixed by specific string
 // from an embed file system.
 func readTemplateItems(efs embed.FS, prefix string) []*template.Item {
@@ -118,32 +805,111 @@ func copySections(config *print.Config, src *terraform.Module) *terraform.Module
 
 	if config.Sections.Header {
 		dest.Header = src.Header
+		dest.License = src.License
 	}
 	if config.Sections.Footer {
 		dest.Footer = src.Footer
 	}
 	if config.Sections.Inputs {
 		dest.Inputs = src.Inputs
+		dest.VariableSummary = src.VariableSummary
 	}
 	if config.Sections.ModuleCalls {
 		dest.ModuleCalls = src.ModuleCalls
+		dest.ModuleCallTree = src.ModuleCallTree
 	}
 	if config.Sections.Outputs {
 		dest.Outputs = src.Outputs
+		dest.ExampleOutputs = src.ExampleOutputs
 	}
 	if config.Sections.Providers {
 		dest.Providers = src.Providers
+		dest.LockChanges = src.LockChanges
 	}
 	if config.Sections.Requirements {
 		dest.Requirements = src.Requirements
+		dest.RequiredCoreVersion = src.RequiredCoreVersion
+		dest.PinnedCoreVersion = src.PinnedCoreVersion
+		dest.CompatibilityMatrix = src.CompatibilityMatrix
 	}
 	if config.Sections.Resources || config.Sections.DataSources {
 		dest.Resources = filterResourcesByMode(config, src.Resources)
+		dest.ExamplePlan = src.ExamplePlan
+	}
+	if config.Sections.Resources && config.Settings.ShowEphemeralResources {
+		dest.EphemeralResources = src.EphemeralResources
+	}
+	if config.Settings.ShowChecks {
+		dest.Checks = src.Checks
+	}
+	if config.Settings.TftestExamples {
+		dest.TestRuns = src.TestRuns
 	}
+	dest.Moved = src.Moved
+	dest.Purpose = src.Purpose
+	dest.Summary = src.Summary
+	dest.CostEstimate = src.CostEstimate
+	dest.SecurityFindings = src.SecurityFindings
+	dest.ExampleValidations = src.ExampleValidations
+	dest.Changelog = src.Changelog
 
 	return dest
 }
 
+// emptySections are the fields of module which are omitted from the encoded
+// output (e.g. JSON) if they are empty and 'settings.hide-empty' is enabled.
+var emptySections = map[string]bool{
+	"Inputs":       true,
+	"ModuleCalls":  true,
+	"Outputs":      true,
+	"Providers":    true,
+	"Requirements": true,
+	"Resources":    true,
+}
+
+// hideEmptySections returns the module to be encoded, which is the 'module'
+// itself or a copy of it (as a struct with the same fields) with 'omitempty'
+// added to the tags of the sections if 'settings.hide-empty' is enabled.
+func hideEmptySections(config *print.Config, module *terraform.Module) interface{} {
+	if !config.Settings.HideEmpty {
+		return module
+	}
+
+	src := reflect.ValueOf(module).Elem()
+
+	fields := make([]reflect.StructField, 0, src.NumField())
+	for i := 0; i < src.NumField(); i++ {
+		field := src.Type().Field(i)
+		if emptySections[field.Name] {
+			field.Tag = omitEmptyTag(field.Tag)
+		}
+		fields = append(fields, field)
+	}
+
+	dest := reflect.New(reflect.StructOf(fields)).Elem()
+	for i := 0; i < src.NumField(); i++ {
+		dest.Field(i).Set(src.Field(i))
+	}
+
+	return dest.Addr().Interface()
+}
+
+// omitEmptyTag adds 'omitempty' to the JSON, TOML and YAML keys of 'tag'.
+func omitEmptyTag(tag reflect.StructTag) reflect.StructTag {
+	keys := []string{}
+	for _, key := range []string{"json", "toml", "xml", "yaml"} {
+		value, ok := tag.Lookup(key)
+		if !ok {
+			continue
+		}
+		if key != "xml" && !strings.Contains(value, ",omitempty") {
+			value += ",omitempty"
+		}
+		keys = append(keys, fmt.Sprintf("%s:%q", key, value))
+	}
+	return reflect.StructTag(strings.Join(keys, " "))
+}
+
 // filterResourcesByMode returns the managed or data resources defined by the show argument
 func filterResourcesByMode(config *print.Config, module []*terraform.Resource) []*terraform.Resource {
 	resources := make([]*terraform.Resource, 0)
@@ -157,3 +923,35 @@ func filterResourcesByMode(config *print.Config, module []*terraform.Resource) [
 	}
 	return resources
 }
+
+// readSectionNames returns the name of the templates which the 'all' template
+// is composed of, in the same order they are used in it.
+func readSectionNames(items []*template.Item) []string {
+	names := make([]string, 0)
+	for _, item := range items {
+		if item.Name != "all" {
+			continue
+		}
+		for _, match := range regexp.MustCompile(`{{-?\s*template\s+"([^"]+)"`).FindAllStringSubmatch(item.Text, -1) {
+			names = append(names, match[1])
+		}
+	}
+	return names
+}
+
+// renderSections renders the 'sections' templates one by one and joins the
+// non-empty ones together with a horizontal rule in between them.
+func renderSections(tt *template.Template, sections []string, module *terraform.Module) (string, error) {
+	rendered := make([]string, 0, len(sections))
+	for _, name := range sections {
+		section, err := tt.Render(name, module)
+		if err != nil {
+			return "", err
+		}
+		if strings.TrimSpace(section) == "" {
+			continue
+		}
+		rendered = append(rendered, section)
+	}
+	return strings.Join(rendered, sectionSeparator), nil
+}
@@ -11,9 +11,20 @@ the root directory of this source tree.
 package format
 
 import (
+	jsonsdk "encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	tomlsdk "github.com/BurntSushi/toml"
 	"github.com/stretchr/testify/assert"
+	yamlv3 "gopkg.in/yaml.v3"
+
+	"github.com/terraform-docs/terraform-docs/internal/testutil"
+	"github.com/terraform-docs/terraform-docs/internal/types"
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
 )
 
 func TestSanitizeMarkdown(t *testing.T) {
@@ -129,3 +140,800 @@ func TestFenceCodeBlock(t *testing.T) {
 		})
 	}
 }
+
+func TestExamplePlaceholder(t *testing.T) {
+	tests := []struct {
+		name     string
+		t        string
+		expected string
+	}{
+		{name: "string", t: "string", expected: `""`},
+		{name: "number", t: "number", expected: "0"},
+		{name: "bool", t: "bool", expected: "false"},
+		{name: "list", t: "list(string)", expected: "[]"},
+		{name: "set", t: "set(number)", expected: "[]"},
+		{name: "tuple", t: "tuple([string, number])", expected: "[]"},
+		{name: "map", t: "map(string)", expected: "{}"},
+		{name: "object", t: "object({ name = string })", expected: "{}"},
+		{name: "any", t: "any", expected: "null"},
+		{name: "empty", t: "", expected: "null"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := examplePlaceholder(tt.t)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestPrintHCLExample(t *testing.T) {
+	module := &terraform.Module{
+		Requirements: []*terraform.Requirement{
+			{Name: "terraform", Version: types.String(">= 1.0")},
+			{Name: "aws", Version: types.String(">= 4.0")},
+			{Name: "aws", Version: types.String("< 6.0")},
+			{Name: "null", Version: types.String("")},
+		},
+		RequiredInputs: []*terraform.Input{
+			{Name: "name", Type: "string", Required: true},
+			{Name: "subnet_ids", Type: "list(string)", Required: true},
+		},
+	}
+	tests := []struct {
+		name     string
+		module   *terraform.Module
+		registry bool
+		expected string
+	}{
+		{
+			name:     "local source",
+			module:   module,
+			registry: false,
+			expected: "```hcl\nterraform {\n  required_version = \">= 1.0\"\n\n  required_providers {\n    aws = {\n      version = \">= 4.0, < 6.0\"\n    }\n  }\n}\n\nmodule \"example\" {\n  source = \"../..\"\n\n  name       = \"\"\n  subnet_ids = []\n}\n```",
+		},
+		{
+			name:     "registry source",
+			module:   module,
+			registry: true,
+			expected: "```hcl\nterraform {\n  required_version = \">= 1.0\"\n\n  required_providers {\n    aws = {\n      version = \">= 4.0, < 6.0\"\n    }\n  }\n}\n\nmodule \"vpc\" {\n  source = \"terraform-aws-modules/vpc/aws\"\n\n  name       = \"\"\n  subnet_ids = []\n}\n```",
+		},
+		{
+			name:     "without requirements and inputs",
+			module:   &terraform.Module{},
+			registry: false,
+			expected: "```hcl\nmodule \"example\" {\n  source = \"../..\"\n}\n```",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			config := print.DefaultConfig()
+			if tt.registry {
+				config.Registry.Namespace = "terraform-aws-modules"
+				config.Registry.Name = "vpc"
+				config.Registry.Provider = "aws"
+			}
+			actual := printHCLExample(config, tt.module)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestPrintInputHCL(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *terraform.Input
+		expected string
+	}{
+		{
+			name:     "optional",
+			input:    &terraform.Input{Name: "region", Type: "string", Default: types.String("eu-west-1")},
+			expected: "# In terraform.tfvars:\nregion = \"eu-west-1\"",
+		},
+		{
+			name:     "required",
+			input:    &terraform.Input{Name: "subnets", Type: "list(string)", Default: types.ValueOf(nil), Required: true},
+			expected: "# In terraform.tfvars:\nsubnets = []",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := printInputHCL(tt.input)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestPrintCardinality(t *testing.T) {
+	tests := []struct {
+		name     string
+		notation string
+		input    *terraform.Input
+		expected string
+	}{
+		{
+			name:     "disabled",
+			notation: "",
+			input:    &terraform.Input{Name: "subnets", Type: "list(string)", Required: true},
+			expected: "",
+		},
+		{
+			name:     "uml optional single",
+			notation: print.CardinalityUML,
+			input:    &terraform.Input{Name: "region", Type: "string"},
+			expected: "0..1",
+		},
+		{
+			name:     "uml required single",
+			notation: print.CardinalityUML,
+			input:    &terraform.Input{Name: "tags", Type: "object({ name = string })", Required: true},
+			expected: "1",
+		},
+		{
+			name:     "uml optional collection",
+			notation: print.CardinalityUML,
+			input:    &terraform.Input{Name: "tags", Type: "map(string)"},
+			expected: "0..*",
+		},
+		{
+			name:     "uml required collection",
+			notation: print.CardinalityUML,
+			input:    &terraform.Input{Name: "subnets", Type: "list(string)", Required: true},
+			expected: "1..*",
+		},
+		{
+			name:     "english",
+			notation: print.CardinalityEnglish,
+			input:    &terraform.Input{Name: "zones", Type: "set(string)"},
+			expected: "zero or more",
+		},
+		{
+			name:     "numeric",
+			notation: print.CardinalityNumeric,
+			input:    &terraform.Input{Name: "name", Type: "string", Required: true},
+			expected: "1-1",
+		},
+		{
+			name:     "type with collection prefix",
+			notation: print.CardinalityUML,
+			input:    &terraform.Input{Name: "mapping", Type: "mapping"},
+			expected: "0..1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.DefaultConfig()
+			config.Settings.InputCardinality = tt.notation
+
+			actual := printCardinality(config, tt.input)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestPrintSeverity(t *testing.T) {
+	tests := []struct {
+		name     string
+		severity string
+		expected string
+	}{
+		{
+			name:     "critical",
+			severity: terraform.SeverityCritical,
+			expected: "🚨 **CRITICAL**",
+		},
+		{
+			name:     "high",
+			severity: terraform.SeverityHigh,
+			expected: "🔴 HIGH",
+		},
+		{
+			name:     "medium",
+			severity: terraform.SeverityMedium,
+			expected: "🟠 MEDIUM",
+		},
+		{
+			name:     "low",
+			severity: terraform.SeverityLow,
+			expected: "🟡 LOW",
+		},
+		{
+			name:     "unknown",
+			severity: "INFO",
+			expected: "INFO",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := printSeverity(tt.severity)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestPrintTagCompliance(t *testing.T) {
+	tests := []struct {
+		name       string
+		compliance *terraform.TagCompliance
+		expected   string
+	}{
+		{
+			name:       "compliant",
+			compliance: &terraform.TagCompliance{Status: terraform.TagsCompliant},
+			expected:   "✅",
+		},
+		{
+			name:       "non-compliant",
+			compliance: &terraform.TagCompliance{Status: terraform.TagsNonCompliant, Missing: []string{"owner", "cost-centre"}},
+			expected:   "❌ missing `owner`, `cost-centre`",
+		},
+		{
+			name:       "check manually",
+			compliance: &terraform.TagCompliance{Status: terraform.TagsCheckManually},
+			expected:   "⚠️ check manually",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := printTagCompliance(tt.compliance)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestPrintTestVariables(t *testing.T) {
+	tests := []struct {
+		name      string
+		variables []*terraform.TestVariable
+		expected  string
+	}{
+		{
+			name:      "empty",
+			variables: []*terraform.TestVariable{},
+			expected:  "```hcl\n\n```",
+		},
+		{
+			name: "aligned",
+			variables: []*terraform.TestVariable{
+				{Name: "name", Value: types.String(`"foo"`)},
+				{Name: "instance_count", Value: types.String("2")},
+			},
+			expected: "```hcl\nname           = \"foo\"\ninstance_count = 2\n```",
+		},
+		{
+			name: "multi-line",
+			variables: []*terraform.TestVariable{
+				{Name: "tags", Value: types.String("{\n  Name = \"foo\"\n}")},
+			},
+			expected: "```hcl\ntags = {\n  Name = \"foo\"\n}\n```",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := printTestVariables(tt.variables)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestPrintRequiredVersionBadge(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		expected string
+	}{
+		{
+			name:     "single constraint",
+			version:  ">= 0.12",
+			expected: "[![Terraform](https://img.shields.io/badge/terraform-%3E=%200.12-7B42BC?logo=terraform)](https://www.terraform.io)",
+		},
+		{
+			name:     "multiple constraints",
+			version:  ">= 1.3, < 2.0",
+			expected: "[![Terraform](https://img.shields.io/badge/terraform-%3E=%201.3%2C%20%3C%202.0-7B42BC?logo=terraform)](https://www.terraform.io)",
+		},
+		{
+			name:     "pre-release",
+			version:  "1.6.0-beta1",
+			expected: "[![Terraform](https://img.shields.io/badge/terraform-1.6.0--beta1-7B42BC?logo=terraform)](https://www.terraform.io)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := printRequiredVersionBadge(tt.version)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestPrintHealthScoreBadge(t *testing.T) {
+	tests := []struct {
+		name     string
+		score    int
+		expected string
+	}{
+		{
+			name:     "healthy",
+			score:    80,
+			expected: "![Documentation Health](https://img.shields.io/badge/docs%20health-80%25-brightgreen)",
+		},
+		{
+			name:     "partial",
+			score:    50,
+			expected: "![Documentation Health](https://img.shields.io/badge/docs%20health-50%25-yellow)",
+		},
+		{
+			name:     "unhealthy",
+			score:    0,
+			expected: "![Documentation Health](https://img.shields.io/badge/docs%20health-0%25-red)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := printHealthScoreBadge(tt.score)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestPrintRegistryBadge(t *testing.T) {
+	tests := []struct {
+		name      string
+		namespace string
+		module    string
+		provider  string
+		expected  string
+	}{
+		{
+			name:      "all provided",
+			namespace: "terraform-aws-modules",
+			module:    "vpc",
+			provider:  "aws",
+			expected:  "[![View on Terraform Registry](https://img.shields.io/badge/terraform-registry-7B42BC?logo=terraform)](https://registry.terraform.io/modules/terraform-aws-modules/vpc/aws)",
+		},
+		{
+			name:      "without namespace",
+			namespace: "",
+			module:    "vpc",
+			provider:  "aws",
+			expected:  "",
+		},
+		{
+			name:      "without provider",
+			namespace: "terraform-aws-modules",
+			module:    "vpc",
+			provider:  "",
+			expected:  "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			config := print.DefaultConfig()
+			config.Registry.Enabled = true
+			config.Registry.Namespace = tt.namespace
+			config.Registry.Name = tt.module
+			config.Registry.Provider = tt.provider
+			actual := printRegistryBadge(config)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestPrintProviderVersionBadge(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider string
+		version  string
+		expected string
+	}{
+		{
+			name:     "with constraint",
+			provider: "aws",
+			version:  ">= 2.15.0",
+			expected: "![aws](https://img.shields.io/badge/aws-%3E=%202.15.0-blue)",
+		},
+		{
+			name:     "without constraint",
+			provider: "null",
+			version:  "",
+			expected: "![null](https://img.shields.io/badge/null-any-blue)",
+		},
+		{
+			name:     "escape name",
+			provider: "foo-bar_baz",
+			version:  "~> 1.0",
+			expected: "![foo-bar_baz](https://img.shields.io/badge/foo--bar__baz-~%3E%201.0-blue)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := printProviderVersionBadge(tt.provider, tt.version)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestPrintInputsPagination(t *testing.T) {
+	inputs := []*terraform.Input{{Name: "a"}, {Name: "b"}, {Name: "c"}}
+	tests := []struct {
+		name     string
+		html     bool
+		enabled  bool
+		size     int
+		expected bool
+	}{
+		{
+			name:     "more inputs than page size",
+			html:     true,
+			enabled:  true,
+			size:     2,
+			expected: true,
+		},
+		{
+			name:     "as many inputs as page size",
+			html:     true,
+			enabled:  true,
+			size:     3,
+			expected: false,
+		},
+		{
+			name:     "without html",
+			html:     false,
+			enabled:  true,
+			size:     2,
+			expected: false,
+		},
+		{
+			name:     "disabled",
+			html:     true,
+			enabled:  false,
+			size:     2,
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			config := print.DefaultConfig()
+			config.Settings.HTML = tt.html
+			config.Settings.InputTablePagination = tt.enabled
+			config.Settings.PaginationSize = tt.size
+			actual := printInputsPagination(config, inputs)
+			if tt.expected {
+				assert.True(strings.HasPrefix(actual, "<script>"))
+				assert.Contains(actual, "var size = 2;")
+			} else {
+				assert.Empty(actual)
+			}
+		})
+	}
+}
+
+func TestPrintInputsSearch(t *testing.T) {
+	tests := []struct {
+		name     string
+		html     bool
+		enabled  bool
+		inputs   []*terraform.Input
+		expected bool
+	}{
+		{
+			name:     "with inputs",
+			html:     true,
+			enabled:  true,
+			inputs:   []*terraform.Input{{Name: "a"}},
+			expected: true,
+		},
+		{
+			name:     "without inputs",
+			html:     true,
+			enabled:  true,
+			inputs:   []*terraform.Input{},
+			expected: false,
+		},
+		{
+			name:     "without html",
+			html:     false,
+			enabled:  true,
+			inputs:   []*terraform.Input{{Name: "a"}},
+			expected: false,
+		},
+		{
+			name:     "disabled",
+			html:     true,
+			enabled:  false,
+			inputs:   []*terraform.Input{{Name: "a"}},
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			config := print.DefaultConfig()
+			config.Settings.HTML = tt.html
+			config.Settings.SearchableTable = tt.enabled
+			actual := printInputsSearch(config, tt.inputs)
+			if tt.expected {
+				assert.Equal(inputsSearchScript, actual)
+			} else {
+				assert.Empty(actual)
+			}
+		})
+	}
+}
+
+func TestPrintDarkModeStyle(t *testing.T) {
+	dir := t.TempDir()
+	err := ioutil.WriteFile(filepath.Join(dir, "dark.css"), []byte("  body {\n    color: #fff;\n  }\n"), 0600)
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name     string
+		html     bool
+		enabled  bool
+		file     string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "default palette",
+			html:     true,
+			enabled:  true,
+			file:     "",
+			expected: "<style>\n@media (prefers-color-scheme: dark) {\n" + darkModePalette + "\n}\n</style>",
+			wantErr:  false,
+		},
+		{
+			name:     "palette from file",
+			html:     true,
+			enabled:  true,
+			file:     "dark.css",
+			expected: "<style>\n@media (prefers-color-scheme: dark) {\n  body {\n    color: #fff;\n  }\n}\n</style>",
+			wantErr:  false,
+		},
+		{
+			name:     "missing file",
+			html:     true,
+			enabled:  true,
+			file:     "missing.css",
+			expected: "",
+			wantErr:  true,
+		},
+		{
+			name:     "without html",
+			html:     false,
+			enabled:  true,
+			file:     "",
+			expected: "",
+			wantErr:  false,
+		},
+		{
+			name:     "disabled",
+			html:     true,
+			enabled:  false,
+			file:     "",
+			expected: "",
+			wantErr:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			config := print.DefaultConfig()
+			config.ModuleRoot = dir
+			config.Settings.HTML = tt.html
+			config.Settings.DarkMode = tt.enabled
+			config.Settings.DarkModeCSSFile = tt.file
+			actual, err := printDarkModeStyle(config)
+			if tt.wantErr {
+				assert.NotNil(err)
+			} else {
+				assert.Nil(err)
+				assert.Equal(tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestPrintModuleSource(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		docsURL  string
+		external bool
+		expected string
+	}{
+		{
+			name:     "registry source",
+			source:   "terraform-aws-modules/vpc/aws",
+			docsURL:  "",
+			external: false,
+			expected: "[terraform-aws-modules/vpc/aws](https://registry.terraform.io/modules/terraform-aws-modules/vpc/aws)",
+		},
+		{
+			name:     "git source",
+			source:   "git::https://example.com/vpc.git",
+			docsURL:  "",
+			external: false,
+			expected: "git::https://example.com/vpc.git",
+		},
+		{
+			name:     "local source",
+			source:   "./modules/foo",
+			docsURL:  "",
+			external: false,
+			expected: "./modules/foo",
+		},
+		{
+			name:     "source with docs",
+			source:   "github.com/gruntwork-io/terraform-aws-vpc//modules/vpc-app",
+			docsURL:  "https://docs.gruntwork.io/reference/modules/terraform-aws-vpc/vpc-app/",
+			external: false,
+			expected: "[github.com/gruntwork-io/terraform-aws-vpc//modules/vpc-app](https://docs.gruntwork.io/reference/modules/terraform-aws-vpc/vpc-app/)",
+		},
+		{
+			name:     "git source with external links",
+			source:   "git::https://example.com/vpc.git?ref=v1.2.0",
+			docsURL:  "",
+			external: true,
+			expected: "[git::https://example.com/vpc.git?ref=v1.2.0](https://example.com/vpc)",
+		},
+		{
+			name:     "local source with external links",
+			source:   "../modules/foo",
+			docsURL:  "",
+			external: true,
+			expected: "[../modules/foo](../modules/foo)",
+		},
+		{
+			name:     "registry source with external links",
+			source:   "terraform-aws-modules/vpc/aws",
+			docsURL:  "",
+			external: true,
+			expected: "[terraform-aws-modules/vpc/aws](https://registry.terraform.io/modules/terraform-aws-modules/vpc/aws)",
+		},
+		{
+			name:     "archive source with external links",
+			source:   "s3::https://s3-eu-west-1.amazonaws.com/bucket/vpc.zip",
+			docsURL:  "",
+			external: true,
+			expected: "s3::https://s3-eu-west-1.amazonaws.com/bucket/vpc.zip",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			config := print.DefaultConfig()
+			config.Settings.ExternalLinks = tt.external
+			actual := printModuleSource(config, &terraform.ModuleCall{Name: "foo", Source: tt.source, DocsURL: tt.docsURL})
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestHideEmptySections(t *testing.T) {
+	sections := []struct {
+		key     string
+		heading string
+		empty   func(*terraform.Module)
+	}{
+		{key: "inputs", heading: "## Inputs", empty: func(m *terraform.Module) { m.Inputs = []*terraform.Input{} }},
+		{key: "modules", heading: "## Modules", empty: func(m *terraform.Module) { m.ModuleCalls = []*terraform.ModuleCall{} }},
+		{key: "outputs", heading: "## Outputs", empty: func(m *terraform.Module) { m.Outputs = []*terraform.Output{} }},
+		{key: "providers", heading: "## Providers", empty: func(m *terraform.Module) { m.Providers = []*terraform.Provider{} }},
+		{key: "requirements", heading: "## Requirements", empty: func(m *terraform.Module) { m.Requirements = []*terraform.Requirement{} }},
+		{key: "resources", heading: "## Resources", empty: func(m *terraform.Module) { m.Resources = []*terraform.Resource{} }},
+	}
+
+	decoders := map[string]func(string) (map[string]interface{}, error){
+		"json": func(s string) (map[string]interface{}, error) {
+			decoded := map[string]interface{}{}
+			err := jsonsdk.Unmarshal([]byte(s), &decoded)
+			return decoded, err
+		},
+		"yaml": func(s string) (map[string]interface{}, error) {
+			decoded := map[string]interface{}{}
+			err := yamlv3.Unmarshal([]byte(s), &decoded)
+			return decoded, err
+		},
+		"toml": func(s string) (map[string]interface{}, error) {
+			decoded := map[string]interface{}{}
+			_, err := tomlsdk.Decode(s, &decoded)
+			return decoded, err
+		},
+	}
+
+	config := testutil.WithSections(testutil.WithHideEmpty())
+
+	loaded, err := testutil.GetModule(&config)
+	assert.Nil(t, err)
+
+	// every possible combination of empty sections
+	for mask := 0; mask < 1<<len(sections); mask++ {
+		copy := *loaded
+		module := &copy
+
+		empty := map[string]bool{}
+		for i, section := range sections {
+			if mask&(1<<i) != 0 {
+				section.empty(module)
+				empty[section.key] = true
+			}
+		}
+
+		for name, fn := range map[string]func(*print.Config) Type{
+			"json":              NewJSON,
+			"yaml":              NewYAML,
+			"toml":              NewTOML,
+			"markdown table":    NewMarkdownTable,
+			"markdown document": NewMarkdownDocument,
+		} {
+			formatter := fn(&config)
+			assert.Nil(t, formatter.Generate(module), name)
+
+			decode, ok := decoders[name]
+			if !ok {
+				for _, section := range sections {
+					assert.Equal(t, !empty[section.key], strings.Contains(formatter.Content(), section.heading+"\n"), "%s: %s of %06b", name, section.key, mask)
+				}
+				continue
+			}
+
+			decoded, err := decode(formatter.Content())
+			assert.Nil(t, err, name)
+
+			for _, section := range sections {
+				_, found := decoded[section.key]
+				assert.Equal(t, !empty[section.key], found, "%s: %s of %06b", name, section.key, mask)
+			}
+		}
+	}
+}
+
+// populateInputValidations sets validations of some of the inputs, examples
+// module doesn't have any validation blocks.
+func populateInputValidations(module *terraform.Module) {
+	for _, input := range module.Inputs {
+		switch input.Name {
+		case "string-1":
+			input.Validations = []*terraform.Condition{
+				{Expression: "length(var.string-1) > 0", ErrorMessage: "It can't be empty."},
+			}
+		case "number-1":
+			input.Validations = []*terraform.Condition{
+				{Expression: "var.number-1 >= -1", ErrorMessage: "It must be positive, or -1 for unlimited."},
+				{Expression: "floor(var.number-1) == var.number-1", ErrorMessage: "It must be an integer."},
+			}
+		}
+	}
+}
+
+// populateAttributes sets 'sensitive' and 'nullable' attributes of some of the
+// inputs and outputs, examples module doesn't declare any of them.
+func populateAttributes(module *terraform.Module) {
+	for _, input := range module.Inputs {
+		switch input.Name {
+		case "string-2":
+			input.Sensitive = true
+		case "number-1":
+			nullable := false
+			input.Nullable = &nullable
+		}
+	}
+	for _, output := range module.Outputs {
+		if output.Name == "output-1" {
+			output.Sensitive = true
+		}
+	}
+}
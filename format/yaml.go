@@ -43,7 +43,7 @@ func (y *yaml) Generate(module *terraform.Module) error {
 	encoder := yamlv3.NewEncoder(buffer)
 	encoder.SetIndent(2)
 
-	if err := encoder.Encode(copy); err != nil {
+	if err := encoder.Encode(hideEmptySections(y.config, copy)); err != nil {
 		return err
 	}
 
@@ -11,12 +11,16 @@ the root directory of this source tree.
 package format
 
 import (
+	jsonsdk "encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	yamlv3 "gopkg.in/yaml.v3"
 
 	"github.com/terraform-docs/terraform-docs/internal/testutil"
+	"github.com/terraform-docs/terraform-docs/internal/types"
 	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
 )
 
 func TestYaml(t *testing.T) {
@@ -34,6 +38,14 @@ func TestYaml(t *testing.T) {
 				}),
 			),
 		},
+		"HideEmpty": {
+			config: testutil.WithDefaultSections(
+				testutil.WithHideEmpty(),
+				testutil.With(func(c *print.Config) {
+					c.ModuleRoot = "empty"
+				}),
+			),
+		},
 		"HideAll": {
 			config: testutil.With(func(c *print.Config) {
 				c.Sections.Header = false // Since we don't show the header, the file won't be loaded at all
@@ -55,6 +67,11 @@ func TestYaml(t *testing.T) {
 		"OnlyDataSources": {
 			config: testutil.With(func(c *print.Config) { c.Sections.DataSources = true }),
 		},
+		"ModulePurpose": {
+			config: testutil.With(func(c *print.Config) {
+				c.Settings.ModulePurpose = true
+			}),
+		},
 		"OnlyHeader": {
 			config: testutil.With(func(c *print.Config) { c.Sections.Header = true }),
 		},
@@ -102,3 +119,109 @@ func TestYaml(t *testing.T) {
 		})
 	}
 }
+
+func TestYamlMoved(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {})
+
+	expected, err := testutil.GetExpected("yaml", "yaml-Moved")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// examples module doesn't have any moved blocks, populate them directly
+	module.Moved = []*terraform.Moved{
+		{From: types.String("null_resource.foo"), To: types.String("null_resource.bar[0]")},
+		{From: types.String(`module.foo["key"]`), To: types.String("module.baz")},
+	}
+
+	formatter := NewYAML(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestYamlEphemeralResources(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Resources = true
+		c.Settings.ShowEphemeralResources = true
+	})
+
+	expected, err := testutil.GetExpected("yaml", "yaml-EphemeralResources")
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	// examples module doesn't have any ephemeral blocks, populate them directly
+	module.EphemeralResources = []*terraform.EphemeralResource{
+		{Type: "password", Name: "db", ProviderName: "random", ProviderSource: "hashicorp/random", Version: types.String("latest")},
+		{Type: "secret_version", Name: "db", ProviderName: "vault", ProviderSource: "acme.com/acme/vault", Version: types.String("1.0.0")},
+	}
+
+	formatter := NewYAML(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	assert.Equal(expected, formatter.Content())
+}
+
+func TestYamlSchema(t *testing.T) {
+	tests := map[string]struct {
+		config print.Config
+	}{
+		"Base": {
+			config: testutil.WithSections(),
+		},
+		"Empty": {
+			config: testutil.WithDefaultSections(
+				testutil.With(func(c *print.Config) {
+					c.ModuleRoot = "empty"
+				}),
+			),
+		},
+		"OutputValues": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Outputs = true
+				c.OutputValues.Enabled = true
+				c.OutputValues.From = "output_values.json"
+				c.Settings.Sensitive = true
+			}),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			module, err := testutil.GetModule(&tt.config)
+			assert.Nil(err)
+
+			yamlFormatter := NewYAML(&tt.config)
+			assert.Nil(yamlFormatter.Generate(module))
+
+			jsonFormatter := NewJSON(&tt.config)
+			assert.Nil(jsonFormatter.Generate(module))
+
+			var fromYAML interface{}
+			assert.Nil(yamlv3.Unmarshal([]byte(yamlFormatter.Content()), &fromYAML))
+
+			// normalize the values decoded from YAML (e.g. int vs float64)
+			// by converting them to JSON first
+			normalized, err := jsonsdk.Marshal(fromYAML)
+			assert.Nil(err)
+
+			var expected, actual interface{}
+			assert.Nil(jsonsdk.Unmarshal([]byte(jsonFormatter.Content()), &expected))
+			assert.Nil(jsonsdk.Unmarshal(normalized, &actual))
+
+			assert.Equal(expected, actual)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// parentDirs returns the parent directories of 'dir', from the closest one up
+// to the root of Git repository (i.e. the one containing '.git') similar to how
+// Git discovers its own configuration. Nothing is returned if 'dir' is not part
+// of a Git repository, to not pick up unrelated config files (e.g. in '/').
+func parentDirs(dir string) []string {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return []string{}
+	}
+
+	if isGitRoot(abs) {
+		return []string{}
+	}
+
+	parents := []string{}
+
+	for current := filepath.Dir(abs); ; current = filepath.Dir(current) {
+		parents = append(parents, current)
+
+		if isGitRoot(current) {
+			return parents
+		}
+
+		// reached the root of filesystem, 'dir' is not in a Git repository
+		if current == filepath.Dir(current) {
+			return []string{}
+		}
+	}
+}
+
+// isGitRoot indicates if 'dir' is the root of a Git repository. '.git' is a
+// directory in repository, but a file in worktree or submodule.
+func isGitRoot(dir string) bool {
+	_, err := os.Stat(filepath.Join(dir, ".git"))
+	return err == nil
+}
+
+// configPath returns the slash-separated path of config 'file' relative to the
+// root of its Git repository, or to the current directory if it's not part of
+// a Git repository, to be used in links to it.
+func configPath(file string) string {
+	if file == "" {
+		return ""
+	}
+
+	abs, err := filepath.Abs(file)
+	if err != nil {
+		return filepath.ToSlash(file)
+	}
+
+	base, err := filepath.Abs(".")
+	if err != nil {
+		return filepath.ToSlash(file)
+	}
+	for current := filepath.Dir(abs); ; current = filepath.Dir(current) {
+		if isGitRoot(current) {
+			base = current
+			break
+		}
+		if current == filepath.Dir(current) {
+			break
+		}
+	}
+
+	rel, err := filepath.Rel(base, abs)
+	if err != nil {
+		return filepath.ToSlash(file)
+	}
+	return filepath.ToSlash(rel)
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+func TestParentDirs(t *testing.T) {
+	root := t.TempDir()
+
+	repo := filepath.Join(root, "repo")
+	module := filepath.Join(repo, "modules", "foo")
+	outside := filepath.Join(root, "outside", "module")
+
+	for _, dir := range []string{filepath.Join(repo, ".git"), module, outside} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := map[string]struct {
+		dir      string
+		expected []string
+	}{
+		"InRepository": {
+			dir:      module,
+			expected: []string{filepath.Join(repo, "modules"), repo},
+		},
+		"RepositoryRoot": {
+			dir:      repo,
+			expected: []string{},
+		},
+		"OutsideRepository": {
+			dir:      outside,
+			expected: []string{},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := parentDirs(tt.dir)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestConfigPath(t *testing.T) {
+	root := t.TempDir()
+
+	repo := filepath.Join(root, "repo")
+	module := filepath.Join(repo, "modules", "foo")
+	outside := filepath.Join(root, "outside", "module")
+
+	for _, dir := range []string{filepath.Join(repo, ".git"), module, outside} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	relative, err := filepath.Rel(cwd, filepath.Join(outside, ".terraform-docs.yml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		file     string
+		expected string
+	}{
+		"Empty": {
+			file:     "",
+			expected: "",
+		},
+		"InRepository": {
+			file:     filepath.Join(module, ".config", ".terraform-docs.yml"),
+			expected: "modules/foo/.config/.terraform-docs.yml",
+		},
+		"RepositoryRoot": {
+			file:     filepath.Join(repo, ".terraform-docs.yml"),
+			expected: ".terraform-docs.yml",
+		},
+		"OutsideRepository": {
+			file:     filepath.Join(outside, ".terraform-docs.yml"),
+			expected: filepath.ToSlash(relative),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := configPath(tt.file)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestReadConfig(t *testing.T) {
+	root := t.TempDir()
+
+	repo := filepath.Join(root, "repo")
+	module := filepath.Join(repo, "modules", "foo")
+
+	for _, dir := range []string{filepath.Join(repo, ".git"), module} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	content := []byte("header-from: file.md\nsort:\n  by: required\n")
+	if err := os.WriteFile(filepath.Join(repo, ".terraform-docs.yml"), content, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	newRuntime := func(dir string, flags map[string]string) *Runtime {
+		config := print.DefaultConfig()
+
+		cmd := &cobra.Command{}
+		cmd.Flags().StringVarP(&config.File, "config", "c", ".terraform-docs.yml", "")
+		cmd.Flags().StringVar(&config.HeaderFrom, "header-from", "main.tf", "")
+		cmd.Flags().StringVar(&config.Sort.By, "sort-by", "name", "")
+		cmd.Flags().BoolVar(&config.Settings.HideEmpty, "hide-empty", false, "")
+
+		for name, value := range flags {
+			if err := cmd.Flags().Set(name, value); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		return &Runtime{
+			rootDir:       dir,
+			formatter:     "markdown",
+			config:        config,
+			cmd:           cmd,
+			isFlagChanged: cmd.Flags().Changed,
+		}
+	}
+
+	t.Run("DiscoveredFromParent", func(t *testing.T) {
+		assert := assert.New(t)
+
+		r := newRuntime(module, map[string]string{})
+		v := viper.New()
+
+		assert.Nil(r.readConfig(v, r.config.File, ""))
+		assert.Nil(r.unmarshalConfig(v, r.config))
+		assert.Equal("file.md", r.config.HeaderFrom)
+		assert.Equal("required", r.config.Sort.By)
+	})
+
+	t.Run("FlagOverridesFile", func(t *testing.T) {
+		assert := assert.New(t)
+
+		r := newRuntime(module, map[string]string{"header-from": "doc.md"})
+		v := viper.New()
+
+		assert.Nil(r.readConfig(v, r.config.File, ""))
+		assert.Nil(r.unmarshalConfig(v, r.config))
+		assert.Equal("doc.md", r.config.HeaderFrom)
+		assert.Equal("required", r.config.Sort.By)
+	})
+
+	t.Run("FlagOfSettings", func(t *testing.T) {
+		assert := assert.New(t)
+
+		r := newRuntime(module, map[string]string{"hide-empty": "true"})
+		v := viper.New()
+
+		assert.Nil(r.readConfig(v, r.config.File, ""))
+		assert.Nil(r.unmarshalConfig(v, r.config))
+		assert.True(r.config.Settings.HideEmpty)
+	})
+
+	t.Run("ExplicitFileMissing", func(t *testing.T) {
+		assert := assert.New(t)
+
+		missing := filepath.Join(root, "missing.yml")
+
+		r := newRuntime(module, map[string]string{"config": missing})
+		v := viper.New()
+
+		err := r.readConfig(v, r.config.File, "")
+		assert.NotNil(err)
+		assert.Equal("config file "+missing+" not found", err.Error())
+	})
+
+	t.Run("UnknownKeys", func(t *testing.T) {
+		assert := assert.New(t)
+
+		file := filepath.Join(root, "unknown.yml")
+		if err := os.WriteFile(file, []byte("header-from: file.md\nsort:\n  bye: required\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		r := newRuntime(module, map[string]string{"config": file})
+		v := viper.New()
+
+		assert.Nil(r.readConfig(v, r.config.File, ""))
+
+		err := r.unmarshalConfig(v, r.config)
+		assert.NotNil(err)
+		assert.Contains(err.Error(), "'sort' has invalid keys: bye")
+	})
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+const gitignoreFile = ".gitignore"
+
+// findRepositoryRoot returns the closest parent directory of 'dir' (including
+// itself) which contains '.git', or 'dir' itself if it's not in a repository.
+func findRepositoryRoot(dir string) string {
+	for current := dir; ; {
+		if _, err := os.Stat(filepath.Join(current, ".git")); err == nil {
+			return current
+		}
+		parent := filepath.Dir(current)
+		if parent == current {
+			return dir
+		}
+		current = parent
+	}
+}
+
+// hasGitignoreEntry reports whether 'content' of '.gitignore' already contains
+// 'entry', with or without its leading slash.
+func hasGitignoreEntry(content []byte, entry string) bool {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == entry || "/"+line == entry {
+			return true
+		}
+	}
+	return false
+}
+
+// writeGitignore adds the path of '--output-file' (relative to the root of the
+// repository) to its '.gitignore', if '--with-gitignore' is set. '.gitignore'
+// is created if it doesn't exist and the path is not added if it's already in
+// there. Nothing is changed with '--output-check' or '--with-dry-run'.
+func writeGitignore(config *print.Config) error {
+	if !config.Settings.Gitignore || config.Output.File == "" || config.Output.Check {
+		return nil
+	}
+
+	filename := config.Output.File
+	if !filepath.IsAbs(filename) {
+		filename = filepath.Join(config.ModuleRoot, filename)
+	}
+	filename, err := filepath.Abs(filename)
+	if err != nil {
+		return err
+	}
+
+	root := findRepositoryRoot(filepath.Dir(filename))
+
+	path, err := filepath.Rel(root, filename)
+	if err != nil {
+		return err
+	}
+	entry := "/" + filepath.ToSlash(path)
+
+	gitignore := filepath.Join(root, gitignoreFile)
+
+	content, err := os.ReadFile(filepath.Clean(gitignore))
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("unable to read %s, %w", gitignoreFile, err)
+	}
+	exists := hasGitignoreEntry(content, entry)
+	if !exists {
+		if len(content) > 0 && !bytes.HasSuffix(content, []byte("\n")) {
+			content = append(content, '\n')
+		}
+		content = append(content, []byte(entry+"\n")...)
+	}
+
+	if config.Output.DryRun {
+		return previewFile(gitignore, content, false)
+	}
+	if exists {
+		return nil
+	}
+
+	return os.WriteFile(gitignore, content, 0644)
+}
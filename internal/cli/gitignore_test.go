@@ -0,0 +1,141 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+func TestHasGitignoreEntry(t *testing.T) {
+	tests := map[string]struct {
+		content  string
+		expected bool
+	}{
+		"Empty": {
+			content:  "",
+			expected: false,
+		},
+		"Missing": {
+			content:  "/.terraform\n*.tfstate\n",
+			expected: false,
+		},
+		"Anchored": {
+			content:  "/.terraform\n/modules/foo/README.md\n",
+			expected: true,
+		},
+		"NotAnchored": {
+			content:  "modules/foo/README.md\n",
+			expected: true,
+		},
+		"Whitespace": {
+			content:  "  /modules/foo/README.md  \n",
+			expected: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := hasGitignoreEntry([]byte(tt.content), "/modules/foo/README.md")
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestWriteGitignore(t *testing.T) {
+	tests := map[string]struct {
+		content  *string
+		file     string
+		enabled  bool
+		check    bool
+		expected *string
+	}{
+		"Disabled": {
+			content:  nil,
+			file:     "README.md",
+			enabled:  false,
+			expected: nil,
+		},
+		"Stdout": {
+			content:  nil,
+			file:     "",
+			enabled:  true,
+			expected: nil,
+		},
+		"Check": {
+			content:  nil,
+			file:     "README.md",
+			enabled:  true,
+			check:    true,
+			expected: nil,
+		},
+		"Create": {
+			content:  nil,
+			file:     "README.md",
+			enabled:  true,
+			expected: strptr("/modules/foo/README.md\n"),
+		},
+		"Append": {
+			content:  strptr("/.terraform"),
+			file:     filepath.Join("docs", "README.md"),
+			enabled:  true,
+			expected: strptr("/.terraform\n/modules/foo/docs/README.md\n"),
+		},
+		"Idempotent": {
+			content:  strptr("modules/foo/README.md\n"),
+			file:     "README.md",
+			enabled:  true,
+			expected: strptr("modules/foo/README.md\n"),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			root := t.TempDir()
+			module := filepath.Join(root, "modules", "foo")
+			assert.Nil(os.MkdirAll(filepath.Join(root, ".git"), 0755))
+			assert.Nil(os.MkdirAll(module, 0755))
+
+			gitignore := filepath.Join(root, gitignoreFile)
+			if tt.content != nil {
+				assert.Nil(os.WriteFile(gitignore, []byte(*tt.content), 0644))
+			}
+
+			config := print.DefaultConfig()
+			config.ModuleRoot = module
+			config.Output.File = tt.file
+			config.Output.Check = tt.check
+			config.Settings.Gitignore = tt.enabled
+
+			err := writeGitignore(config)
+			assert.Nil(err)
+
+			actual, err := os.ReadFile(gitignore)
+			if tt.expected == nil {
+				assert.True(os.IsNotExist(err))
+				return
+			}
+			assert.Nil(err)
+			assert.Equal(*tt.expected, string(actual))
+		})
+	}
+}
+
+func strptr(s string) *string {
+	return &s
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+const helmValuesFile = "helm-values.yaml"
+
+// helmValuesPlaceholder returns the placeholder value of an output in Helm
+// values based on its type inferred from the value expression.
+func helmValuesPlaceholder(output *terraform.Output) *yaml.Node {
+	switch string(output.Type) {
+	case "string":
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "", Style: yaml.DoubleQuotedStyle}
+	case "number":
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: "0"}
+	case "bool":
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: "false"}
+	case "tuple":
+		return &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq", Style: yaml.FlowStyle}
+	case "object":
+		return &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Style: yaml.FlowStyle}
+	}
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}
+}
+
+// helmValuesContent returns the outputs of the module matching the pattern of
+// '--helm-values-pattern' as Helm values, with the name of the outputs as keys
+// and their description as comment.
+func helmValuesContent(config *print.Config, module *terraform.Module) (string, error) {
+	pattern, err := regexp.Compile(config.Settings.HelmValuesPattern)
+	if err != nil {
+		return "", err
+	}
+
+	values := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	for _, output := range module.Outputs {
+		if !pattern.MatchString(output.Name) {
+			continue
+		}
+		key := &yaml.Node{
+			Kind:        yaml.ScalarNode,
+			Tag:         "!!str",
+			Value:       output.Name,
+			HeadComment: strings.TrimSpace(string(output.Description)),
+		}
+		values.Content = append(values.Content, key, helmValuesPlaceholder(output))
+	}
+	if len(values.Content) == 0 {
+		values.Style = yaml.FlowStyle
+	}
+
+	buffer := new(bytes.Buffer)
+	encoder := yaml.NewEncoder(buffer)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(values); err != nil {
+		return "", err
+	}
+
+	return buffer.String(), nil
+}
+
+// writeHelmValues writes 'helm-values.yaml' into the module root with the
+// outputs of the module, if '--with-helm-values-output' is set, regardless of
+// the formatter.
+func writeHelmValues(config *print.Config, module *terraform.Module) error {
+	if !config.Settings.HelmValuesOutput {
+		return nil
+	}
+
+	content, err := helmValuesContent(config, module)
+	if err != nil {
+		return err
+	}
+
+	return writeModuleFile(config, helmValuesFile, content)
+}
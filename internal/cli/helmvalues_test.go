@@ -0,0 +1,121 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+func TestHelmValuesContent(t *testing.T) {
+	outputs := []*terraform.Output{
+		{Name: "helm_name", Type: "string", Description: "Name of the release."},
+		{Name: "helm_replicas", Type: "number"},
+		{Name: "helm_enabled", Type: "bool", Description: "First line.\nSecond line."},
+		{Name: "helm_hosts", Type: "tuple"},
+		{Name: "helm_labels", Type: "object"},
+		{Name: "helm_arn", Type: "any"},
+		{Name: "bucket"},
+	}
+	tests := map[string]struct {
+		pattern  string
+		outputs  []*terraform.Output
+		expected string
+	}{
+		"AllOutputs": {
+			pattern: ".*",
+			outputs: outputs[5:],
+			expected: "helm_arn: null\n" +
+				"bucket: null\n",
+		},
+		"Pattern": {
+			pattern: "^helm_",
+			outputs: outputs,
+			expected: "# Name of the release.\n" +
+				"helm_name: \"\"\n" +
+				"helm_replicas: 0\n" +
+				"# First line.\n" +
+				"# Second line.\n" +
+				"helm_enabled: false\n" +
+				"helm_hosts: []\n" +
+				"helm_labels: {}\n" +
+				"helm_arn: null\n",
+		},
+		"NoMatch": {
+			pattern:  "^chart_",
+			outputs:  outputs,
+			expected: "{}\n",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.DefaultConfig()
+			config.Settings.HelmValuesPattern = tt.pattern
+
+			actual, err := helmValuesContent(config, &terraform.Module{Outputs: tt.outputs})
+			assert.Nil(err)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestWriteHelmValues(t *testing.T) {
+	tests := map[string]struct {
+		file    string
+		enabled bool
+	}{
+		"Disabled": {
+			file:    "README.md",
+			enabled: false,
+		},
+		"Stdout": {
+			file:    "",
+			enabled: true,
+		},
+		"OutputFileInSubdir": {
+			file:    filepath.Join("docs", "README.md"),
+			enabled: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			root := t.TempDir()
+
+			config := print.DefaultConfig()
+			config.ModuleRoot = root
+			config.Output.File = tt.file
+			config.Settings.HelmValuesOutput = tt.enabled
+
+			module := &terraform.Module{Outputs: []*terraform.Output{{Name: "helm_name", Type: "string"}}}
+
+			err := writeHelmValues(config, module)
+			assert.Nil(err)
+
+			actual, err := os.ReadFile(filepath.Join(root, helmValuesFile))
+			if !tt.enabled {
+				assert.True(os.IsNotExist(err))
+				return
+			}
+			assert.Nil(err)
+			assert.Equal("helm_name: \"\"\n", string(actual))
+		})
+	}
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+const inputDefaultsFile = "defaults.auto.tfvars"
+
+// inputDefaultsContent returns the optional inputs of the module, which have a
+// non-null default, as tfvars assignments of their default value with their
+// description as comment. Inputs without description are assigned as is.
+func inputDefaultsContent(module *terraform.Module) string {
+	var b strings.Builder
+	for _, input := range module.Inputs {
+		if !input.Default.HasDefault() {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		if description := strings.TrimSpace(string(input.Description)); description != "" {
+			b.WriteString("# " + strings.ReplaceAll(description, "\n", "\n# ") + "\n")
+		}
+		fmt.Fprintf(&b, "%s = %s\n", input.Name, input.GetValue())
+	}
+	return b.String()
+}
+
+// writeInputDefaults writes 'defaults.auto.tfvars' into the module root with the
+// default values of the optional inputs of the module, if
+// '--with-module-input-defaults-file' is set, regardless of the formatter.
+func writeInputDefaults(config *print.Config, module *terraform.Module) error {
+	if !config.Settings.ModuleInputDefaultsFile {
+		return nil
+	}
+	return writeModuleFile(config, inputDefaultsFile, inputDefaultsContent(module))
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/types"
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+func TestInputDefaultsContent(t *testing.T) {
+	tests := map[string]struct {
+		inputs   []*terraform.Input
+		expected string
+	}{
+		"NoInputs": {
+			inputs:   []*terraform.Input{},
+			expected: "",
+		},
+		"Inputs": {
+			inputs: []*terraform.Input{
+				{Name: "name", Description: "Name of the bucket.", Default: types.ValueOf(nil), Required: true},
+				{Name: "region", Description: "Region of the bucket.", Default: types.ValueOf("eu-west-1")},
+				{Name: "versioning", Default: types.ValueOf(true)},
+				{Name: "tags", Description: "Tags of the bucket.\nMerged with the default tags.", Default: types.ValueOf(map[string]interface{}{"owner": "team"})},
+				{Name: "kms_key", Description: "KMS key of the bucket.", Default: types.ValueOf(nil)},
+			},
+			expected: "# Region of the bucket.\n" +
+				"region = \"eu-west-1\"\n" +
+				"\n" +
+				"versioning = true\n" +
+				"\n" +
+				"# Tags of the bucket.\n" +
+				"# Merged with the default tags.\n" +
+				"tags = {\n  \"owner\": \"team\"\n}\n",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, inputDefaultsContent(&terraform.Module{Inputs: tt.inputs}))
+		})
+	}
+}
+
+func TestWriteInputDefaults(t *testing.T) {
+	tests := map[string]struct {
+		file    string
+		enabled bool
+	}{
+		"Disabled": {
+			file:    "README.md",
+			enabled: false,
+		},
+		"Stdout": {
+			file:    "",
+			enabled: true,
+		},
+		"OutputFileInSubdir": {
+			file:    filepath.Join("docs", "README.md"),
+			enabled: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			root := t.TempDir()
+			assert.Nil(os.MkdirAll(filepath.Join(root, "docs"), 0755))
+
+			config := print.DefaultConfig()
+			config.ModuleRoot = root
+			config.Output.File = tt.file
+			config.Settings.ModuleInputDefaultsFile = tt.enabled
+
+			module := &terraform.Module{
+				Inputs: []*terraform.Input{{Name: "a", Default: types.ValueOf("b")}},
+			}
+
+			err := writeInputDefaults(config, module)
+			assert.Nil(err)
+
+			actual, err := os.ReadFile(filepath.Join(root, inputDefaultsFile))
+			if !tt.enabled {
+				assert.True(os.IsNotExist(err))
+				return
+			}
+			assert.Nil(err)
+			assert.Equal("a = \"b\"\n", string(actual))
+		})
+	}
+}
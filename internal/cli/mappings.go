@@ -15,25 +15,77 @@ var flagMappings = map[string]string{
 	"header-from": "header-from",
 	"footer-from": "footer-from",
 
-	"hide-empty": "hide-empty",
+	"terraform-version": "terraform-version",
+
+	"with-readme-template":          "content-from",
+	"with-module-deprecations-file": "deprecations-from",
+	"with-var-file-defaults":        "var-file-defaults",
+
+	"hide-empty": "settings.hide-empty",
 
 	"show": "sections.show",
 	"hide": "sections.hide",
 
-	"output-file":     "output.file",
-	"output-mode":     "output.mode",
-	"output-template": "output.template",
+	"output-file":          "output.file",
+	"output-mode":          "output.mode",
+	"output-notion":        "output.notion",
+	"output-template":      "output.template",
+	"output-template-file": "output.template-file",
 
 	"output-values":      "output-values.enabled",
 	"output-values-from": "output-values.from",
 
-	"sort":             "sort.enabled",
-	"sort-by":          "sort.by",
-	"sort-by-required": "required",
-	"sort-by-type":     "type",
+	"with-example-plan": "example-plan.enabled",
+	"example-plan-vars": "example-plan.vars",
+
+	"with-dependency-lock-diff": "lock-diff.enabled",
+	"lock-diff-from":            "lock-diff.from",
+	"lock-diff-to":              "lock-diff.to",
+
+	"no-cache":  "cache.enabled",
+	"cache-dir": "cache.dir",
+
+	"with-changelog-file": "changelog.enabled",
+	"changelog-version":   "changelog.versions",
+
+	"with-tfdocs-config-link": "config-link.enabled",
+	"repo-url":                "config-link.repo-url",
+
+	"exclude-variable": "exclude.variables",
+	"exclude-output":   "exclude.outputs",
+	"exclude-file":     "exclude.file",
+
+	"with-module-health-score": "health-score.enabled",
+
+	"with-module-registry-link": "registry.enabled",
+	"registry-namespace":        "registry.namespace",
+	"registry-name":             "registry.name",
+	"registry-provider":         "registry.provider",
+
+	"with-sensitive-defaults-redacted": "sensitive.redact-defaults",
+	"sensitive-patterns":               "sensitive.patterns",
+
+	"with-experimental-opentelemetry": "experimental.opentelemetry",
+
+	"fail-on-undocumented-inputs":  "fail-on.undocumented-inputs",
+	"fail-on-undocumented-outputs": "fail-on.undocumented-outputs",
+	"strict":                       "fail-on.markdown-lint",
+
+	"with-module-map": "recursive.module-map",
+	"parallelism":     "recursive.parallelism",
+
+	"sort":                  "sort.enabled",
+	"sort-by":               "sort.by",
+	"sort-by-required":      "required",
+	"sort-by-type":          "type",
+	"with-pinned-variables": "sort.pinned-bottom-variables",
+
+	"with-sorted-providers":    "sort.providers",
+	"with-sorted-requirements": "sort.requirements",
 
 	"anchor":        "settings.anchor",
 	"color":         "settings.color",
+	"compact":       "settings.compact",
 	"default":       "settings.default",
 	"description":   "settings.description",
 	"escape":        "settings.escape",
@@ -41,5 +93,70 @@ var flagMappings = map[string]string{
 	"read-comments": "settings.read-comments",
 	"required":      "settings.required",
 	"sensitive":     "settings.sensitive",
+	"show-checks":   "settings.show-checks",
 	"type":          "settings.type",
+
+	"call-graph-depth":          "settings.call-graph-depth",
+	"confluence-table-style":    "settings.confluence-table-style",
+	"dark-mode-css-file":        "settings.dark-mode-css-file",
+	"helm-values-pattern":       "settings.helm-values-pattern",
+	"html-mode":                 "settings.html-mode",
+	"indentation-level":         "settings.indentation-level",
+	"pagination-size":           "settings.pagination-size",
+	"show-attributes":           "settings.show-attributes",
+	"show-column-default":       "settings.default",
+	"show-column-required":      "settings.required",
+	"show-column-sensitive":     "settings.sensitive",
+	"show-column-type":          "settings.type",
+	"show-core-version":         "settings.show-core-version",
+	"show-ephemeral-resources":  "settings.show-ephemeral-resources",
+	"show-lifecycle-conditions": "settings.show-lifecycle-conditions",
+	"show-moved":                "settings.show-moved",
+	"show-summary":              "settings.show-summary",
+	"show-validations":          "settings.show-validations",
+
+	"with-ascii-type-diagrams":             "settings.ascii-type-diagrams",
+	"with-auto-detect-regions":             "settings.auto-detect-regions",
+	"with-azure-devops-wiki":               "settings.azure-devops-wiki",
+	"with-cost-estimate":                   "settings.cost-estimate",
+	"with-dark-mode":                       "settings.dark-mode",
+	"with-escaped-pipes":                   "settings.escaped-pipes",
+	"with-example-outputs":                 "settings.example-outputs",
+	"with-examples-runner":                 "settings.examples-runner",
+	"with-external-links":                  "settings.external-links",
+	"with-gitignore":                       "settings.gitignore",
+	"with-graph-format":                    "settings.graph-format",
+	"with-gruntwork-links":                 "settings.gruntwork-links",
+	"with-hcl-examples":                    "settings.hcl-examples",
+	"with-helm-values-output":              "settings.helm-values-output",
+	"with-input-cardinality":               "settings.input-cardinality",
+	"with-input-hcl":                       "settings.input-hcl",
+	"with-input-history":                   "settings.input-history",
+	"with-input-table-pagination":          "settings.input-table-pagination",
+	"with-license":                         "settings.license",
+	"with-markdown-lint":                   "settings.markdown-lint",
+	"with-module-call-graph":               "settings.module-call-graph",
+	"with-module-input-defaults-file":      "settings.module-input-defaults-file",
+	"with-module-purpose":                  "settings.module-purpose",
+	"with-output-consumers":                "settings.output-consumers",
+	"with-output-deprecation":              "settings.output-deprecation",
+	"with-output-value-type":               "settings.output-value-type",
+	"with-provider-source-namespace":       "settings.provider-source-namespace",
+	"with-provider-source-version-matrix":  "settings.provider-source-version-matrix",
+	"with-provider-version-badges":         "settings.provider-version-badges",
+	"with-required-json-schema-properties": "settings.required-json-schema-properties",
+	"with-required-version-badge":          "settings.required-version-badge",
+	"with-run-tests":                       "settings.run-tests",
+	"with-s3-backend-docs":                 "settings.s3-backend-docs",
+	"with-searchable-table":                "settings.searchable-table",
+	"with-section-separators":              "settings.section-separators",
+	"with-sensitive-summary":               "settings.sensitive-summary",
+	"with-show-defaults-type":              "settings.show-defaults-type",
+	"with-table-of-contents":               "settings.table-of-contents",
+	"with-tag-policy":                      "settings.tag-policy",
+	"with-tfsec-results":                   "settings.tfsec-results",
+	"with-tftest-examples":                 "settings.tftest-examples",
+	"with-variable-example-block":          "settings.variable-example-block",
+	"with-variable-export":                 "settings.variable-export",
+	"with-variable-summary-table":          "settings.variable-summary-table",
 }
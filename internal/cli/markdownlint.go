@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+// markdownLintExitCode is the exit code of terraform-docs if the generated
+// content has any violation of markdownlint rules, with '--strict' flag.
+const markdownLintExitCode = 2
+
+// markdownLintCommand is the command of markdownlint-cli to lint the generated
+// content with, which reads it from stdin.
+var markdownLintCommand = []string{"markdownlint", "--stdin"}
+
+// markdownLintError represents the violations of markdownlint rules of the
+// generated content of a module.
+type markdownLintError struct {
+	violations []string
+}
+
+func (e *markdownLintError) Error() string {
+	return fmt.Sprintf("generated content has %d markdownlint violation(s)", len(e.violations))
+}
+
+// ExitCode returns the exit code of terraform-docs for the error.
+func (e *markdownLintError) ExitCode() int {
+	return markdownLintExitCode
+}
+
+// parseMarkdownLint returns the violations reported by markdownlint-cli, one
+// per line, e.g. 'stdin:3 MD022/blanks-around-headings Headings should be ...'.
+func parseMarkdownLint(content []byte) []string {
+	violations := make([]string, 0)
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			violations = append(violations, line)
+		}
+	}
+
+	return violations
+}
+
+// lintMarkdown runs markdownlint on the generated 'content', if '--with-markdown-lint'
+// is set, and prints its violations to stderr. The violations are returned as
+// error with '--strict' flag, so nothing is written for the module.
+func lintMarkdown(config *print.Config, content string) error {
+	if !config.Settings.MarkdownLint {
+		return nil
+	}
+
+	var stderr bytes.Buffer
+
+	cmd := exec.Command(markdownLintCommand[0], markdownLintCommand[1:]...) //nolint:gosec
+	cmd.Stdin = strings.NewReader(content)
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+
+	// 'markdownlint' exits with non-zero code if there's any violation, which
+	// is already reported in its output
+	var exitErr *exec.ExitError
+	if err != nil && (!errors.As(err, &exitErr) || stderr.Len() == 0) {
+		return fmt.Errorf("caught error while running the markdownlint: %w", err)
+	}
+
+	violations := parseMarkdownLint(stderr.Bytes())
+	for _, violation := range violations {
+		fmt.Fprintf(os.Stderr, "%s: %s\n", config.ModuleRoot, violation)
+	}
+
+	if len(violations) > 0 && config.FailOn.MarkdownLint {
+		return &markdownLintError{violations: violations}
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+func TestParseMarkdownLint(t *testing.T) {
+	tests := map[string]struct {
+		content  string
+		expected []string
+	}{
+		"Empty": {
+			content:  "",
+			expected: []string{},
+		},
+		"Violations": {
+			content: "stdin:3 MD022/blanks-around-headings Headings should be surrounded by blank lines\n" +
+				"\n" +
+				"stdin:12:81 MD013/line-length Line length [Expected: 80; Actual: 95]\n",
+			expected: []string{
+				"stdin:3 MD022/blanks-around-headings Headings should be surrounded by blank lines",
+				"stdin:12:81 MD013/line-length Line length [Expected: 80; Actual: 95]",
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := parseMarkdownLint([]byte(tt.content))
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestLintMarkdown(t *testing.T) {
+	violation := []string{"sh", "-c", "cat >/dev/null; echo 'stdin:1 MD041/first-line-heading' >&2; exit 1"}
+	clean := []string{"sh", "-c", "cat >/dev/null"}
+
+	tests := map[string]struct {
+		command []string
+		enabled bool
+		strict  bool
+		wantErr bool
+		errMsg  string
+	}{
+		"Disabled": {
+			command: violation,
+			enabled: false,
+			strict:  true,
+			wantErr: false,
+		},
+		"NoViolations": {
+			command: clean,
+			enabled: true,
+			strict:  true,
+			wantErr: false,
+		},
+		"Violations": {
+			command: violation,
+			enabled: true,
+			strict:  false,
+			wantErr: false,
+		},
+		"ViolationsStrict": {
+			command: violation,
+			enabled: true,
+			strict:  true,
+			wantErr: true,
+			errMsg:  "generated content has 1 markdownlint violation(s)",
+		},
+		"CommandNotFound": {
+			command: []string{"terraform-docs-markdownlint-not-found"},
+			enabled: true,
+			strict:  false,
+			wantErr: true,
+			errMsg:  "caught error while running the markdownlint: exec: \"terraform-docs-markdownlint-not-found\": executable file not found in $PATH",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			command := markdownLintCommand
+			markdownLintCommand = tt.command
+			defer func() { markdownLintCommand = command }()
+
+			config := print.DefaultConfig()
+			config.Settings.MarkdownLint = tt.enabled
+			config.FailOn.MarkdownLint = tt.strict
+
+			err := lintMarkdown(config, "## Inputs\n\nNo inputs.\n")
+			if tt.wantErr {
+				assert.NotNil(err)
+				assert.Equal(tt.errMsg, err.Error())
+			} else {
+				assert.Nil(err)
+			}
+		})
+	}
+}
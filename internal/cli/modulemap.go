@@ -0,0 +1,127 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+const (
+	moduleMapMarkdown = "MODULE_MAP.md"
+	moduleMapJSON     = "modules.json"
+)
+
+// moduleMapItem represents a submodule discovered by '--recursive' in the
+// overview document generated with '--with-module-map'.
+type moduleMapItem struct {
+	Name          string `json:"name"`
+	Path          string `json:"path"`
+	Description   string `json:"description"`
+	Inputs        int    `json:"inputs"`
+	Outputs       int    `json:"outputs"`
+	Documentation string `json:"documentation"`
+}
+
+// newModuleMapItem returns item of the overview document for the module located
+// in 'config.ModuleRoot', relative to 'rootDir'.
+func newModuleMapItem(rootDir string, config *print.Config, module *terraform.Module) (*moduleMapItem, error) {
+	path, err := filepath.Rel(rootDir, config.ModuleRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	path = filepath.ToSlash(path)
+
+	return &moduleMapItem{
+		Name:          filepath.Base(config.ModuleRoot),
+		Path:          path,
+		Description:   headerSummary(module.Header),
+		Inputs:        len(module.Inputs),
+		Outputs:       len(module.Outputs),
+		Documentation: path + "/" + filepath.ToSlash(config.Output.File),
+	}, nil
+}
+
+// headerSummary returns the first non-empty line of the header, without any
+// leading Markdown heading characters.
+func headerSummary(header string) string {
+	for _, line := range strings.Split(header, "\n") {
+		line = strings.TrimSpace(strings.TrimLeft(line, "# "))
+		if line != "" {
+			return line
+		}
+	}
+	return ""
+}
+
+// writeModuleMap writes the overview document of all the submodules in
+// 'rootDir', as 'modules.json' for 'json' formatter and 'MODULE_MAP.md'
+// otherwise.
+func writeModuleMap(rootDir string, formatter string, items []*moduleMapItem) error {
+	var filename string
+	var content string
+
+	if formatter == "json" {
+		filename = moduleMapJSON
+
+		buffer := new(bytes.Buffer)
+		encoder := json.NewEncoder(buffer)
+		encoder.SetIndent("", "  ")
+		encoder.SetEscapeHTML(false)
+
+		if err := encoder.Encode(struct {
+			Modules []*moduleMapItem `json:"modules"`
+		}{items}); err != nil {
+			return err
+		}
+
+		content = buffer.String()
+	} else {
+		filename = moduleMapMarkdown
+		content = moduleMapMarkdownContent(items)
+	}
+
+	return os.WriteFile(filepath.Join(rootDir, filename), []byte(content), 0644)
+}
+
+// moduleMapMarkdownContent returns the overview document of all the submodules
+// as Markdown table.
+func moduleMapMarkdownContent(items []*moduleMapItem) string {
+	var b strings.Builder
+
+	b.WriteString("# Module Map\n\n")
+
+	if len(items) == 0 {
+		b.WriteString("No modules.\n")
+		return b.String()
+	}
+
+	b.WriteString("| Name | Path | Description | Inputs | Outputs |\n")
+	b.WriteString("|------|------|-------------|--------|---------|\n")
+
+	for _, item := range items {
+		description := strings.ReplaceAll(item.Description, "|", "\\|")
+		if description == "" {
+			description = "n/a"
+		}
+		fmt.Fprintf(&b, "| [%s](%s) | `%s` | %s | %d | %d |\n", item.Name, item.Documentation, item.Path, description, item.Inputs, item.Outputs)
+	}
+
+	return b.String()
+}
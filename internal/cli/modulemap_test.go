@@ -0,0 +1,154 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+func TestHeaderSummary(t *testing.T) {
+	tests := map[string]struct {
+		header   string
+		expected string
+	}{
+		"Empty": {
+			header:   "",
+			expected: "",
+		},
+		"SingleLine": {
+			header:   "Module to create foo resources.",
+			expected: "Module to create foo resources.",
+		},
+		"MultiLine": {
+			header:   "\n\nModule to create foo resources.\n\nMore details here.",
+			expected: "Module to create foo resources.",
+		},
+		"Heading": {
+			header:   "# terraform-foo-module\n\nModule to create foo resources.",
+			expected: "terraform-foo-module",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := headerSummary(tt.header)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestNewModuleMapItem(t *testing.T) {
+	assert := assert.New(t)
+
+	config := print.NewConfig()
+	config.ModuleRoot = filepath.Join("path", "to", "module", "modules", "foo")
+	config.Output.File = "README.md"
+
+	module := &terraform.Module{
+		Header:  "Module to create foo resources.",
+		Inputs:  []*terraform.Input{{Name: "a"}, {Name: "b"}},
+		Outputs: []*terraform.Output{{Name: "c"}},
+	}
+
+	item, err := newModuleMapItem(filepath.Join("path", "to", "module"), config, module)
+	assert.Nil(err)
+	assert.Equal(&moduleMapItem{
+		Name:          "foo",
+		Path:          "modules/foo",
+		Description:   "Module to create foo resources.",
+		Inputs:        2,
+		Outputs:       1,
+		Documentation: "modules/foo/README.md",
+	}, item)
+}
+
+func TestWriteModuleMap(t *testing.T) {
+	items := []*moduleMapItem{
+		{
+			Name:          "bar",
+			Path:          "modules/bar",
+			Description:   "",
+			Inputs:        0,
+			Outputs:       3,
+			Documentation: "modules/bar/README.md",
+		},
+		{
+			Name:          "foo",
+			Path:          "modules/foo",
+			Description:   "Module to create foo | baz resources.",
+			Inputs:        2,
+			Outputs:       1,
+			Documentation: "modules/foo/README.md",
+		},
+	}
+	tests := map[string]struct {
+		formatter string
+		items     []*moduleMapItem
+		filename  string
+		expected  string
+	}{
+		"Markdown": {
+			formatter: "markdown table",
+			items:     items,
+			filename:  "MODULE_MAP.md",
+			expected: "# Module Map\n\n" +
+				"| Name | Path | Description | Inputs | Outputs |\n" +
+				"|------|------|-------------|--------|---------|\n" +
+				"| [bar](modules/bar/README.md) | `modules/bar` | n/a | 0 | 3 |\n" +
+				"| [foo](modules/foo/README.md) | `modules/foo` | Module to create foo \\| baz resources. | 2 | 1 |\n",
+		},
+		"MarkdownEmpty": {
+			formatter: "markdown table",
+			items:     []*moduleMapItem{},
+			filename:  "MODULE_MAP.md",
+			expected:  "# Module Map\n\nNo modules.\n",
+		},
+		"JSON": {
+			formatter: "json",
+			items:     items[1:],
+			filename:  "modules.json",
+			expected: "{\n" +
+				"  \"modules\": [\n" +
+				"    {\n" +
+				"      \"name\": \"foo\",\n" +
+				"      \"path\": \"modules/foo\",\n" +
+				"      \"description\": \"Module to create foo | baz resources.\",\n" +
+				"      \"inputs\": 2,\n" +
+				"      \"outputs\": 1,\n" +
+				"      \"documentation\": \"modules/foo/README.md\"\n" +
+				"    }\n" +
+				"  ]\n" +
+				"}\n",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			dir := t.TempDir()
+
+			err := writeModuleMap(dir, tt.formatter, tt.items)
+			assert.Nil(err)
+
+			actual, err := os.ReadFile(filepath.Join(dir, tt.filename))
+			assert.Nil(err)
+			assert.Equal(tt.expected, string(actual))
+		})
+	}
+}
@@ -0,0 +1,616 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	notionAPI     = "https://api.notion.com/v1"
+	notionVersion = "2022-06-28"
+	notionToken   = "NOTION_TOKEN"
+
+	// timeout of each request to Notion API.
+	notionTimeout = 30 * time.Second
+
+	// maximum number of blocks which can be appended in one request (as well as
+	// the number of rows of one table), and maximum length of content of one
+	// rich text object.
+	notionMaxBlocks   = 100
+	notionMaxTextSize = 2000
+
+	// characters which can be escaped with backslash in Markdown.
+	markdownEscapable = "\\`*_{}[]()#+-.!|<>~"
+)
+
+// notionLanguages contains the languages of code blocks supported by Notion,
+// the other ones are rendered as "plain text".
+var notionLanguages = map[string]bool{
+	"bash":       true,
+	"go":         true,
+	"hcl":        true,
+	"json":       true,
+	"markdown":   true,
+	"plain text": true,
+	"shell":      true,
+	"toml":       true,
+	"xml":        true,
+	"yaml":       true,
+}
+
+var (
+	htmlAnchorRegex = regexp.MustCompile(`<a (name|id)="[^"]*"></a>`)
+	htmlBreakRegex  = regexp.MustCompile(`<br\s*/?>`)
+	tableSepRegex   = regexp.MustCompile(`^\|?(\s*:?-+:?\s*\|)+\s*:?-*:?\s*$`)
+)
+
+type notionLink struct {
+	URL string `json:"url"`
+}
+
+type notionText struct {
+	Content string      `json:"content"`
+	Link    *notionLink `json:"link,omitempty"`
+}
+
+type notionAnnotations struct {
+	Bold   bool `json:"bold,omitempty"`
+	Italic bool `json:"italic,omitempty"`
+	Code   bool `json:"code,omitempty"`
+}
+
+type notionRichText struct {
+	Type        string             `json:"type"`
+	Text        notionText         `json:"text"`
+	Annotations *notionAnnotations `json:"annotations,omitempty"`
+}
+
+type notionTextBlock struct {
+	RichText []notionRichText `json:"rich_text"`
+}
+
+type notionCodeBlock struct {
+	RichText []notionRichText `json:"rich_text"`
+	Language string           `json:"language"`
+}
+
+type notionTableBlock struct {
+	TableWidth      int            `json:"table_width"`
+	HasColumnHeader bool           `json:"has_column_header"`
+	HasRowHeader    bool           `json:"has_row_header"`
+	Children        []*notionBlock `json:"children"`
+}
+
+type notionTableRowBlock struct {
+	Cells [][]notionRichText `json:"cells"`
+}
+
+// notionBlock represents a block of Notion API, only one of its content is
+// set based on its 'Type'.
+type notionBlock struct {
+	Object           string               `json:"object"`
+	Type             string               `json:"type"`
+	Heading1         *notionTextBlock     `json:"heading_1,omitempty"`
+	Heading2         *notionTextBlock     `json:"heading_2,omitempty"`
+	Heading3         *notionTextBlock     `json:"heading_3,omitempty"`
+	Paragraph        *notionTextBlock     `json:"paragraph,omitempty"`
+	BulletedListItem *notionTextBlock     `json:"bulleted_list_item,omitempty"`
+	Code             *notionCodeBlock     `json:"code,omitempty"`
+	Table            *notionTableBlock    `json:"table,omitempty"`
+	TableRow         *notionTableRowBlock `json:"table_row,omitempty"`
+}
+
+// notionWriter replaces the content of Notion page with the given ID by the
+// generated Markdown, converted to Notion blocks. The integration token is
+// read from 'NOTION_TOKEN' environment variable. The existing blocks of the
+// page are only deleted once all the new ones are appended, so the page is
+// never left empty if any of the requests fails.
+type notionWriter struct {
+	pageID  string
+	baseURL string
+
+	client *http.Client
+}
+
+// Write content to Notion page
+func (nw *notionWriter) Write(p []byte) (int, error) {
+	token := os.Getenv(notionToken)
+	if token == "" {
+		return 0, fmt.Errorf("environment variable '%s' is missing", notionToken)
+	}
+
+	blocks := markdownToNotionBlocks(string(p))
+
+	children, err := nw.children(token)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := 0; i < len(blocks); i += notionMaxBlocks {
+		end := i + notionMaxBlocks
+		if end > len(blocks) {
+			end = len(blocks)
+		}
+
+		body := struct {
+			Children []*notionBlock `json:"children"`
+		}{blocks[i:end]}
+
+		if err := nw.request(token, http.MethodPatch, "/blocks/"+nw.pageID+"/children", body, nil); err != nil {
+			return 0, err
+		}
+	}
+
+	for _, id := range children {
+		if err := nw.request(token, http.MethodDelete, "/blocks/"+id, nil, nil); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(p), nil
+}
+
+// children returns ID of all the existing blocks of the page.
+func (nw *notionWriter) children(token string) ([]string, error) {
+	type response struct {
+		Results []struct {
+			ID string `json:"id"`
+		} `json:"results"`
+		HasMore    bool   `json:"has_more"`
+		NextCursor string `json:"next_cursor"`
+	}
+
+	ids := []string{}
+	cursor := ""
+
+	for {
+		query := url.Values{}
+		query.Set("page_size", fmt.Sprintf("%d", notionMaxBlocks))
+		if cursor != "" {
+			query.Set("start_cursor", cursor)
+		}
+
+		var res response
+		if err := nw.request(token, http.MethodGet, "/blocks/"+nw.pageID+"/children?"+query.Encode(), nil, &res); err != nil {
+			return nil, err
+		}
+
+		for _, r := range res.Results {
+			ids = append(ids, r.ID)
+		}
+
+		if !res.HasMore || res.NextCursor == "" {
+			return ids, nil
+		}
+		cursor = res.NextCursor
+	}
+}
+
+// request sends a request to Notion API and decodes its response in 'result',
+// if not nil.
+func (nw *notionWriter) request(token string, method string, path string, body interface{}, result interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		content, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(content)
+	}
+
+	req, err := http.NewRequest(method, nw.baseURL+path, reader)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Notion-Version", notionVersion)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := nw.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close() //nolint:errcheck
+
+	content, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode >= 300 {
+		var e struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(content, &e); err != nil || e.Message == "" {
+			e.Message = http.StatusText(res.StatusCode)
+		}
+		return fmt.Errorf("notion: %s %s failed with status %d: %s", method, strings.SplitN(path, "?", 2)[0], res.StatusCode, e.Message)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(content, result); err != nil {
+		return errors.New("notion: unable to decode response")
+	}
+
+	return nil
+}
+
+// markdownToNotionBlocks converts Markdown to Notion blocks. Headings, tables,
+// code blocks, lists and paragraphs are supported, as well as bold, italic,
+// inline code and links inside them. HTML anchors are removed and line breaks
+// are converted to new line.
+func markdownToNotionBlocks(content string) []*notionBlock {
+	blocks := []*notionBlock{}
+	paragraph := []string{}
+
+	flush := func() {
+		if len(paragraph) == 0 {
+			return
+		}
+		blocks = append(blocks, newNotionTextBlock("paragraph", strings.Join(paragraph, "\n")))
+		paragraph = paragraph[:0]
+	}
+
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case strings.HasPrefix(trimmed, "```"):
+			flush()
+
+			code := []string{}
+			for i++; i < len(lines) && strings.TrimSpace(lines[i]) != "```"; i++ {
+				code = append(code, lines[i])
+			}
+
+			blocks = append(blocks, newNotionCodeBlock(strings.TrimPrefix(trimmed, "```"), strings.Join(code, "\n")))
+
+		case strings.HasPrefix(trimmed, "#"):
+			flush()
+
+			level := len(trimmed) - len(strings.TrimLeft(trimmed, "#"))
+			if level > 3 {
+				level = 3
+			}
+
+			blocks = append(blocks, newNotionTextBlock(fmt.Sprintf("heading_%d", level), strings.TrimSpace(strings.TrimLeft(trimmed, "#"))))
+
+		case strings.HasPrefix(trimmed, "|"):
+			flush()
+
+			rows := []string{}
+			for ; i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), "|"); i++ {
+				rows = append(rows, strings.TrimSpace(lines[i]))
+			}
+			i--
+
+			blocks = append(blocks, newNotionTableBlocks(rows)...)
+
+		case strings.HasPrefix(trimmed, "- ") || strings.HasPrefix(trimmed, "* "):
+			flush()
+
+			blocks = append(blocks, newNotionTextBlock("bulleted_list_item", trimmed[2:]))
+
+		default:
+			text := strings.TrimSpace(htmlAnchorRegex.ReplaceAllString(line, ""))
+			if text == "" {
+				if trimmed == "" {
+					flush()
+				}
+				continue
+			}
+			paragraph = append(paragraph, text)
+		}
+	}
+
+	flush()
+
+	return blocks
+}
+
+func newNotionTextBlock(kind string, text string) *notionBlock {
+	content := &notionTextBlock{
+		RichText: parseNotionRichText(text),
+	}
+
+	block := &notionBlock{
+		Object: "block",
+		Type:   kind,
+	}
+
+	switch kind {
+	case "heading_1":
+		block.Heading1 = content
+	case "heading_2":
+		block.Heading2 = content
+	case "heading_3":
+		block.Heading3 = content
+	case "bulleted_list_item":
+		block.BulletedListItem = content
+	default:
+		block.Paragraph = content
+	}
+
+	return block
+}
+
+func newNotionCodeBlock(language string, code string) *notionBlock {
+	language = strings.ToLower(strings.TrimSpace(language))
+	if !notionLanguages[language] {
+		language = "plain text"
+	}
+
+	return &notionBlock{
+		Object: "block",
+		Type:   "code",
+		Code: &notionCodeBlock{
+			RichText: newNotionRichText(code, notionAnnotations{}, ""),
+			Language: language,
+		},
+	}
+}
+
+// newNotionTableBlocks returns table blocks of the given Markdown table rows.
+// The first row is considered as column header if it's followed by separator
+// row (e.g. '|---|---|'). The tables with more rows than the limit of Notion
+// API are split in several tables, each of them with the column header.
+func newNotionTableBlocks(rows []string) []*notionBlock {
+	header := len(rows) > 1 && tableSepRegex.MatchString(rows[1])
+
+	cells := [][]string{}
+	width := 0
+
+	for i, row := range rows {
+		if header && i == 1 {
+			continue
+		}
+		c := splitMarkdownTableRow(row)
+		if len(c) > width {
+			width = len(c)
+		}
+		cells = append(cells, c)
+	}
+
+	tableRows := make([]*notionBlock, 0, len(cells))
+	for _, c := range cells {
+		row := &notionTableRowBlock{
+			Cells: make([][]notionRichText, width),
+		}
+		for j := 0; j < width; j++ {
+			row.Cells[j] = []notionRichText{}
+			if j < len(c) {
+				row.Cells[j] = parseNotionRichText(c[j])
+			}
+		}
+		tableRows = append(tableRows, &notionBlock{
+			Object:   "block",
+			Type:     "table_row",
+			TableRow: row,
+		})
+	}
+
+	var headerRow []*notionBlock
+	size := notionMaxBlocks
+	if header {
+		headerRow, tableRows = tableRows[:1], tableRows[1:]
+		size--
+	}
+
+	tables := []*notionBlock{}
+	for i := 0; i == 0 || i < len(tableRows); i += size {
+		end := i + size
+		if end > len(tableRows) {
+			end = len(tableRows)
+		}
+
+		children := make([]*notionBlock, 0, len(headerRow)+end-i)
+		children = append(children, headerRow...)
+		children = append(children, tableRows[i:end]...)
+
+		tables = append(tables, &notionBlock{
+			Object: "block",
+			Type:   "table",
+			Table: &notionTableBlock{
+				TableWidth:      width,
+				HasColumnHeader: header,
+				HasRowHeader:    false,
+				Children:        children,
+			},
+		})
+	}
+
+	return tables
+}
+
+// splitMarkdownTableRow returns the cells of Markdown table row, the escaped
+// pipes (i.e. '\|') are kept in the cells.
+func splitMarkdownTableRow(row string) []string {
+	row = strings.TrimSuffix(strings.TrimPrefix(row, "|"), "|")
+
+	cells := []string{}
+	var cell strings.Builder
+
+	for i := 0; i < len(row); i++ {
+		switch {
+		case row[i] == '\\' && i+1 < len(row) && row[i+1] == '|':
+			cell.WriteString(`\|`)
+			i++
+		case row[i] == '|':
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteByte(row[i])
+		}
+	}
+
+	return append(cells, strings.TrimSpace(cell.String()))
+}
+
+// parseNotionRichText converts inline Markdown (i.e. bold, italic, code and
+// links) to Notion rich text objects.
+func parseNotionRichText(s string) []notionRichText {
+	s = htmlAnchorRegex.ReplaceAllString(s, "")
+	s = htmlBreakRegex.ReplaceAllString(s, "\n")
+
+	result := []notionRichText{}
+
+	var buf strings.Builder
+	var annotations notionAnnotations
+	var underscore bool
+
+	flush := func() {
+		if buf.Len() == 0 {
+			return
+		}
+		result = append(result, newNotionRichText(buf.String(), annotations, "")...)
+		buf.Reset()
+	}
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		switch {
+		case c == '\\' && i+1 < len(s) && strings.IndexByte(markdownEscapable, s[i+1]) >= 0:
+			buf.WriteByte(s[i+1])
+			i++
+
+		case c == '`' && strings.IndexByte(s[i+1:], '`') >= 0:
+			flush()
+
+			end := i + 1 + strings.IndexByte(s[i+1:], '`')
+			code := annotations
+			code.Code = true
+			result = append(result, newNotionRichText(s[i+1:end], code, "")...)
+			i = end
+
+		case strings.HasPrefix(s[i:], "**") && (annotations.Bold || strings.Contains(s[i+2:], "**")):
+			flush()
+
+			annotations.Bold = !annotations.Bold
+			i++
+
+		case c == '*' && (annotations.Italic && !underscore || !annotations.Italic && strings.IndexByte(s[i+1:], '*') >= 0):
+			flush()
+
+			annotations.Italic = !annotations.Italic
+			underscore = false
+
+		case c == '_' && annotations.Italic && underscore && (i+1 == len(s) || !isWordChar(s[i+1])):
+			flush()
+
+			annotations.Italic = false
+			underscore = false
+
+		case c == '_' && !annotations.Italic && (i == 0 || !isWordChar(s[i-1])) && i+1 < len(s) && !unicode.IsSpace(rune(s[i+1])) && strings.IndexByte(s[i+1:], '_') >= 0:
+			flush()
+
+			annotations.Italic = true
+			underscore = true
+
+		case c == '[':
+			text, link, n := parseMarkdownLink(s[i:])
+			if n == 0 {
+				buf.WriteByte(c)
+				continue
+			}
+
+			flush()
+
+			if !strings.HasPrefix(link, "http://") && !strings.HasPrefix(link, "https://") {
+				link = ""
+			}
+			result = append(result, newNotionRichText(text, annotations, link)...)
+			i += n - 1
+
+		default:
+			buf.WriteByte(c)
+		}
+	}
+
+	flush()
+
+	return result
+}
+
+// parseMarkdownLink returns the text and the destination of Markdown link
+// at the beginning of 's' (e.g. '[text](url)'), and its length. Zero length
+// is returned if 's' doesn't start with a link.
+func parseMarkdownLink(s string) (string, string, int) {
+	end := strings.Index(s, "](")
+	if end < 0 {
+		return "", "", 0
+	}
+
+	dest := strings.IndexByte(s[end+2:], ')')
+	if dest < 0 {
+		return "", "", 0
+	}
+
+	return s[1:end], s[end+2 : end+2+dest], end + 2 + dest + 1
+}
+
+// newNotionRichText returns rich text objects of the given content, split in
+// chunks because of the limit of Notion API on the length of its content.
+func newNotionRichText(content string, annotations notionAnnotations, link string) []notionRichText {
+	result := []notionRichText{}
+
+	for content != "" {
+		chunk := content
+		if utf8.RuneCountInString(chunk) > notionMaxTextSize {
+			chunk = string([]rune(chunk)[:notionMaxTextSize])
+		}
+		content = content[len(chunk):]
+
+		text := notionRichText{
+			Type: "text",
+			Text: notionText{
+				Content: chunk,
+			},
+		}
+
+		if link != "" {
+			text.Text.Link = &notionLink{URL: link}
+		}
+
+		if annotations != (notionAnnotations{}) {
+			a := annotations
+			text.Annotations = &a
+		}
+
+		result = append(result, text)
+	}
+
+	return result
+}
+
+func isWordChar(c byte) bool {
+	return c == '_' || unicode.IsLetter(rune(c)) || unicode.IsDigit(rune(c))
+}
@@ -0,0 +1,339 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNotionRichText(t *testing.T) {
+	bold := &notionAnnotations{Bold: true}
+	italic := &notionAnnotations{Italic: true}
+	code := &notionAnnotations{Code: true}
+
+	tests := map[string]struct {
+		text     string
+		expected []notionRichText
+	}{
+		"Plain": {
+			text: "plain text",
+			expected: []notionRichText{
+				{Type: "text", Text: notionText{Content: "plain text"}},
+			},
+		},
+		"Bold": {
+			text: "a **bold** text",
+			expected: []notionRichText{
+				{Type: "text", Text: notionText{Content: "a "}},
+				{Type: "text", Text: notionText{Content: "bold"}, Annotations: bold},
+				{Type: "text", Text: notionText{Content: " text"}},
+			},
+		},
+		"Italic": {
+			text: "an *italic* and _italic_ text",
+			expected: []notionRichText{
+				{Type: "text", Text: notionText{Content: "an "}},
+				{Type: "text", Text: notionText{Content: "italic"}, Annotations: italic},
+				{Type: "text", Text: notionText{Content: " and "}},
+				{Type: "text", Text: notionText{Content: "italic"}, Annotations: italic},
+				{Type: "text", Text: notionText{Content: " text"}},
+			},
+		},
+		"Code": {
+			text: "use `foo_bar` **here**",
+			expected: []notionRichText{
+				{Type: "text", Text: notionText{Content: "use "}},
+				{Type: "text", Text: notionText{Content: "foo_bar"}, Annotations: code},
+				{Type: "text", Text: notionText{Content: " "}},
+				{Type: "text", Text: notionText{Content: "here"}, Annotations: bold},
+			},
+		},
+		"Underscore": {
+			text: "input_with_underscores",
+			expected: []notionRichText{
+				{Type: "text", Text: notionText{Content: "input_with_underscores"}},
+			},
+		},
+		"Escaped": {
+			text: `input\_name \| \*`,
+			expected: []notionRichText{
+				{Type: "text", Text: notionText{Content: "input_name | *"}},
+			},
+		},
+		"Links": {
+			text: "[docs](https://terraform-docs.io) and [anchor](#input_foo)",
+			expected: []notionRichText{
+				{Type: "text", Text: notionText{Content: "docs", Link: &notionLink{URL: "https://terraform-docs.io"}}},
+				{Type: "text", Text: notionText{Content: " and "}},
+				{Type: "text", Text: notionText{Content: "anchor"}},
+			},
+		},
+		"HTML": {
+			text: `<a name="input_foo"></a> foo<br>bar`,
+			expected: []notionRichText{
+				{Type: "text", Text: notionText{Content: " foo\nbar"}},
+			},
+		},
+		"LongText": {
+			text: strings.Repeat("a", notionMaxTextSize+1),
+			expected: []notionRichText{
+				{Type: "text", Text: notionText{Content: strings.Repeat("a", notionMaxTextSize)}},
+				{Type: "text", Text: notionText{Content: "a"}},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := parseNotionRichText(tt.text)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestMarkdownToNotionBlocks(t *testing.T) {
+	assert := assert.New(t)
+
+	content := strings.Join([]string{
+		"# Title",
+		"",
+		"Some **description**",
+		"on two lines.",
+		"",
+		"## Inputs",
+		"",
+		"| Name | Description |",
+		"|------|-------------|",
+		"| <a name=\"input_foo\"></a> [foo](#input\\_foo) | a \\| b |",
+		"",
+		"#### Example",
+		"",
+		"```hcl",
+		"foo = \"bar\"",
+		"```",
+		"",
+		"```",
+		"plain",
+		"```",
+		"",
+		"- one",
+		"* two",
+	}, "\n")
+
+	blocks := markdownToNotionBlocks(content)
+
+	types := make([]string, 0, len(blocks))
+	for _, b := range blocks {
+		types = append(types, b.Type)
+	}
+	assert.Equal([]string{"heading_1", "paragraph", "heading_2", "table", "heading_3", "code", "code", "bulleted_list_item", "bulleted_list_item"}, types)
+
+	assert.Equal("Title", blocks[0].Heading1.RichText[0].Text.Content)
+	assert.Equal("\non two lines.", blocks[1].Paragraph.RichText[2].Text.Content)
+
+	table := blocks[3].Table
+	assert.Equal(2, table.TableWidth)
+	assert.True(table.HasColumnHeader)
+	assert.Len(table.Children, 2)
+	assert.Equal("Name", table.Children[0].TableRow.Cells[0][0].Text.Content)
+	assert.Equal("foo", table.Children[1].TableRow.Cells[0][1].Text.Content)
+	assert.Equal("a | b", table.Children[1].TableRow.Cells[1][0].Text.Content)
+
+	assert.Equal("hcl", blocks[5].Code.Language)
+	assert.Equal("foo = \"bar\"", blocks[5].Code.RichText[0].Text.Content)
+	assert.Equal("plain text", blocks[6].Code.Language)
+
+	assert.Equal("two", blocks[8].BulletedListItem.RichText[0].Text.Content)
+}
+
+func TestNewNotionTableBlocks(t *testing.T) {
+	tests := map[string]struct {
+		rows     int
+		header   bool
+		expected []int
+	}{
+		"Small": {
+			rows:     3,
+			header:   true,
+			expected: []int{4},
+		},
+		"SplitWithHeader": {
+			rows:     250,
+			header:   true,
+			expected: []int{100, 100, 53},
+		},
+		"SplitWithoutHeader": {
+			rows:     250,
+			header:   false,
+			expected: []int{100, 100, 50},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			rows := []string{}
+			if tt.header {
+				rows = append(rows, "| Name | Description |", "|------|-------------|")
+			}
+			for i := 0; i < tt.rows; i++ {
+				rows = append(rows, fmt.Sprintf("| name-%d | description |", i))
+			}
+
+			tables := newNotionTableBlocks(rows)
+
+			actual := make([]int, 0, len(tables))
+			for _, table := range tables {
+				actual = append(actual, len(table.Table.Children))
+				assert.Equal(tt.header, table.Table.HasColumnHeader)
+				if tt.header {
+					assert.Equal("Name", table.Table.Children[0].TableRow.Cells[0][0].Text.Content)
+				}
+			}
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestNotionWriter(t *testing.T) {
+	tests := map[string]struct {
+		token    string
+		status   int
+		method   string
+		expected []string
+		wantErr  bool
+		errMsg   string
+	}{
+		"Success": {
+			token:  "secret",
+			status: http.StatusOK,
+			expected: []string{
+				"GET /blocks/page-id/children",
+				"GET /blocks/page-id/children",
+				"PATCH /blocks/page-id/children",
+				"DELETE /blocks/block-1",
+				"DELETE /blocks/block-2",
+			},
+			wantErr: false,
+			errMsg:  "",
+		},
+		"AppendFailed": {
+			token:  "secret",
+			status: http.StatusBadRequest,
+			method: http.MethodPatch,
+			expected: []string{
+				"GET /blocks/page-id/children",
+				"GET /blocks/page-id/children",
+				"PATCH /blocks/page-id/children",
+			},
+			wantErr: true,
+			errMsg:  "notion: PATCH /blocks/page-id/children failed with status 400: API token is invalid.",
+		},
+		"MissingToken": {
+			token:    "",
+			status:   http.StatusOK,
+			expected: []string{},
+			wantErr:  true,
+			errMsg:   "environment variable 'NOTION_TOKEN' is missing",
+		},
+		"Unauthorized": {
+			token:  "secret",
+			status: http.StatusUnauthorized,
+			expected: []string{
+				"GET /blocks/page-id/children",
+			},
+			wantErr: true,
+			errMsg:  "notion: GET /blocks/page-id/children failed with status 401: API token is invalid.",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			var mu sync.Mutex
+			requests := []string{}
+			var appended []*notionBlock
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				requests = append(requests, r.Method+" "+r.URL.Path)
+
+				assert.Equal("Bearer "+tt.token, r.Header.Get("Authorization"))
+				assert.Equal(notionVersion, r.Header.Get("Notion-Version"))
+
+				if tt.status != http.StatusOK && (tt.method == "" || tt.method == r.Method) {
+					w.WriteHeader(tt.status)
+					io.WriteString(w, `{"object":"error","message":"API token is invalid."}`) //nolint:errcheck,gosec
+					return
+				}
+
+				switch r.Method {
+				case http.MethodGet:
+					if r.URL.Query().Get("start_cursor") == "" {
+						io.WriteString(w, `{"results":[{"id":"block-1"}],"has_more":true,"next_cursor":"next"}`) //nolint:errcheck,gosec
+					} else {
+						io.WriteString(w, `{"results":[{"id":"block-2"}],"has_more":false,"next_cursor":null}`) //nolint:errcheck,gosec
+					}
+				case http.MethodPatch:
+					var body struct {
+						Children []*notionBlock `json:"children"`
+					}
+					assert.Nil(json.NewDecoder(r.Body).Decode(&body))
+					appended = append(appended, body.Children...)
+					io.WriteString(w, `{}`) //nolint:errcheck,gosec
+				default:
+					io.WriteString(w, `{}`) //nolint:errcheck,gosec
+				}
+			}))
+			defer server.Close()
+
+			old, ok := os.LookupEnv(notionToken)
+			os.Setenv(notionToken, tt.token) //nolint:errcheck,gosec
+			defer func() {
+				if ok {
+					os.Setenv(notionToken, old) //nolint:errcheck,gosec
+				} else {
+					os.Unsetenv(notionToken) //nolint:errcheck,gosec
+				}
+			}()
+
+			writer := &notionWriter{
+				pageID:  "page-id",
+				baseURL: server.URL,
+				client:  server.Client(),
+			}
+
+			_, err := io.WriteString(writer, "## Inputs\n\nNo inputs.")
+
+			if tt.wantErr {
+				assert.NotNil(err)
+				assert.Equal(tt.errMsg, err.Error())
+			} else {
+				assert.Nil(err)
+				assert.Len(appended, 2)
+			}
+			assert.Equal(tt.expected, requests)
+		})
+	}
+}
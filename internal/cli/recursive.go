@@ -0,0 +1,131 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+// moduleError represents the error of generating content of a module.
+type moduleError struct {
+	index   int
+	rootDir string
+	err     error
+}
+
+// moduleErrors represents the errors of all the modules which are failed to
+// generate their content, which are reported together.
+type moduleErrors []*moduleError
+
+func (e moduleErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, m := range e {
+		messages = append(messages, fmt.Sprintf("%s: %s", m.rootDir, m.err))
+	}
+	return fmt.Sprintf("failed to generate content of %d modules:\n%s", len(e), strings.Join(messages, "\n"))
+}
+
+// ExitCode returns the exit code which all of the errors agree on, or 1 if
+// they're different (e.g. some of the modules are failed to load).
+func (e moduleErrors) ExitCode() int {
+	code := 0
+	for _, m := range e {
+		c := ExitCode(m.err)
+		if code != 0 && c != code {
+			return 1
+		}
+		code = c
+	}
+	return code
+}
+
+// processModules calls 'fn' for all the 'modules' with up to 'parallelism'
+// of them being processed concurrently, and returns their loaded Terraform
+// module in the same order. An error of a module doesn't abort processing the
+// others, all the errors are collected and returned together at the end.
+func processModules(modules []module, parallelism int, fn func(module) (*terraform.Module, error)) ([]*terraform.Module, error) {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	if parallelism > len(modules) {
+		parallelism = len(modules)
+	}
+
+	results := make([]*terraform.Module, len(modules))
+
+	jobs := make(chan int, len(modules))
+	errs := make(chan *moduleError, len(modules))
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < parallelism; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				tfmodule, err := fn(modules[i])
+				if err != nil {
+					errs <- &moduleError{index: i, rootDir: modules[i].rootDir, err: err}
+					continue
+				}
+				results[i] = tfmodule
+			}
+		}()
+	}
+
+	for i := range modules {
+		jobs <- i
+	}
+	close(jobs)
+
+	wg.Wait()
+	close(errs)
+
+	failed := moduleErrors{}
+	for err := range errs {
+		failed = append(failed, err)
+	}
+
+	switch len(failed) {
+	case 0:
+		return results, nil
+	case 1:
+		return results, failed[0].err
+	default:
+		sort.Slice(failed, func(i, j int) bool {
+			return failed[i].index < failed[j].index
+		})
+		return results, failed
+	}
+}
+
+// moduleConfig returns the configuration of the given module, which is its own
+// configuration file if exists or the one of root module otherwise. A copy is
+// returned so modules can be processed concurrently.
+func (r *Runtime) moduleConfig(module module) *print.Config {
+	cfg := *r.config
+
+	// If submodules contains its own configuration file, use that instead
+	if module.config != nil {
+		cfg = *module.config
+	}
+
+	// set the module root directory
+	cfg.ModuleRoot = module.rootDir
+
+	return &cfg
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+func TestProcessModules(t *testing.T) {
+	modules := []module{}
+	for i := 0; i < 10; i++ {
+		modules = append(modules, module{rootDir: fmt.Sprintf("module-%d", i)})
+	}
+
+	tests := map[string]struct {
+		parallelism int
+		failing     map[string]bool
+		errMsg      string
+	}{
+		"Sequential": {
+			parallelism: 1,
+			failing:     map[string]bool{},
+		},
+		"Parallel": {
+			parallelism: 4,
+			failing:     map[string]bool{},
+		},
+		"MoreWorkersThanModules": {
+			parallelism: 20,
+			failing:     map[string]bool{},
+		},
+		"ZeroMeansSequential": {
+			parallelism: 0,
+			failing:     map[string]bool{},
+		},
+		"OneError": {
+			parallelism: 4,
+			failing:     map[string]bool{"module-3": true},
+			errMsg:      "boom",
+		},
+		"MultipleErrors": {
+			parallelism: 4,
+			failing:     map[string]bool{"module-7": true, "module-2": true},
+			errMsg:      "failed to generate content of 2 modules:\nmodule-2: boom\nmodule-7: boom",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			results, err := processModules(modules, tt.parallelism, func(m module) (*terraform.Module, error) {
+				if tt.failing[m.rootDir] {
+					return nil, fmt.Errorf("boom")
+				}
+				return &terraform.Module{Header: m.rootDir}, nil
+			})
+
+			if tt.errMsg != "" {
+				assert.NotNil(err)
+				assert.Equal(tt.errMsg, err.Error())
+			} else {
+				assert.Nil(err)
+			}
+
+			// modules which are not failed are processed regardless, in order
+			assert.Len(results, len(modules))
+			for i, m := range modules {
+				if tt.failing[m.rootDir] {
+					assert.Nil(results[i])
+				} else {
+					assert.Equal(m.rootDir, results[i].Header)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessModulesSpeedup(t *testing.T) {
+	assert := assert.New(t)
+
+	modules := make([]module, 10)
+
+	elapsed := func(parallelism int) time.Duration {
+		start := time.Now()
+		_, err := processModules(modules, parallelism, func(m module) (*terraform.Module, error) {
+			time.Sleep(20 * time.Millisecond)
+			return &terraform.Module{}, nil
+		})
+		assert.Nil(err)
+		return time.Since(start)
+	}
+
+	sequential := elapsed(1)
+	parallel := elapsed(4)
+
+	// 10 modules with 4 workers take 3 rounds instead of 10
+	assert.Less(int64(parallel), int64(sequential)/2, "sequential: %s, parallel: %s", sequential, parallel)
+}
+
+func TestRunRecursiveParallel(t *testing.T) {
+	assert := assert.New(t)
+
+	root := t.TempDir()
+
+	dirs := []string{root}
+	for i := 0; i < 10; i++ {
+		dirs = append(dirs, filepath.Join(root, "modules", fmt.Sprintf("module-%d", i)))
+	}
+	for i, dir := range dirs {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		content := fmt.Sprintf("variable \"input_%d\" {}\n\noutput \"output_%d\" {\n  value = var.input_%d\n}\n", i, i, i)
+		if err := os.WriteFile(filepath.Join(dir, "main.tf"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	config := print.DefaultConfig()
+	config.File = ".terraform-docs.yml"
+	config.Formatter = "markdown table"
+	config.Output.File = "README.md"
+	config.Recursive.Enabled = true
+	config.Recursive.Parallelism = 4
+	config.Cache.Enabled = false
+
+	r := &Runtime{
+		rootDir:       root,
+		formatter:     "markdown table",
+		config:        config,
+		isFlagChanged: func(string) bool { return false },
+	}
+
+	modules, err := r.findSubmodules()
+	assert.Nil(err)
+	assert.Len(modules, 10)
+
+	err = r.RunEFunc(nil, nil)
+	assert.Nil(err)
+
+	for i, dir := range dirs {
+		content, err := os.ReadFile(filepath.Join(dir, "README.md"))
+		assert.Nil(err)
+		assert.Contains(string(content), fmt.Sprintf("input_%d", i))
+		assert.Contains(string(content), fmt.Sprintf("output_%d", i))
+	}
+}
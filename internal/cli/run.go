@@ -11,16 +11,21 @@ the root directory of this source tree.
 package cli
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"path/filepath"
 
 	goversion "github.com/hashicorp/go-version"
+	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/terraform-docs/terraform-docs/format"
 	"github.com/terraform-docs/terraform-docs/internal/plugin"
@@ -95,6 +100,18 @@ type module struct {
 // to discover submodules, on `--recursive` flag, and generates the content for them
 // as well as the root module.
 func (r *Runtime) RunEFunc(cmd *cobra.Command, args []string) error {
+	shutdown, err := setupTracing(context.Background(), r.config.Experimental.OpenTelemetry)
+	if err != nil {
+		return err
+	}
+	defer shutdown(context.Background()) //nolint:errcheck
+
+	ctx, span := tracer.Start(context.Background(), "terraform-docs", trace.WithAttributes(
+		attribute.String("formatter", r.config.Formatter),
+		attribute.Bool("recursive", r.config.Recursive.Enabled),
+	))
+	defer span.End()
+
 	modules := []module{
 		{rootDir: r.rootDir, config: r.config},
 	}
@@ -111,34 +128,54 @@ func (r *Runtime) RunEFunc(cmd *cobra.Command, args []string) error {
 		modules = append(modules, items...)
 	}
 
-	for _, module := range modules {
-		cfg := r.config
+	span.SetAttributes(attribute.Int("modules", len(modules)))
 
-		// If submodules contains its own configuration file, use that instead
-		if module.config != nil {
-			cfg = module.config
-		}
-
-		// set the module root directory
-		cfg.ModuleRoot = module.rootDir
+	tfmodules, err := processModules(modules, r.config.Recursive.Parallelism, func(module module) (*terraform.Module, error) {
+		cfg := r.moduleConfig(module)
 
 		// process and validate configuration
 		if err := cfg.Validate(); err != nil {
-			return err
+			return nil, err
 		}
 
 		if r.config.Recursive.Enabled && cfg.Output.File == "" {
-			return fmt.Errorf("value of '--output-file' cannot be empty with '--recursive'")
+			return nil, fmt.Errorf("value of '--output-file' cannot be empty with '--recursive'")
 		}
 
-		if err := generateContent(cfg); err != nil {
-			return err
+		return generateContent(ctx, cfg)
+	})
+	if err != nil {
+		return err
+	}
+
+	if r.config.Recursive.ModuleMap {
+		items := []*moduleMapItem{}
+
+		// root module itself is not part of the module map
+		for i, module := range modules[1:] {
+			item, err := newModuleMapItem(r.rootDir, r.moduleConfig(module), tfmodules[i+1])
+			if err != nil {
+				return err
+			}
+			items = append(items, item)
 		}
+
+		return writeModuleMap(r.rootDir, r.config.Formatter, items)
 	}
 
 	return nil
 }
 
+// ExitCode returns the exit code of terraform-docs for the given error, which
+// is the one provided by the error itself (if any) or 1 otherwise.
+func ExitCode(err error) int {
+	var coder interface{ ExitCode() int }
+	if errors.As(err, &coder) {
+		return coder.ExitCode()
+	}
+	return 1
+}
+
 // readConfig attempts to read config file, either default `.terraform-docs.yml`
 // or provided file with `-c, --config` flag. It will then attempt to override
 // them with corresponding flags (if set).
@@ -157,9 +194,15 @@ func (r *Runtime) readConfig(v *viper.Viper, file string, submoduleDir string) e
 
 	v.AddConfigPath(r.rootDir)              // first look at module root
 	v.AddConfigPath(r.rootDir + "/.config") // then .config/ folder at module root
-	v.AddConfigPath(".")                    // then current directory
-	v.AddConfigPath(".config")              // then .config/ folder at current directory
-	v.AddConfigPath("$HOME/.tfdocs.d")      // and finally $HOME/.tfdocs.d/
+
+	// then parent folders of module root, up to the root of Git repository
+	for _, dir := range parentDirs(r.rootDir) {
+		v.AddConfigPath(dir)
+	}
+
+	v.AddConfigPath(".")               // then current directory
+	v.AddConfigPath(".config")         // then .config/ folder at current directory
+	v.AddConfigPath("$HOME/.tfdocs.d") // and finally $HOME/.tfdocs.d/
 
 	if err := v.ReadInConfig(); err != nil {
 		var perr *os.PathError
@@ -185,7 +228,8 @@ func (r *Runtime) readConfig(v *viper.Viper, file string, submoduleDir string) e
 func (r *Runtime) unmarshalConfig(v *viper.Viper, config *print.Config) error {
 	r.bindFlags(v)
 
-	if err := v.Unmarshal(config); err != nil {
+	// unknown keys (e.g. typos) are reported instead of being silently ignored
+	if err := v.Unmarshal(config, func(c *mapstructure.DecoderConfig) { c.ErrorUnused = true }); err != nil {
 		return fmt.Errorf("unable to decode config, %w", err)
 	}
 
@@ -197,6 +241,10 @@ func (r *Runtime) unmarshalConfig(v *viper.Viper, config *print.Config) error {
 		config.Formatter = r.formatter
 	}
 
+	if config.ConfigLink.Enabled {
+		config.ConfigLink.Path = configPath(v.ConfigFileUsed())
+	}
+
 	config.Parse()
 
 	return nil
@@ -221,6 +269,12 @@ func (r *Runtime) bindFlags(v *viper.Viper) {
 				sectionsCleared = true
 			}
 
+			items, err := fs.GetStringSlice(f.Name)
+			if err != nil {
+				return
+			}
+			v.Set(flagMappings[f.Name], items)
+		case "with-pinned-variables", "exclude-variable", "exclude-output", "sensitive-patterns", "with-var-file-defaults":
 			items, err := fs.GetStringSlice(f.Name)
 			if err != nil {
 				return
@@ -228,6 +282,22 @@ func (r *Runtime) bindFlags(v *viper.Viper) {
 			v.Set(flagMappings[f.Name], items)
 		case "sort-by-required", "sort-by-type":
 			v.Set("sort.by", flagMappings[f.Name])
+		case "with-sorted-providers", "with-sorted-requirements":
+			sorted, err := fs.GetBool(f.Name)
+			if err != nil {
+				return
+			}
+			if sorted {
+				v.Set(flagMappings[f.Name], print.SortName)
+			} else {
+				v.Set(flagMappings[f.Name], "")
+			}
+		case "no-cache":
+			noCache, err := fs.GetBool(f.Name)
+			if err != nil {
+				return
+			}
+			v.Set(flagMappings[f.Name], !noCache)
 		default:
 			if _, ok := flagMappings[f.Name]; !ok {
 				return
@@ -321,13 +391,68 @@ func checkConstraint(versionRange string, currentVersion string) error {
 
 // generateContent extracts print.Settings and terraform.Options from normalized
 // Config and generates the output content for the module (and submodules if available)
-// and write the result to the output (either stdout or a file).
-func generateContent(config *print.Config) error {
-	module, err := terraform.LoadWithOptions(config)
+// and write the result to the output (either stdout or a file). The loaded module
+// is returned.
+func generateContent(ctx context.Context, config *print.Config) (tfmodule *terraform.Module, err error) {
+	ctx, span := tracer.Start(ctx, "generate", trace.WithAttributes(
+		attribute.String("module.root", config.ModuleRoot),
+		attribute.String("formatter", config.Formatter),
+	))
+	defer func() { endSpan(span, err) }()
+
+	module, err := terraform.LoadWithContext(ctx, config)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if module.HealthScore != nil {
+		fmt.Fprintf(os.Stderr, "%s: documentation health score is %d%%\n", config.ModuleRoot, module.HealthScore.Score)
+	}
+
+	// check before formatting, so nothing is written for undocumented modules
+	if err := checkDocumented(config, module); err != nil {
+		return nil, err
+	}
+
+	content, err := formatContent(ctx, config, module)
+	if err != nil {
+		return nil, err
 	}
 
+	if err := lintMarkdown(config, content); err != nil {
+		return nil, err
+	}
+
+	_, wspan := tracer.Start(ctx, "write", trace.WithAttributes(
+		attribute.String("output.file", config.Output.File),
+		attribute.String("output.mode", config.Output.Mode),
+	))
+	err = writeContent(config, module, content)
+	if err == nil {
+		err = writeVariableExport(config, module)
+	}
+	if err == nil {
+		err = writeHelmValues(config, module)
+	}
+	if err == nil {
+		err = writeInputDefaults(config, module)
+	}
+	if err == nil {
+		err = writeGitignore(config)
+	}
+	endSpan(wspan, err)
+
+	return module, err
+}
+
+// formatContent returns the content of the module generated by the formatter
+// of the config, which is either a built-in one or a plugin.
+func formatContent(ctx context.Context, config *print.Config, module *terraform.Module) (content string, err error) {
+	_, span := tracer.Start(ctx, "format", trace.WithAttributes(
+		attribute.String("formatter", config.Formatter),
+	))
+	defer func() { endSpan(span, err) }()
+
 	formatter, err := format.New(config)
 
 	// formatter is unknown, this might mean that the intended formatter is
@@ -336,56 +461,88 @@ func generateContent(config *print.Config) error {
 	if err != nil {
 		plugins, perr := plugin.Discover()
 		if perr != nil {
-			return fmt.Errorf("formatter '%s' not found", config.Formatter)
+			return "", err
 		}
 
 		client, found := plugins.Get(config.Formatter)
 		if !found {
-			return fmt.Errorf("formatter '%s' not found", config.Formatter)
+			return "", err
 		}
 
-		content, cerr := client.Execute(&pluginsdk.ExecuteArgs{
+		span.SetAttributes(attribute.Bool("formatter.plugin", true))
+
+		return client.Execute(&pluginsdk.ExecuteArgs{
 			Module: module,
 			Config: config,
 		})
-		if cerr != nil {
-			return cerr
-		}
-
-		return writeContent(config, content)
 	}
 
 	err = formatter.Generate(module)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	content, err := formatter.Render(config.Content)
+	tpl, err := readContentTemplate(config)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	return writeContent(config, content)
+	return formatter.Render(tpl)
 }
 
-// writeContent to a Writer. This can either be os.Stdout or specific
-// file (e.g. README.md) if '--output-file' is provided.
-func writeContent(config *print.Config, content string) error {
+// readContentTemplate returns the template to render the whole content with,
+// which is read from '--with-readme-template' file (relative to module root or
+// an absolute path) if provided, or 'content' of the config otherwise.
+func readContentTemplate(config *print.Config) (string, error) {
+	if config.ContentFrom == "" {
+		return config.Content, nil
+	}
+
+	filename := config.ContentFrom
+	if !filepath.IsAbs(filename) {
+		filename = filepath.Join(config.ModuleRoot, filename)
+	}
+
+	content, err := os.ReadFile(filepath.Clean(filename))
+	if err != nil {
+		return "", fmt.Errorf("unable to read readme template, %w", err)
+	}
+
+	return string(content), nil
+}
+
+// writeContent to a Writer. This can either be os.Stdout, specific file
+// (e.g. README.md) if '--output-file' is provided, or a Notion page if
+// '--output-notion' is provided (which takes precedence over the file).
+func writeContent(config *print.Config, module *terraform.Module, content string) error {
 	var w io.Writer
 
-	// writing to a file (either inject or replace)
-	if config.Output.File != "" {
+	if config.Output.Notion != "" {
+		// replacing content of a Notion page
+		w = &notionWriter{
+			pageID:  config.Output.Notion,
+			baseURL: notionAPI,
+
+			client: &http.Client{Timeout: notionTimeout},
+		}
+	} else if config.Output.File != "" {
+		// writing to a file (either inject or replace)
 		w = &fileWriter{
 			file: config.Output.File,
 			dir:  config.ModuleRoot,
 
 			mode: config.Output.Mode,
 
-			check: config.Output.Check,
+			check:  config.Output.Check,
+			dryRun: config.Output.DryRun,
 
 			template: config.Output.Template,
 			begin:    config.Output.BeginComment,
 			end:      config.Output.EndComment,
+
+			templateFile: config.Output.TemplateFile,
+			module:       module,
+			settings:     config.Settings,
 		}
 	} else {
 		// writing to stdout
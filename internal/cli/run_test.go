@@ -11,9 +11,12 @@ the root directory of this source tree.
 package cli
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/print"
 )
 
 func TestVersionConstraint(t *testing.T) {
@@ -94,3 +97,64 @@ func TestVersionConstraint(t *testing.T) {
 		})
 	}
 }
+
+func TestReadContentTemplate(t *testing.T) {
+	abs, _ := filepath.Abs(filepath.Join("testdata", "readme-template", "README.md.tmpl"))
+
+	tests := map[string]struct {
+		content     string
+		contentFrom string
+		expected    string
+		wantErr     bool
+	}{
+		"Empty": {
+			content:     "",
+			contentFrom: "",
+			expected:    "",
+			wantErr:     false,
+		},
+		"Content": {
+			content:     "{{ .Inputs }}",
+			contentFrom: "",
+			expected:    "{{ .Inputs }}",
+			wantErr:     false,
+		},
+		"RelativePath": {
+			content:     "",
+			contentFrom: "README.md.tmpl",
+			expected:    "{{ .Header }}\n\n## Usage\n\n{{ .Inputs }}\n",
+			wantErr:     false,
+		},
+		"AbsolutePath": {
+			content:     "",
+			contentFrom: abs,
+			expected:    "{{ .Header }}\n\n## Usage\n\n{{ .Inputs }}\n",
+			wantErr:     false,
+		},
+		"FileNotFound": {
+			content:     "",
+			contentFrom: "noop.tmpl",
+			expected:    "",
+			wantErr:     true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.DefaultConfig()
+			config.ModuleRoot = filepath.Join("testdata", "readme-template")
+			config.Content = tt.content
+			config.ContentFrom = tt.contentFrom
+
+			actual, err := readContentTemplate(config)
+
+			if tt.wantErr {
+				assert.NotNil(err)
+			} else {
+				assert.Nil(err)
+			}
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/terraform-docs/terraform-docs/internal/version"
+)
+
+// tracer creates the spans of generating the content, which are only exported
+// if '--with-experimental-opentelemetry' is set.
+var tracer = otel.Tracer("github.com/terraform-docs/terraform-docs/internal/cli")
+
+// setupTracing registers the global tracer provider to export the spans to the
+// OTLP endpoint of 'OTEL_EXPORTER_OTLP_ENDPOINT' environment variable (or to
+// 'localhost:4318' by default) over HTTP, if 'enabled'. The returned function
+// flushes the remaining spans and has to be called before exiting.
+func setupTracing(ctx context.Context, enabled bool) (func(context.Context) error, error) {
+	if !enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create OpenTelemetry exporter, %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceNameKey.String("terraform-docs"),
+			semconv.ServiceVersionKey.String(version.Full()),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// endSpan ends the 'span', and marks it as failed if 'err' is not nil.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
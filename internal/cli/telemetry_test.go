@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+func TestSetupTracingDisabled(t *testing.T) {
+	assert := assert.New(t)
+
+	shutdown, err := setupTracing(context.Background(), false)
+	assert.Nil(err)
+	assert.Nil(shutdown(context.Background()))
+}
+
+func TestGenerateContentSpans(t *testing.T) {
+	assert := assert.New(t)
+
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	root := t.TempDir()
+	assert.Nil(os.WriteFile(filepath.Join(root, "main.tf"), []byte("variable \"name\" {}\n"), 0644))
+
+	config := print.DefaultConfig()
+	config.Formatter = "json"
+	config.ModuleRoot = root
+	config.Cache.Enabled = false
+	config.Output.File = "README.json"
+	config.Output.Mode = print.OutputModeReplace
+	config.Output.Template = print.OutputContent
+
+	_, err := generateContent(context.Background(), config)
+	assert.Nil(err)
+
+	spans := recorder.Ended()
+	names := []string{}
+	for _, span := range spans {
+		names = append(names, span.Name())
+	}
+	assert.Equal([]string{"parse", "sort", "load", "format", "write", "generate"}, names)
+
+	formatter := ""
+	for _, attr := range spans[3].Attributes() {
+		if attr.Key == attribute.Key("formatter") {
+			formatter = attr.Value.AsString()
+		}
+	}
+	assert.Equal("json", formatter)
+
+	generate := spans[5].SpanContext().SpanID()
+	for _, span := range []sdktrace.ReadOnlySpan{spans[2], spans[3], spans[4]} {
+		assert.Equal(generate, span.Parent().SpanID())
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+// undocumentedExitCode is the exit code of terraform-docs if any input or
+// output of the module doesn't have description, with '--fail-on-undocumented-inputs'
+// or '--fail-on-undocumented-outputs' flag.
+const undocumentedExitCode = 2
+
+// undocumentedError represents the inputs and outputs of a module which don't
+// have any description.
+type undocumentedError struct {
+	inputs  []string
+	outputs []string
+}
+
+func (e *undocumentedError) Error() string {
+	messages := []string{}
+	if len(e.inputs) > 0 {
+		messages = append(messages, fmt.Sprintf("undocumented inputs: %s", strings.Join(e.inputs, ", ")))
+	}
+	if len(e.outputs) > 0 {
+		messages = append(messages, fmt.Sprintf("undocumented outputs: %s", strings.Join(e.outputs, ", ")))
+	}
+	return strings.Join(messages, "; ")
+}
+
+// ExitCode returns the exit code of terraform-docs for the error.
+func (e *undocumentedError) ExitCode() int {
+	return undocumentedExitCode
+}
+
+// checkDocumented returns error listing the name of all the inputs and outputs
+// of the module which have empty description (or only whitespace), if failing
+// on them is enabled in the config.
+func checkDocumented(config *print.Config, module *terraform.Module) error {
+	undocumented := &undocumentedError{
+		inputs:  []string{},
+		outputs: []string{},
+	}
+
+	if config.FailOn.UndocumentedInputs {
+		for _, input := range module.Inputs {
+			if strings.TrimSpace(string(input.Description)) == "" {
+				undocumented.inputs = append(undocumented.inputs, input.Name)
+			}
+		}
+	}
+
+	if config.FailOn.UndocumentedOutputs {
+		for _, output := range module.Outputs {
+			if strings.TrimSpace(string(output.Description)) == "" {
+				undocumented.outputs = append(undocumented.outputs, output.Name)
+			}
+		}
+	}
+
+	if len(undocumented.inputs) == 0 && len(undocumented.outputs) == 0 {
+		return nil
+	}
+
+	return undocumented
+}
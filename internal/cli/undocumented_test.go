@@ -0,0 +1,171 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/types"
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+func TestCheckDocumented(t *testing.T) {
+	documented := &terraform.Module{
+		Inputs: []*terraform.Input{
+			{Name: "foo", Description: types.String("foo description")},
+			{Name: "bar", Description: types.String("bar description")},
+		},
+		Outputs: []*terraform.Output{
+			{Name: "baz", Description: types.String("baz description")},
+		},
+	}
+	partially := &terraform.Module{
+		Inputs: []*terraform.Input{
+			{Name: "foo", Description: types.String("foo description")},
+			{Name: "bar", Description: types.String("  ")},
+		},
+		Outputs: []*terraform.Output{
+			{Name: "baz", Description: types.String("baz description")},
+			{Name: "qux", Description: types.String("")},
+		},
+	}
+	undocumented := &terraform.Module{
+		Inputs: []*terraform.Input{
+			{Name: "foo", Description: types.String("")},
+			{Name: "bar", Description: types.String("")},
+		},
+		Outputs: []*terraform.Output{
+			{Name: "baz", Description: types.String("")},
+		},
+	}
+
+	tests := map[string]struct {
+		module  *terraform.Module
+		inputs  bool
+		outputs bool
+		wantErr bool
+		errMsg  string
+	}{
+		"Disabled": {
+			module:  undocumented,
+			inputs:  false,
+			outputs: false,
+			wantErr: false,
+			errMsg:  "",
+		},
+		"FullyDocumented": {
+			module:  documented,
+			inputs:  true,
+			outputs: true,
+			wantErr: false,
+			errMsg:  "",
+		},
+		"PartiallyDocumented": {
+			module:  partially,
+			inputs:  true,
+			outputs: true,
+			wantErr: true,
+			errMsg:  "undocumented inputs: bar; undocumented outputs: qux",
+		},
+		"PartiallyDocumentedOnlyInputs": {
+			module:  partially,
+			inputs:  true,
+			outputs: false,
+			wantErr: true,
+			errMsg:  "undocumented inputs: bar",
+		},
+		"PartiallyDocumentedOnlyOutputs": {
+			module:  partially,
+			inputs:  false,
+			outputs: true,
+			wantErr: true,
+			errMsg:  "undocumented outputs: qux",
+		},
+		"Undocumented": {
+			module:  undocumented,
+			inputs:  true,
+			outputs: true,
+			wantErr: true,
+			errMsg:  "undocumented inputs: foo, bar; undocumented outputs: baz",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.FailOn.UndocumentedInputs = tt.inputs
+			config.FailOn.UndocumentedOutputs = tt.outputs
+
+			err := checkDocumented(config, tt.module)
+
+			if tt.wantErr {
+				assert.NotNil(err)
+				assert.Equal(tt.errMsg, err.Error())
+				assert.Equal(2, ExitCode(err))
+			} else {
+				assert.Nil(err)
+			}
+		})
+	}
+}
+
+func TestExitCode(t *testing.T) {
+	undocumented := &undocumentedError{inputs: []string{"foo"}, outputs: []string{}}
+
+	tests := map[string]struct {
+		err      error
+		expected int
+	}{
+		"Error": {
+			err:      fmt.Errorf("boom"),
+			expected: 1,
+		},
+		"Undocumented": {
+			err:      undocumented,
+			expected: 2,
+		},
+		"Wrapped": {
+			err:      fmt.Errorf("wrapped: %w", undocumented),
+			expected: 2,
+		},
+		"MarkdownLint": {
+			err:      &markdownLintError{violations: []string{"stdin:1 MD041/first-line-heading"}},
+			expected: 2,
+		},
+		"ModulesUndocumented": {
+			err: moduleErrors{
+				{index: 0, rootDir: "module-0", err: undocumented},
+				{index: 1, rootDir: "module-1", err: undocumented},
+			},
+			expected: 2,
+		},
+		"ModulesMixed": {
+			err: moduleErrors{
+				{index: 0, rootDir: "module-0", err: undocumented},
+				{index: 1, rootDir: "module-1", err: fmt.Errorf("boom")},
+			},
+			expected: 1,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := ExitCode(tt.err)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
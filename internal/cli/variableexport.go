@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+const variableExportFile = "variables_export.json"
+
+// variableExportContent returns the inputs of the module as JSON, with the same
+// schema as 'inputs' of the output of 'json' formatter.
+func variableExportContent(config *print.Config, module *terraform.Module) (string, error) {
+	inputs := module.Inputs
+	if inputs == nil {
+		inputs = make([]*terraform.Input, 0)
+	}
+
+	buffer := new(bytes.Buffer)
+	encoder := json.NewEncoder(buffer)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(config.Settings.Escape)
+
+	if err := encoder.Encode(struct {
+		Inputs []*terraform.Input `json:"inputs"`
+	}{inputs}); err != nil {
+		return "", err
+	}
+
+	return buffer.String(), nil
+}
+
+// writeVariableExport writes 'variables_export.json' with the inputs of the
+// module, if '--with-variable-export' is set, regardless of the formatter. The
+// file is written next to '--output-file' (and checked with '--output-check'),
+// or in the module root if the content is written to stdout.
+func writeVariableExport(config *print.Config, module *terraform.Module) error {
+	if !config.Settings.VariableExport {
+		return nil
+	}
+
+	content, err := variableExportContent(config, module)
+	if err != nil {
+		return err
+	}
+
+	file := variableExportFile
+	if config.Output.File != "" {
+		file = filepath.Join(filepath.Dir(config.Output.File), variableExportFile)
+	}
+
+	return writeModuleFile(config, file, content)
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+func TestVariableExportContent(t *testing.T) {
+	assert := assert.New(t)
+
+	config := print.DefaultConfig()
+	module := &terraform.Module{
+		Inputs: []*terraform.Input{{Name: "a", Type: "string"}, {Name: "b", Type: "number"}},
+	}
+
+	content, err := variableExportContent(config, module)
+	assert.Nil(err)
+
+	var actual struct {
+		Inputs []struct {
+			Name string `json:"name"`
+			Type string `json:"type"`
+		} `json:"inputs"`
+	}
+	assert.Nil(json.Unmarshal([]byte(content), &actual))
+	assert.Equal(2, len(actual.Inputs))
+	assert.Equal("a", actual.Inputs[0].Name)
+	assert.Equal("number", actual.Inputs[1].Type)
+
+	content, err = variableExportContent(config, &terraform.Module{})
+	assert.Nil(err)
+	assert.Equal("{\n  \"inputs\": []\n}\n", content)
+}
+
+func TestWriteVariableExport(t *testing.T) {
+	tests := map[string]struct {
+		file     string
+		enabled  bool
+		expected string
+	}{
+		"Disabled": {
+			file:     "README.md",
+			enabled:  false,
+			expected: "",
+		},
+		"Stdout": {
+			file:     "",
+			enabled:  true,
+			expected: variableExportFile,
+		},
+		"OutputFile": {
+			file:     "README.md",
+			enabled:  true,
+			expected: variableExportFile,
+		},
+		"OutputFileInSubdir": {
+			file:     filepath.Join("docs", "README.md"),
+			enabled:  true,
+			expected: filepath.Join("docs", variableExportFile),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			root := t.TempDir()
+			assert.Nil(os.MkdirAll(filepath.Join(root, "docs"), 0755))
+
+			config := print.DefaultConfig()
+			config.ModuleRoot = root
+			config.Output.File = tt.file
+			config.Settings.VariableExport = tt.enabled
+
+			module := &terraform.Module{Inputs: []*terraform.Input{{Name: "a"}}}
+
+			err := writeVariableExport(config, module)
+			assert.Nil(err)
+
+			if tt.expected == "" {
+				_, err = os.Stat(filepath.Join(root, variableExportFile))
+				assert.True(os.IsNotExist(err))
+				return
+			}
+
+			expected, err := variableExportContent(config, module)
+			assert.Nil(err)
+
+			actual, err := os.ReadFile(filepath.Join(root, tt.expected))
+			assert.Nil(err)
+			assert.Equal(expected, string(actual))
+		})
+	}
+}
+
+func TestWriteVariableExportCheck(t *testing.T) {
+	assert := assert.New(t)
+
+	root := t.TempDir()
+	filename := filepath.Join(root, variableExportFile)
+	assert.Nil(os.WriteFile(filename, []byte("{}\n"), 0644))
+
+	config := print.DefaultConfig()
+	config.ModuleRoot = root
+	config.Output.File = "README.md"
+	config.Output.Check = true
+	config.Settings.VariableExport = true
+
+	err := writeVariableExport(config, &terraform.Module{})
+	assert.NotNil(err)
+	assert.Equal(fmt.Sprintf("%s is out of date", filename), err.Error())
+}
@@ -11,16 +11,22 @@ the root directory of this source tree.
 package cli
 
 import (
+	"bufio"
 	"bytes"
 	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"text/template"
+	"time"
+
+	"github.com/pmezard/go-difflib/difflib"
 
 	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
 )
 
 // stdoutWriter writes content to os.Stdout.
@@ -43,18 +49,26 @@ func (sw *stdoutWriter) Write(p []byte) (int, error) {
 // template into 'dir/file' between the 'begin' and 'end' comment. Note that
 // this will fail if 'dir/file' doesn't exist, or doesn't contain 'begin' or
 // 'end' comment.
+//
+// If 'templateFile' is provided, the whole content of 'dir/file' is replaced
+// with output of the template read from it, regardless of 'mode'.
 type fileWriter struct {
 	file string
 	dir  string
 
 	mode string
 
-	check bool
+	check  bool
+	dryRun bool
 
 	template string
 	begin    string
 	end      string
 
+	templateFile string
+	module       *terraform.Module
+	settings     interface{}
+
 	writer io.Writer
 }
 
@@ -62,6 +76,14 @@ type fileWriter struct {
 func (fw *fileWriter) Write(p []byte) (int, error) {
 	filename := fw.fullFilePath()
 
+	if fw.templateFile != "" {
+		buf, err := fw.applyFile(p)
+		if err != nil {
+			return 0, err
+		}
+		return fw.write(filename, buf.Bytes())
+	}
+
 	if fw.template == "" {
 		// template is optional for mode replace
 		if fw.mode == print.OutputModeReplace {
@@ -122,6 +144,45 @@ func (fw *fileWriter) apply(p []byte) (bytes.Buffer, error) {
 	return buf, err
 }
 
+// applyFile applies template read from 'templateFile' to generated output.
+// The template has access to the loaded 'Module', the 'Settings' and the
+// generated output as 'GeneratedDocs', as well as 'now' and 'env' functions.
+func (fw *fileWriter) applyFile(p []byte) (bytes.Buffer, error) {
+	type content struct {
+		Module        *terraform.Module
+		Settings      interface{}
+		GeneratedDocs string
+	}
+
+	var buf bytes.Buffer
+
+	filename := fw.templateFile
+	if !filepath.IsAbs(filename) {
+		filename = filepath.Join(fw.dir, filename)
+	}
+
+	text, err := os.ReadFile(filepath.Clean(filename))
+	if err != nil {
+		return buf, fmt.Errorf("unable to read output template file, %w", err)
+	}
+
+	tmpl, err := template.New("content").Funcs(template.FuncMap{
+		"now": time.Now,
+		"env": os.Getenv,
+	}).Parse(string(text))
+	if err != nil {
+		return buf, err
+	}
+
+	err = tmpl.ExecuteTemplate(&buf, "content", content{
+		Module:        fw.module,
+		Settings:      fw.settings,
+		GeneratedDocs: string(p),
+	})
+
+	return buf, err
+}
+
 // inject generated output into file.
 func (fw *fileWriter) inject(filename string, content string, generated string) (int, error) {
 	before := strings.Index(content, fw.begin)
@@ -159,6 +220,10 @@ func (fw *fileWriter) inject(filename string, content string, generated string)
 // write the content to io.Writer. If no io.Writer is available,
 // it will be written to 'filename'.
 func (fw *fileWriter) write(filename string, p []byte) (int, error) {
+	if fw.dryRun {
+		return 0, previewFile(filename, p, fw.check)
+	}
+
 	// if run in check mode return exit 1
 	if fw.check {
 		f, err := os.ReadFile(filepath.Clean(filename))
@@ -166,8 +231,9 @@ func (fw *fileWriter) write(filename string, p []byte) (int, error) {
 			return 0, err
 		}
 
-		// check for changes and print changed file
+		// check for changes and print changed file along with the diff
 		if !bytes.Equal(f, p) {
+			fmt.Print(unifiedDiff(filename, f, p))
 			return 0, fmt.Errorf("%s is out of date", filename)
 		}
 
@@ -182,3 +248,122 @@ func (fw *fileWriter) write(filename string, p []byte) (int, error) {
 	fmt.Printf("%s updated successfully\n", filename)
 	return len(p), os.WriteFile(filename, p, 0644)
 }
+
+// unifiedDiff returns the unified diff of the 'current' content of 'filename'
+// and the 'generated' one, as printed by 'diff -u' with 3 lines of context.
+func unifiedDiff(filename string, current []byte, generated []byte) string {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        diffLines(current),
+		B:        diffLines(generated),
+		FromFile: filename,
+		ToFile:   filename + " (generated)",
+		Context:  3,
+	})
+	if err != nil {
+		return ""
+	}
+	return diff
+}
+
+// diffLines splits 'p' into its lines with their trailing newline, which is also
+// added to the last line if it's missing to not join it with the next one.
+func diffLines(p []byte) []string {
+	if len(p) == 0 {
+		return []string{}
+	}
+	lines := strings.SplitAfter(string(p), "\n")
+	if last := len(lines) - 1; lines[last] == "" {
+		lines = lines[:last]
+	} else {
+		lines[last] += "\n"
+	}
+	return lines
+}
+
+// writeModuleFile writes 'content' into 'file' (relative to module root), which
+// is generated alongside the docs. It's checked instead if '--output-check' is
+// set, and nothing is printed if the docs are printed to stdout to not mix with
+// them.
+func writeModuleFile(config *print.Config, file string, content string) error {
+	if config.Output.File == "" && !config.Output.DryRun {
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(config.ModuleRoot, file)
+		}
+		return os.WriteFile(file, []byte(content), 0644)
+	}
+
+	w := &fileWriter{
+		file: file,
+		dir:  config.ModuleRoot,
+
+		mode: print.OutputModeReplace,
+
+		check:  config.Output.Check,
+		dryRun: config.Output.DryRun,
+	}
+
+	_, err := io.WriteString(w, content)
+
+	return err
+}
+
+// sectionHeading matches the headings of Markdown and AsciiDoc sections.
+var sectionHeading = regexp.MustCompile(`^(#{1,6}|={1,6}) \S`)
+
+// Actions of the files printed with '--with-dry-run'.
+const (
+	previewCreate    = "CREATE"
+	previewWrite     = "WRITE"
+	previewUnchanged = "UNCHANGED"
+	previewStale     = "STALE"
+)
+
+// previewFile prints what would be done to 'filename' by writing 'p' into it,
+// e.g. 'WRITE examples/README.md (4 sections, 1240 bytes)', without writing it.
+// With '--output-check' the files which would be created or written are stale
+// and fail the same as check mode does.
+func previewFile(filename string, p []byte, check bool) error {
+	action := previewWrite
+	current, err := os.ReadFile(filepath.Clean(filename))
+	switch {
+	case err != nil:
+		action = previewCreate
+	case bytes.Equal(current, p):
+		action = previewUnchanged
+	}
+	if check && action != previewUnchanged {
+		action = previewStale
+	}
+
+	if sections := countSections(p); sections > 0 {
+		fmt.Printf("%s %s (%d sections, %d bytes)\n", action, filename, sections, len(p))
+	} else {
+		fmt.Printf("%s %s (%d bytes)\n", action, filename, len(p))
+	}
+
+	if action == previewStale {
+		return fmt.Errorf("%s is out of date", filename)
+	}
+	return nil
+}
+
+// countSections returns the number of headings of Markdown (e.g. '## Inputs')
+// or AsciiDoc (e.g. '== Inputs') in 'p', excluding the ones in code blocks.
+func countSections(p []byte) int {
+	count := 0
+	fenced := false
+
+	scanner := bufio.NewScanner(bytes.NewReader(p))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "```") || strings.HasPrefix(line, "----") {
+			fenced = !fenced
+			continue
+		}
+		if !fenced && sectionHeading.MatchString(line) {
+			count++
+		}
+	}
+
+	return count
+}
@@ -12,14 +12,18 @@ package cli
 
 import (
 	"bytes"
+	"fmt"
 	"io"
+	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/terraform-docs/terraform-docs/internal/testutil"
 	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
 )
 
 func TestFileWriterFullPath(t *testing.T) {
@@ -293,3 +297,212 @@ func TestFileWriter(t *testing.T) {
 		})
 	}
 }
+
+func TestFileWriterTemplateFile(t *testing.T) {
+	content := "Lorem ipsum dolor sit amet, consectetur adipiscing elit"
+
+	os.Setenv("TFDOCS_TEMPLATE_AUTHOR", "Jane Doe") //nolint:errcheck,gosec
+	defer os.Unsetenv("TFDOCS_TEMPLATE_AUTHOR")     //nolint:errcheck,gosec
+
+	module := &terraform.Module{
+		Header: "Example",
+		Inputs: []*terraform.Input{{Name: "foo"}, {Name: "bar"}},
+	}
+
+	settings := print.DefaultConfig().Settings
+
+	tests := map[string]struct {
+		templateFile string
+		expected     string
+		wantErr      bool
+		errMsg       string
+	}{
+		"AllFields": {
+			templateFile: "output-template.md.tmpl",
+			expected: fmt.Sprintf(
+				"# Example\n\nInputs: 2\nSensitive: true\nAuthor: Jane Doe\nYear: %d\n\n%s\n",
+				time.Now().Year(),
+				content,
+			),
+			wantErr: false,
+			errMsg:  "",
+		},
+		"FileMissing": {
+			templateFile: "file-missing.md.tmpl",
+			expected:     "",
+			wantErr:      true,
+			errMsg:       "unable to read output template file, open testdata/writer/file-missing.md.tmpl: no such file or directory",
+		},
+		"InvalidField": {
+			templateFile: "output-template-invalid.md.tmpl",
+			expected:     "",
+			wantErr:      true,
+			errMsg:       "template: content:1:10: executing \"content\" at <.Module.Foo>: can't evaluate field Foo in type *terraform.Module",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			w := &bytes.Buffer{}
+
+			writer := &fileWriter{
+				file: "mode-replace.md",
+				dir:  filepath.Join("testdata", "writer"),
+
+				mode: print.OutputModeInject,
+
+				templateFile: tt.templateFile,
+				module:       module,
+				settings:     settings,
+
+				writer: w,
+			}
+
+			_, err := io.WriteString(writer, content)
+
+			if tt.wantErr {
+				assert.NotNil(err)
+				assert.Equal(tt.errMsg, err.Error())
+			} else {
+				assert.Nil(err)
+				assert.Equal(tt.expected, w.String())
+			}
+		})
+	}
+}
+
+func TestFileWriterDryRun(t *testing.T) {
+	content := "## Inputs\n\nNo inputs.\n"
+	tests := map[string]struct {
+		existing *string
+		check    bool
+		wantErr  bool
+	}{
+		"Create": {
+			existing: nil,
+			check:    false,
+			wantErr:  false,
+		},
+		"Write": {
+			existing: strptr("outdated"),
+			check:    false,
+			wantErr:  false,
+		},
+		"Unchanged": {
+			existing: strptr(content),
+			check:    true,
+			wantErr:  false,
+		},
+		"Stale": {
+			existing: strptr("outdated"),
+			check:    true,
+			wantErr:  true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			dir := t.TempDir()
+			filename := filepath.Join(dir, "README.md")
+			if tt.existing != nil {
+				assert.Nil(os.WriteFile(filename, []byte(*tt.existing), 0644))
+			}
+
+			writer := &fileWriter{
+				file: "README.md",
+				dir:  dir,
+
+				mode: print.OutputModeReplace,
+
+				check:  tt.check,
+				dryRun: true,
+			}
+
+			_, err := io.WriteString(writer, content)
+
+			if tt.wantErr {
+				assert.NotNil(err)
+				assert.Equal(fmt.Sprintf("%s is out of date", filename), err.Error())
+			} else {
+				assert.Nil(err)
+			}
+
+			// nothing is written in dry run
+			actual, err := os.ReadFile(filename)
+			if tt.existing == nil {
+				assert.True(os.IsNotExist(err))
+			} else {
+				assert.Nil(err)
+				assert.Equal(*tt.existing, string(actual))
+			}
+		})
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	tests := map[string]struct {
+		current   string
+		generated string
+		expected  string
+	}{
+		"Unchanged": {
+			current:   "# Title\n\nfoo\n",
+			generated: "# Title\n\nfoo\n",
+			expected:  "",
+		},
+		"Changed": {
+			current:   "# Title\n\nfoo\n",
+			generated: "# Title\n\nbar\n",
+			expected:  "--- README.md\n+++ README.md (generated)\n@@ -1,3 +1,3 @@\n # Title\n \n-foo\n+bar\n",
+		},
+		"WithoutTrailingNewline": {
+			current:   "foo",
+			generated: "bar",
+			expected:  "--- README.md\n+++ README.md (generated)\n@@ -1 +1 @@\n-foo\n+bar\n",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, unifiedDiff("README.md", []byte(tt.current), []byte(tt.generated)))
+		})
+	}
+}
+
+func TestCountSections(t *testing.T) {
+	tests := map[string]struct {
+		content  string
+		expected int
+	}{
+		"Empty": {
+			content:  "",
+			expected: 0,
+		},
+		"Markdown": {
+			content:  "# Header\n\n## Inputs\n\nNo inputs.\n\n## Outputs\n\nNo outputs.\n",
+			expected: 3,
+		},
+		"MarkdownCodeBlock": {
+			content:  "## Inputs\n\n```hcl\n# In terraform.tfvars:\nfoo = \"bar\"\n```\n",
+			expected: 1,
+		},
+		"AsciiDoc": {
+			content:  "== Inputs\n\n[source]\n----\n= not a heading\n----\n\n== Outputs\n",
+			expected: 2,
+		},
+		"JSON": {
+			content:  "{\n  \"inputs\": []\n}\n",
+			expected: 0,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := countSections([]byte(tt.content))
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 func baseConfig() print.Config {
 	base := print.NewConfig()
 	base.Settings.ReadComments = true
+	base.Settings.ShowCoreVersion = true
 
 	return *base
 }
@@ -14,10 +14,11 @@ import (
 	"os"
 
 	"github.com/terraform-docs/terraform-docs/cmd"
+	"github.com/terraform-docs/terraform-docs/internal/cli"
 )
 
 func main() {
 	if err := cmd.Execute(); err != nil {
-		os.Exit(1)
+		os.Exit(cli.ExitCode(err))
 	}
 }
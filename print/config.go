@@ -15,26 +15,45 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"regexp"
 	"strings"
+	"text/template"
 
+	goversion "github.com/hashicorp/go-version"
 	"github.com/spf13/viper"
 )
 
 // Config represents all the available config options that can be accessed and
 // passed through CLI.
 type Config struct {
-	File         string       `mapstructure:"-"`
-	Formatter    string       `mapstructure:"formatter"`
-	Version      string       `mapstructure:"version"`
-	HeaderFrom   string       `mapstructure:"header-from"`
-	FooterFrom   string       `mapstructure:"footer-from"`
-	Recursive    recursive    `mapstructure:"recursive"`
-	Content      string       `mapstructure:"content"`
-	Sections     sections     `mapstructure:"sections"`
-	Output       output       `mapstructure:"output"`
-	OutputValues outputvalues `mapstructure:"output-values"`
-	Sort         sort         `mapstructure:"sort"`
-	Settings     settings     `mapstructure:"settings"`
+	File             string       `mapstructure:"-"`
+	Formatter        string       `mapstructure:"formatter"`
+	Version          string       `mapstructure:"version"`
+	TerraformVersion string       `mapstructure:"terraform-version"`
+	HeaderFrom       string       `mapstructure:"header-from"`
+	FooterFrom       string       `mapstructure:"footer-from"`
+	Recursive        recursive    `mapstructure:"recursive"`
+	Content          string       `mapstructure:"content"`
+	ContentFrom      string       `mapstructure:"content-from"`
+	DeprecationsFrom string       `mapstructure:"deprecations-from"`
+	VarFileDefaults  []string     `mapstructure:"var-file-defaults"`
+	Sections         sections     `mapstructure:"sections"`
+	Output           output       `mapstructure:"output"`
+	OutputValues     outputvalues `mapstructure:"output-values"`
+	ExamplePlan      exampleplan  `mapstructure:"example-plan"`
+	LockDiff         lockdiff     `mapstructure:"lock-diff"`
+	Cache            cache        `mapstructure:"cache"`
+	Changelog        changelog    `mapstructure:"changelog"`
+	ConfigLink       configlink   `mapstructure:"config-link"`
+	Exclude          exclude      `mapstructure:"exclude"`
+	Experimental     experimental `mapstructure:"experimental"`
+	FailOn           failon       `mapstructure:"fail-on"`
+	HealthScore      healthscore  `mapstructure:"health-score"`
+	Registry         registry     `mapstructure:"registry"`
+	Sensitive        sensitive    `mapstructure:"sensitive"`
+	Sort             sort         `mapstructure:"sort"`
+	SourceLinks      sourcelinks  `mapstructure:"source-link-templates"`
+	Settings         settings     `mapstructure:"settings"`
 
 	ModuleRoot string
 }
@@ -47,7 +66,19 @@ func NewConfig() *Config {
 		Sections:     sections{},
 		Output:       output{},
 		OutputValues: outputvalues{},
+		ExamplePlan:  exampleplan{},
+		LockDiff:     lockdiff{},
+		Cache:        cache{},
+		Changelog:    changelog{},
+		ConfigLink:   configlink{},
+		Exclude:      exclude{},
+		Experimental: experimental{},
+		FailOn:       failon{},
+		HealthScore:  healthscore{},
+		Registry:     registry{},
+		Sensitive:    sensitive{},
 		Sort:         sort{},
+		SourceLinks:  sourcelinks{},
 		Settings:     settings{},
 	}
 }
@@ -55,32 +86,52 @@ func NewConfig() *Config {
 // DefaultConfig returns new instance of Config with default values set.
 func DefaultConfig() *Config {
 	return &Config{
-		File:         "",
-		Formatter:    "",
-		Version:      "",
-		HeaderFrom:   "main.tf",
-		FooterFrom:   "",
-		Recursive:    defaultRecursive(),
-		Content:      "",
-		Sections:     defaultSections(),
-		Output:       defaultOutput(),
-		OutputValues: defaultOutputValues(),
-		Sort:         defaultSort(),
-		Settings:     defaultSettings(),
+		File:             "",
+		Formatter:        "",
+		Version:          "",
+		TerraformVersion: "",
+		HeaderFrom:       "main.tf",
+		FooterFrom:       "",
+		Recursive:        defaultRecursive(),
+		Content:          "",
+		ContentFrom:      "",
+		DeprecationsFrom: "",
+		VarFileDefaults:  []string{},
+		Sections:         defaultSections(),
+		Output:           defaultOutput(),
+		OutputValues:     defaultOutputValues(),
+		ExamplePlan:      defaultExamplePlan(),
+		LockDiff:         defaultLockDiff(),
+		Cache:            defaultCache(),
+		Changelog:        defaultChangelog(),
+		ConfigLink:       defaultConfigLink(),
+		Exclude:          defaultExclude(),
+		Experimental:     defaultExperimental(),
+		FailOn:           defaultFailOn(),
+		HealthScore:      defaultHealthScore(),
+		Registry:         defaultRegistry(),
+		Sensitive:        defaultSensitive(),
+		Sort:             defaultSort(),
+		SourceLinks:      defaultSourceLinks(),
+		Settings:         defaultSettings(),
 
 		ModuleRoot: "",
 	}
 }
 
 type recursive struct {
-	Enabled bool   `mapstructure:"enabled"`
-	Path    string `mapstructure:"path"`
+	Enabled     bool   `mapstructure:"enabled"`
+	Path        string `mapstructure:"path"`
+	ModuleMap   bool   `mapstructure:"module-map"`
+	Parallelism int    `mapstructure:"parallelism"`
 }
 
 func defaultRecursive() recursive {
 	return recursive{
-		Enabled: false,
-		Path:    "modules",
+		Enabled:     false,
+		Path:        "modules",
+		ModuleMap:   false,
+		Parallelism: 1,
 	}
 }
 
@@ -88,6 +139,12 @@ func (r *recursive) validate() error {
 	if r.Enabled && r.Path == "" {
 		return fmt.Errorf("value of '--recursive-path' can't be empty")
 	}
+	if r.ModuleMap && !r.Enabled {
+		return fmt.Errorf("value of '--with-module-map' can only be used with '--recursive'")
+	}
+	if r.Parallelism < 0 {
+		return fmt.Errorf("value of '--parallelism' can't be negative")
+	}
 	return nil
 }
 
@@ -208,10 +265,13 @@ var (
 )
 
 type output struct {
-	File     string `mapstructure:"file"`
-	Mode     string `mapstructure:"mode"`
-	Template string `mapstructure:"template"`
-	Check    bool
+	File         string `mapstructure:"file"`
+	Mode         string `mapstructure:"mode"`
+	Template     string `mapstructure:"template"`
+	TemplateFile string `mapstructure:"template-file"`
+	Notion       string `mapstructure:"notion"`
+	Check        bool
+	DryRun       bool
 
 	BeginComment string
 	EndComment   string
@@ -219,10 +279,13 @@ type output struct {
 
 func defaultOutput() output {
 	return output{
-		File:     "",
-		Mode:     OutputModeInject,
-		Template: OutputTemplate,
-		Check:    false,
+		File:         "",
+		Mode:         OutputModeInject,
+		Template:     OutputTemplate,
+		TemplateFile: "",
+		Notion:       "",
+		Check:        false,
+		DryRun:       false,
 
 		BeginComment: OutputBeginComment,
 		EndComment:   OutputEndComment,
@@ -230,6 +293,19 @@ func defaultOutput() output {
 }
 
 func (o *output) validate() error {
+	if o.Notion != "" && o.Check {
+		return fmt.Errorf("value of '--output-check' can't be used with '--output-notion'")
+	}
+
+	if o.TemplateFile != "" {
+		if o.File == "" {
+			return fmt.Errorf("value of '--output-file' can't be empty with '--output-template-file'")
+		}
+		// the whole file is rendered by the template, neither mode nor
+		// template are used
+		return nil
+	}
+
 	if o.File == "" {
 		return nil
 	}
@@ -343,6 +419,260 @@ func (o *outputvalues) validate() error {
 	return nil
 }
 
+type exampleplan struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Vars    string `mapstructure:"vars"`
+}
+
+func defaultExamplePlan() exampleplan {
+	return exampleplan{
+		Enabled: false,
+		Vars:    "",
+	}
+}
+
+func (e *exampleplan) validate() error {
+	if e.Enabled && e.Vars == "" {
+		return fmt.Errorf("value of '--example-plan-vars' is missing")
+	}
+	return nil
+}
+
+type lockdiff struct {
+	Enabled bool   `mapstructure:"enabled"`
+	From    string `mapstructure:"from"`
+	To      string `mapstructure:"to"`
+}
+
+func defaultLockDiff() lockdiff {
+	return lockdiff{
+		Enabled: false,
+		From:    "HEAD~1",
+		To:      "HEAD",
+	}
+}
+
+func (l *lockdiff) validate() error {
+	if !l.Enabled {
+		return nil
+	}
+	for _, ref := range []struct{ flag, value string }{{"--lock-diff-from", l.From}, {"--lock-diff-to", l.To}} {
+		if ref.value == "" {
+			return fmt.Errorf("value of '%s' can't be empty", ref.flag)
+		}
+		if strings.HasPrefix(ref.value, "-") {
+			return fmt.Errorf("value of '%s' is not a valid Git ref: %s", ref.flag, ref.value)
+		}
+	}
+	return nil
+}
+
+type cache struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Dir     string `mapstructure:"dir"`
+}
+
+func defaultCache() cache {
+	// disabled for library callers to not write to cache directory of user
+	// unexpectedly, the CLI enables it by default
+	return cache{
+		Enabled: false,
+		Dir:     "",
+	}
+}
+
+type changelog struct {
+	Enabled  bool `mapstructure:"enabled"`
+	Versions int  `mapstructure:"versions"`
+}
+
+func defaultChangelog() changelog {
+	return changelog{
+		Enabled:  false,
+		Versions: 0,
+	}
+}
+
+func (c *changelog) validate() error {
+	if c.Versions < 0 {
+		return fmt.Errorf("value of '--changelog-version' can't be negative")
+	}
+	return nil
+}
+
+type configlink struct {
+	Enabled bool   `mapstructure:"enabled"`
+	RepoURL string `mapstructure:"repo-url"`
+
+	// Path is the path of the config file which is used, relative to the root
+	// of its Git repository (if any). It's set when reading the config file.
+	Path string `mapstructure:"-"`
+}
+
+func defaultConfigLink() configlink {
+	return configlink{
+		Enabled: false,
+		RepoURL: "",
+	}
+}
+
+type exclude struct {
+	Variables []string `mapstructure:"variables"`
+	Outputs   []string `mapstructure:"outputs"`
+	File      string   `mapstructure:"file"`
+}
+
+func defaultExclude() exclude {
+	return exclude{
+		Variables: []string{},
+		Outputs:   []string{},
+		File:      "",
+	}
+}
+
+func (e *exclude) validate() error {
+	for _, list := range []struct {
+		flag     string
+		patterns []string
+	}{{"--exclude-variable", e.Variables}, {"--exclude-output", e.Outputs}} {
+		for _, pattern := range list.patterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("value of '%s' is not a valid pattern: %s", list.flag, pattern)
+			}
+		}
+	}
+	return nil
+}
+
+type experimental struct {
+	OpenTelemetry bool `mapstructure:"opentelemetry"`
+}
+
+func defaultExperimental() experimental {
+	return experimental{
+		OpenTelemetry: false,
+	}
+}
+
+type failon struct {
+	MarkdownLint        bool `mapstructure:"markdown-lint"`
+	UndocumentedInputs  bool `mapstructure:"undocumented-inputs"`
+	UndocumentedOutputs bool `mapstructure:"undocumented-outputs"`
+}
+
+func defaultFailOn() failon {
+	return failon{
+		MarkdownLint:        false,
+		UndocumentedInputs:  false,
+		UndocumentedOutputs: false,
+	}
+}
+
+type healthscore struct {
+	Enabled bool               `mapstructure:"enabled"`
+	Weights healthscoreweights `mapstructure:"weights"`
+}
+
+type healthscoreweights struct {
+	Inputs       int `mapstructure:"inputs"`
+	Outputs      int `mapstructure:"outputs"`
+	Header       int `mapstructure:"header"`
+	Requirements int `mapstructure:"requirements"`
+}
+
+func defaultHealthScore() healthscore {
+	return healthscore{
+		Enabled: false,
+		Weights: healthscoreweights{
+			Inputs:       40,
+			Outputs:      30,
+			Header:       10,
+			Requirements: 20,
+		},
+	}
+}
+
+func (h *healthscore) validate() error {
+	if !h.Enabled {
+		return nil
+	}
+	total := 0
+	for _, item := range []struct {
+		name   string
+		weight int
+	}{
+		{"inputs", h.Weights.Inputs},
+		{"outputs", h.Weights.Outputs},
+		{"header", h.Weights.Header},
+		{"requirements", h.Weights.Requirements},
+	} {
+		if item.weight < 0 {
+			return fmt.Errorf("value of 'health-score.weights.%s' can't be negative", item.name)
+		}
+		total += item.weight
+	}
+	if total == 0 {
+		return fmt.Errorf("value of 'health-score.weights' can't be all zero")
+	}
+	return nil
+}
+
+type registry struct {
+	Enabled   bool   `mapstructure:"enabled"`
+	Namespace string `mapstructure:"namespace"`
+	Name      string `mapstructure:"name"`
+	Provider  string `mapstructure:"provider"`
+}
+
+func defaultRegistry() registry {
+	return registry{
+		Enabled:   false,
+		Namespace: "",
+		Name:      "",
+		Provider:  "",
+	}
+}
+
+func (r *registry) validate() error {
+	if !r.Enabled {
+		return nil
+	}
+	for _, item := range []struct {
+		flag  string
+		value string
+	}{
+		{"--registry-namespace", r.Namespace},
+		{"--registry-name", r.Name},
+		{"--registry-provider", r.Provider},
+	} {
+		if item.value == "" {
+			return fmt.Errorf("value of '%s' can't be empty with '--with-module-registry-link'", item.flag)
+		}
+	}
+	return nil
+}
+
+type sensitive struct {
+	RedactDefaults bool     `mapstructure:"redact-defaults"`
+	Patterns       []string `mapstructure:"patterns"`
+}
+
+func defaultSensitive() sensitive {
+	return sensitive{
+		RedactDefaults: false,
+		Patterns:       []string{},
+	}
+}
+
+func (s *sensitive) validate() error {
+	for _, pattern := range s.Patterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("value of '--sensitive-patterns' is not a valid regular expression: %s", pattern)
+		}
+	}
+	return nil
+}
+
 // Sort types.
 const (
 	SortName     = "name"
@@ -360,14 +690,20 @@ var allSorts = []string{
 var SortTypes = strings.Join(allSorts, ", ")
 
 type sort struct {
-	Enabled bool   `mapstructure:"enabled"`
-	By      string `mapstructure:"by"`
+	Enabled               bool     `mapstructure:"enabled"`
+	By                    string   `mapstructure:"by"`
+	PinnedBottomVariables []string `mapstructure:"pinned-bottom-variables"`
+	Providers             string   `mapstructure:"providers"`
+	Requirements          string   `mapstructure:"requirements"`
 }
 
 func defaultSort() sort {
 	return sort{
-		Enabled: true,
-		By:      SortName,
+		Enabled:               true,
+		By:                    SortName,
+		PinnedBottomVariables: []string{},
+		Providers:             "",
+		Requirements:          "",
 	}
 }
 
@@ -375,44 +711,308 @@ func (s *sort) validate() error {
 	if !contains(allSorts, s.By) {
 		return fmt.Errorf("'%s' is not a valid sort type", s.By)
 	}
+	// providers and requirements can only be sorted by name
+	if s.Providers != "" && s.Providers != SortName {
+		return fmt.Errorf("'%s' is not a valid sort type of providers", s.Providers)
+	}
+	if s.Requirements != "" && s.Requirements != SortName {
+		return fmt.Errorf("'%s' is not a valid sort type of requirements", s.Requirements)
+	}
+	return nil
+}
+
+// sourcelinks are the templates of URL of documentation of the module calls,
+// by their name. The template of the first source matching the source address
+// of a module call (e.g. 'github.com/gruntwork-io/') is used.
+type sourcelinks map[string]sourcelink
+
+type sourcelink struct {
+	Source string `mapstructure:"source"`
+	URL    string `mapstructure:"url"`
+}
+
+func defaultSourceLinks() sourcelinks {
+	return sourcelinks{
+		"gruntwork": {
+			Source: "github.com/gruntwork-io/",
+			URL:    "https://docs.gruntwork.io/reference/modules/{{ .Repo }}/{{ .Module }}/",
+		},
+	}
+}
+
+func (s sourcelinks) validate() error {
+	for name, link := range s {
+		if link.Source == "" {
+			return fmt.Errorf("value of 'source-link-templates.%s.source' can't be empty", name)
+		}
+		if link.URL == "" {
+			return fmt.Errorf("value of 'source-link-templates.%s.url' can't be empty", name)
+		}
+		if _, err := template.New(name).Parse(link.URL); err != nil {
+			return fmt.Errorf("value of 'source-link-templates.%s.url' is not a valid template: %w", name, err)
+		}
+	}
 	return nil
 }
 
+// Confluence table styles.
+const (
+	ConfluenceTableDefault  = "default"
+	ConfluenceTableSortable = "sortable"
+)
+
+var allConfluenceTableStyles = []string{
+	ConfluenceTableDefault,
+	ConfluenceTableSortable,
+}
+
+// ConfluenceTableStyles list.
+var ConfluenceTableStyles = strings.Join(allConfluenceTableStyles, ", ")
+
+// HTML modes.
+const (
+	HTMLModeStandalone = "standalone"
+	HTMLModeFragment   = "fragment"
+)
+
+var allHTMLModes = []string{
+	HTMLModeStandalone,
+	HTMLModeFragment,
+}
+
+// HTMLModes list.
+var HTMLModes = strings.Join(allHTMLModes, ", ")
+
+// Escaped pipes variants.
+const (
+	EscapedPipesGitHub = "github"
+	EscapedPipesGitLab = "gitlab"
+)
+
+var allEscapedPipes = []string{
+	EscapedPipesGitHub,
+	EscapedPipesGitLab,
+}
+
+// EscapedPipes list.
+var EscapedPipes = strings.Join(allEscapedPipes, ", ")
+
+// Graph formats.
+const (
+	GraphFormatMermaid = "mermaid"
+	GraphFormatDot     = "dot"
+	GraphFormatD2      = "d2"
+)
+
+var allGraphFormats = []string{
+	GraphFormatMermaid,
+	GraphFormatDot,
+	GraphFormatD2,
+}
+
+// GraphFormats list.
+var GraphFormats = strings.Join(allGraphFormats, ", ")
+
+// Cardinality notations of inputs.
+const (
+	CardinalityUML     = "uml"
+	CardinalityEnglish = "english"
+	CardinalityNumeric = "numeric"
+)
+
+var allCardinalityNotations = []string{
+	CardinalityUML,
+	CardinalityEnglish,
+	CardinalityNumeric,
+}
+
+// CardinalityNotations list.
+var CardinalityNotations = strings.Join(allCardinalityNotations, ", ")
+
 type settings struct {
-	Anchor       bool `mapstructure:"anchor"`
-	Color        bool `mapstructure:"color"`
-	Default      bool `mapstructure:"default"`
-	Description  bool `mapstructure:"description"`
-	Escape       bool `mapstructure:"escape"`
-	HideEmpty    bool `mapstructure:"hide-empty"`
-	HTML         bool `mapstructure:"html"`
-	Indent       int  `mapstructure:"indent"`
-	LockFile     bool `mapstructure:"lockfile"`
-	ReadComments bool `mapstructure:"read-comments"`
-	Required     bool `mapstructure:"required"`
-	Sensitive    bool `mapstructure:"sensitive"`
-	Type         bool `mapstructure:"type"`
+	Anchor                       bool   `mapstructure:"anchor"`
+	ASCIITypeDiagrams            bool   `mapstructure:"ascii-type-diagrams"`
+	AutoDetectRegions            bool   `mapstructure:"auto-detect-regions"`
+	AzureDevOpsWiki              bool   `mapstructure:"azure-devops-wiki"`
+	CallGraphDepth               int    `mapstructure:"call-graph-depth"`
+	Color                        bool   `mapstructure:"color"`
+	Compact                      bool   `mapstructure:"compact"`
+	ConfluenceTableStyle         string `mapstructure:"confluence-table-style"`
+	CostEstimate                 bool   `mapstructure:"cost-estimate"`
+	DarkMode                     bool   `mapstructure:"dark-mode"`
+	DarkModeCSSFile              string `mapstructure:"dark-mode-css-file"`
+	Default                      bool   `mapstructure:"default"`
+	Description                  bool   `mapstructure:"description"`
+	Escape                       bool   `mapstructure:"escape"`
+	EscapedPipes                 string `mapstructure:"escaped-pipes"`
+	ExampleOutputs               bool   `mapstructure:"example-outputs"`
+	ExamplesRunner               bool   `mapstructure:"examples-runner"`
+	ExternalLinks                bool   `mapstructure:"external-links"`
+	Gitignore                    bool   `mapstructure:"gitignore"`
+	GraphFormat                  string `mapstructure:"graph-format"`
+	GruntworkLinks               bool   `mapstructure:"gruntwork-links"`
+	HCLExamples                  bool   `mapstructure:"hcl-examples"`
+	HelmValuesOutput             bool   `mapstructure:"helm-values-output"`
+	HelmValuesPattern            string `mapstructure:"helm-values-pattern"`
+	HideEmpty                    bool   `mapstructure:"hide-empty"`
+	HTML                         bool   `mapstructure:"html"`
+	HTMLMode                     string `mapstructure:"html-mode"`
+	Indent                       int    `mapstructure:"indent"`
+	IndentationLevel             int    `mapstructure:"indentation-level"`
+	InputCardinality             string `mapstructure:"input-cardinality"`
+	InputHCL                     bool   `mapstructure:"input-hcl"`
+	InputHistory                 bool   `mapstructure:"input-history"`
+	InputTablePagination         bool   `mapstructure:"input-table-pagination"`
+	License                      bool   `mapstructure:"license"`
+	LockFile                     bool   `mapstructure:"lockfile"`
+	MarkdownLint                 bool   `mapstructure:"markdown-lint"`
+	ModuleCallGraph              bool   `mapstructure:"module-call-graph"`
+	ModuleInputDefaultsFile      bool   `mapstructure:"module-input-defaults-file"`
+	ModulePurpose                bool   `mapstructure:"module-purpose"`
+	OutputConsumers              bool   `mapstructure:"output-consumers"`
+	OutputDeprecation            bool   `mapstructure:"output-deprecation"`
+	OutputValueType              bool   `mapstructure:"output-value-type"`
+	PaginationSize               int    `mapstructure:"pagination-size"`
+	ProviderSourceNamespace      bool   `mapstructure:"provider-source-namespace"`
+	ProviderSourceVersionMatrix  bool   `mapstructure:"provider-source-version-matrix"`
+	ProviderVersionBadges        bool   `mapstructure:"provider-version-badges"`
+	ReadComments                 bool   `mapstructure:"read-comments"`
+	Required                     bool   `mapstructure:"required"`
+	RequiredJSONSchemaProperties bool   `mapstructure:"required-json-schema-properties"`
+	RequiredVersionBadge         bool   `mapstructure:"required-version-badge"`
+	RunTests                     bool   `mapstructure:"run-tests"`
+	S3BackendDocs                bool   `mapstructure:"s3-backend-docs"`
+	SearchableTable              bool   `mapstructure:"searchable-table"`
+	SectionSeparators            bool   `mapstructure:"section-separators"`
+	Sensitive                    bool   `mapstructure:"sensitive"`
+	SensitiveSummary             bool   `mapstructure:"sensitive-summary"`
+	ShowAttributes               bool   `mapstructure:"show-attributes"`
+	ShowChecks                   bool   `mapstructure:"show-checks"`
+	ShowCoreVersion              bool   `mapstructure:"show-core-version"`
+	ShowDefaultsType             bool   `mapstructure:"show-defaults-type"`
+	ShowEphemeralResources       bool   `mapstructure:"show-ephemeral-resources"`
+	ShowLifecycleConditions      bool   `mapstructure:"show-lifecycle-conditions"`
+	ShowMoved                    bool   `mapstructure:"show-moved"`
+	ShowSummary                  bool   `mapstructure:"show-summary"`
+	ShowValidations              bool   `mapstructure:"show-validations"`
+	TableOfContents              bool   `mapstructure:"table-of-contents"`
+	TagPolicy                    bool   `mapstructure:"tag-policy"`
+	TfsecResults                 bool   `mapstructure:"tfsec-results"`
+	TftestExamples               bool   `mapstructure:"tftest-examples"`
+	Type                         bool   `mapstructure:"type"`
+	VariableExampleBlock         bool   `mapstructure:"variable-example-block"`
+	VariableExport               bool   `mapstructure:"variable-export"`
+	VariableSummaryTable         bool   `mapstructure:"variable-summary-table"`
 }
 
 func defaultSettings() settings {
 	return settings{
-		Anchor:       true,
-		Color:        true,
-		Default:      true,
-		Description:  false,
-		Escape:       true,
-		HideEmpty:    false,
-		HTML:         true,
-		Indent:       2,
-		LockFile:     true,
-		ReadComments: true,
-		Required:     true,
-		Sensitive:    true,
-		Type:         true,
+		Anchor:                       true,
+		ASCIITypeDiagrams:            false,
+		AutoDetectRegions:            false,
+		AzureDevOpsWiki:              false,
+		CallGraphDepth:               3,
+		Color:                        true,
+		Compact:                      false,
+		ConfluenceTableStyle:         ConfluenceTableDefault,
+		CostEstimate:                 false,
+		DarkMode:                     false,
+		DarkModeCSSFile:              "",
+		Default:                      true,
+		Description:                  false,
+		Escape:                       true,
+		EscapedPipes:                 EscapedPipesGitHub,
+		ExampleOutputs:               false,
+		ExamplesRunner:               false,
+		ExternalLinks:                false,
+		Gitignore:                    false,
+		GraphFormat:                  "",
+		GruntworkLinks:               false,
+		HCLExamples:                  false,
+		HelmValuesOutput:             false,
+		HelmValuesPattern:            ".*",
+		HideEmpty:                    false,
+		HTML:                         true,
+		HTMLMode:                     HTMLModeStandalone,
+		Indent:                       2,
+		IndentationLevel:             2,
+		InputCardinality:             "",
+		InputHCL:                     false,
+		InputHistory:                 false,
+		InputTablePagination:         false,
+		License:                      false,
+		LockFile:                     true,
+		MarkdownLint:                 false,
+		ModuleCallGraph:              false,
+		ModuleInputDefaultsFile:      false,
+		ModulePurpose:                false,
+		OutputConsumers:              false,
+		OutputDeprecation:            false,
+		OutputValueType:              false,
+		PaginationSize:               20,
+		ProviderSourceNamespace:      false,
+		ProviderSourceVersionMatrix:  false,
+		ProviderVersionBadges:        false,
+		ReadComments:                 true,
+		Required:                     true,
+		RequiredJSONSchemaProperties: true,
+		RequiredVersionBadge:         false,
+		RunTests:                     false,
+		S3BackendDocs:                false,
+		SearchableTable:              false,
+		SectionSeparators:            false,
+		Sensitive:                    true,
+		SensitiveSummary:             false,
+		ShowAttributes:               false,
+		ShowChecks:                   false,
+		ShowCoreVersion:              true,
+		ShowDefaultsType:             false,
+		ShowEphemeralResources:       true,
+		ShowLifecycleConditions:      false,
+		ShowMoved:                    false,
+		ShowSummary:                  false,
+		ShowValidations:              false,
+		TableOfContents:              false,
+		TagPolicy:                    false,
+		TfsecResults:                 false,
+		TftestExamples:               false,
+		Type:                         true,
+		VariableExampleBlock:         false,
+		VariableExport:               false,
+		VariableSummaryTable:         false,
 	}
 }
 
 func (s *settings) validate() error {
+	if s.ConfluenceTableStyle != "" && !contains(allConfluenceTableStyles, s.ConfluenceTableStyle) {
+		return fmt.Errorf("'%s' is not a valid confluence table style", s.ConfluenceTableStyle)
+	}
+	if s.HTMLMode != "" && !contains(allHTMLModes, s.HTMLMode) {
+		return fmt.Errorf("'%s' is not a valid html mode", s.HTMLMode)
+	}
+	if s.EscapedPipes != "" && !contains(allEscapedPipes, s.EscapedPipes) {
+		return fmt.Errorf("'%s' is not a valid escaped pipes variant", s.EscapedPipes)
+	}
+	if s.GraphFormat != "" && !contains(allGraphFormats, s.GraphFormat) {
+		return fmt.Errorf("'%s' is not a valid graph format", s.GraphFormat)
+	}
+	if s.InputCardinality != "" && !contains(allCardinalityNotations, s.InputCardinality) {
+		return fmt.Errorf("'%s' is not a valid cardinality notation", s.InputCardinality)
+	}
+	if s.IndentationLevel != 0 && (s.IndentationLevel < 1 || s.IndentationLevel > 6) {
+		return fmt.Errorf("value of '--indentation-level' must be between 1 and 6, got %d", s.IndentationLevel)
+	}
+	if s.ModuleCallGraph && s.CallGraphDepth < 1 {
+		return fmt.Errorf("value of '--call-graph-depth' can't be less than 1, got %d", s.CallGraphDepth)
+	}
+	if s.InputTablePagination && s.PaginationSize < 1 {
+		return fmt.Errorf("value of '--pagination-size' can't be less than 1, got %d", s.PaginationSize)
+	}
+	if s.HelmValuesOutput {
+		if _, err := regexp.Compile(s.HelmValuesPattern); err != nil {
+			return fmt.Errorf("value of '--helm-values-pattern' is not a valid regular expression: %s", s.HelmValuesPattern)
+		}
+	}
 	return nil
 }
 
@@ -433,6 +1033,13 @@ func (c *Config) Parse() {
 	if c.FooterFrom != "" {
 		c.Sections.Footer = c.Sections.visibility("footer")
 	}
+
+	// JSON Schema is generated from the validations and attributes (i.e.
+	// nullable) of the inputs, which are only loaded if these are set.
+	if c.Formatter == "jsonschema" {
+		c.Settings.ShowAttributes = true
+		c.Settings.ShowValidations = true
+	}
 }
 
 // Validate provided Config and check for any misuse or misconfiguration.
@@ -442,6 +1049,13 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("value of 'formatter' can't be empty")
 	}
 
+	// terraform-version, features of newer versions are not parsed
+	if c.TerraformVersion != "" {
+		if _, err := goversion.NewSemver(c.TerraformVersion); err != nil {
+			return fmt.Errorf("value of '--terraform-version' is not a valid version: %s", c.TerraformVersion)
+		}
+	}
+
 	// header-from
 	if c.HeaderFrom == "" {
 		return fmt.Errorf("value of '--header-from' can't be empty")
@@ -456,12 +1070,30 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("value of '--footer-from' can't equal value of '--header-from")
 	}
 
+	// content-from, replaces the whole content so it can't be used along with it
+	if c.ContentFrom != "" && c.Content != "" {
+		return fmt.Errorf("value of '--with-readme-template' can't be used with 'content'")
+	}
+
+	// output-notion, all the modules would replace content of the same page
+	if c.Output.Notion != "" && c.Recursive.Enabled {
+		return fmt.Errorf("value of '--output-notion' can't be used with '--recursive'")
+	}
+
 	for _, fn := range [](func() error){
 		c.Recursive.validate,
 		c.Sections.validate,
 		c.Output.validate,
 		c.OutputValues.validate,
+		c.ExamplePlan.validate,
+		c.LockDiff.validate,
+		c.Changelog.validate,
+		c.Exclude.validate,
+		c.HealthScore.validate,
+		c.Registry.validate,
+		c.Sensitive.validate,
 		c.Sort.validate,
+		c.SourceLinks.validate,
 		c.Settings.validate,
 	} {
 		if err := fn(); err != nil {
@@ -151,6 +151,34 @@ func TestConfigVisibility(t *testing.T) {
 	}
 }
 
+func TestConfigParseJSONSchema(t *testing.T) {
+	tests := map[string]struct {
+		formatter string
+		expected  bool
+	}{
+		"JSONSchema": {
+			formatter: "jsonschema",
+			expected:  true,
+		},
+		"OtherFormatter": {
+			formatter: "json",
+			expected:  false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := DefaultConfig()
+			config.Formatter = tt.formatter
+			config.Parse()
+
+			assert.Equal(tt.expected, config.Settings.ShowAttributes)
+			assert.Equal(tt.expected, config.Settings.ShowValidations)
+		})
+	}
+}
+
 func TestConfigOutput(t *testing.T) {
 	tests := map[string]struct {
 		output  output
@@ -248,6 +276,32 @@ func TestConfigOutput(t *testing.T) {
 			wantErr: true,
 			errMsg:  "value of '--output-template' is missing end comment",
 		},
+		"TemplateFile": {
+			output: output{
+				File:         "README.md",
+				Mode:         "",
+				Template:     "",
+				TemplateFile: "README.md.tmpl",
+			},
+			wantErr: false,
+			errMsg:  "",
+		},
+		"TemplateFileWithoutFile": {
+			output: output{
+				File:         "",
+				TemplateFile: "README.md.tmpl",
+			},
+			wantErr: true,
+			errMsg:  "value of '--output-file' can't be empty with '--output-template-file'",
+		},
+		"NotionWithCheck": {
+			output: output{
+				Notion: "page-id",
+				Check:  true,
+			},
+			wantErr: true,
+			errMsg:  "value of '--output-check' can't be used with '--output-notion'",
+		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -495,6 +549,141 @@ func TestConfigSort(t *testing.T) {
 	}
 }
 
+func TestConfigSettings(t *testing.T) {
+	tests := map[string]struct {
+		settings settings
+		wantErr  bool
+		errMsg   string
+	}{
+		"ConfluenceTableEmpty": {
+			settings: settings{
+				ConfluenceTableStyle: "",
+			},
+			wantErr: false,
+			errMsg:  "",
+		},
+		"ConfluenceTableDefault": {
+			settings: settings{
+				ConfluenceTableStyle: ConfluenceTableDefault,
+			},
+			wantErr: false,
+			errMsg:  "",
+		},
+		"ConfluenceTableSortable": {
+			settings: settings{
+				ConfluenceTableStyle: ConfluenceTableSortable,
+			},
+			wantErr: false,
+			errMsg:  "",
+		},
+		"ConfluenceTableUnknown": {
+			settings: settings{
+				ConfluenceTableStyle: "foo",
+			},
+			wantErr: true,
+			errMsg:  "'foo' is not a valid confluence table style",
+		},
+		"EscapedPipesEmpty": {
+			settings: settings{
+				EscapedPipes: "",
+			},
+			wantErr: false,
+			errMsg:  "",
+		},
+		"EscapedPipesGitHub": {
+			settings: settings{
+				EscapedPipes: EscapedPipesGitHub,
+			},
+			wantErr: false,
+			errMsg:  "",
+		},
+		"EscapedPipesGitLab": {
+			settings: settings{
+				EscapedPipes: EscapedPipesGitLab,
+			},
+			wantErr: false,
+			errMsg:  "",
+		},
+		"EscapedPipesUnknown": {
+			settings: settings{
+				EscapedPipes: "foo",
+			},
+			wantErr: true,
+			errMsg:  "'foo' is not a valid escaped pipes variant",
+		},
+		"HTMLModeEmpty": {
+			settings: settings{
+				HTMLMode: "",
+			},
+			wantErr: false,
+			errMsg:  "",
+		},
+		"HTMLModeStandalone": {
+			settings: settings{
+				HTMLMode: HTMLModeStandalone,
+			},
+			wantErr: false,
+			errMsg:  "",
+		},
+		"HTMLModeFragment": {
+			settings: settings{
+				HTMLMode: HTMLModeFragment,
+			},
+			wantErr: false,
+			errMsg:  "",
+		},
+		"HTMLModeUnknown": {
+			settings: settings{
+				HTMLMode: "foo",
+			},
+			wantErr: true,
+			errMsg:  "'foo' is not a valid html mode",
+		},
+		"IndentationLevelEmpty": {
+			settings: settings{
+				IndentationLevel: 0,
+			},
+			wantErr: false,
+			errMsg:  "",
+		},
+		"IndentationLevelSix": {
+			settings: settings{
+				IndentationLevel: 6,
+			},
+			wantErr: false,
+			errMsg:  "",
+		},
+		"IndentationLevelTooLow": {
+			settings: settings{
+				IndentationLevel: -1,
+			},
+			wantErr: true,
+			errMsg:  "value of '--indentation-level' must be between 1 and 6, got -1",
+		},
+		"IndentationLevelTooHigh": {
+			settings: settings{
+				IndentationLevel: 7,
+			},
+			wantErr: true,
+			errMsg:  "value of '--indentation-level' must be between 1 and 6, got 7",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			err := tt.settings.validate()
+
+			if tt.wantErr {
+				assert.NotNil(err)
+				assert.Equal(tt.errMsg, err.Error())
+			} else {
+				assert.Nil(err)
+			}
+		})
+	}
+}
+
 func TestConfigOutputvalues(t *testing.T) {
 	tests := map[string]struct {
 		outputvalues outputvalues
@@ -541,6 +730,52 @@ func TestConfigOutputvalues(t *testing.T) {
 	}
 }
 
+func TestConfigExamplePlan(t *testing.T) {
+	tests := map[string]struct {
+		exampleplan exampleplan
+		wantErr     bool
+		errMsg      string
+	}{
+		"OK": {
+			exampleplan: exampleplan{
+				Enabled: true,
+				Vars:    "example.tfvars",
+			},
+			wantErr: false,
+			errMsg:  "",
+		},
+		"Disabled": {
+			exampleplan: exampleplan{
+				Enabled: false,
+			},
+			wantErr: false,
+			errMsg:  "",
+		},
+		"VarsEmpty": {
+			exampleplan: exampleplan{
+				Enabled: true,
+				Vars:    "",
+			},
+			wantErr: true,
+			errMsg:  "value of '--example-plan-vars' is missing",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			err := tt.exampleplan.validate()
+
+			if tt.wantErr {
+				assert.NotNil(err)
+				assert.Equal(tt.errMsg, err.Error())
+			} else {
+				assert.Nil(err)
+			}
+		})
+	}
+}
+
 func TestConfigValidate(t *testing.T) {
 	tests := map[string]struct {
 		config  func(c *Config)
@@ -567,6 +802,254 @@ func TestConfigValidate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "value of '--recursive-path' can't be empty",
 		},
+		"ModuleMapWithoutRecursive": {
+			config: func(c *Config) {
+				c.Recursive.Enabled = false
+				c.Recursive.ModuleMap = true
+			},
+			wantErr: true,
+			errMsg:  "value of '--with-module-map' can only be used with '--recursive'",
+		},
+		"ParallelismNegative": {
+			config: func(c *Config) {
+				c.Recursive.Parallelism = -1
+			},
+			wantErr: true,
+			errMsg:  "value of '--parallelism' can't be negative",
+		},
+		"TerraformVersionInvalid": {
+			config: func(c *Config) {
+				c.TerraformVersion = "latest"
+			},
+			wantErr: true,
+			errMsg:  "value of '--terraform-version' is not a valid version: latest",
+		},
+		"LockDiffFromEmpty": {
+			config: func(c *Config) {
+				c.LockDiff.Enabled = true
+				c.LockDiff.From = ""
+				c.LockDiff.To = "HEAD"
+			},
+			wantErr: true,
+			errMsg:  "value of '--lock-diff-from' can't be empty",
+		},
+		"LockDiffToOption": {
+			config: func(c *Config) {
+				c.LockDiff.Enabled = true
+				c.LockDiff.From = "HEAD~1"
+				c.LockDiff.To = "--output=foo"
+			},
+			wantErr: true,
+			errMsg:  "value of '--lock-diff-to' is not a valid Git ref: --output=foo",
+		},
+		"ChangelogVersionNegative": {
+			config: func(c *Config) {
+				c.Changelog.Versions = -1
+			},
+			wantErr: true,
+			errMsg:  "value of '--changelog-version' can't be negative",
+		},
+		"ExcludeVariablePattern": {
+			config: func(c *Config) {
+				c.Exclude.Variables = []string{"secret_*"}
+				c.Exclude.Outputs = []string{"*_key"}
+			},
+			wantErr: false,
+			errMsg:  "",
+		},
+		"ExcludeVariableInvalidPattern": {
+			config: func(c *Config) {
+				c.Exclude.Variables = []string{"[secret"}
+			},
+			wantErr: true,
+			errMsg:  "value of '--exclude-variable' is not a valid pattern: [secret",
+		},
+		"ExcludeOutputInvalidPattern": {
+			config: func(c *Config) {
+				c.Exclude.Outputs = []string{"key\\"}
+			},
+			wantErr: true,
+			errMsg:  "value of '--exclude-output' is not a valid pattern: key\\",
+		},
+		"GraphFormat": {
+			config: func(c *Config) {
+				c.Settings.GraphFormat = GraphFormatD2
+			},
+			wantErr: false,
+			errMsg:  "",
+		},
+		"GraphFormatInvalid": {
+			config: func(c *Config) {
+				c.Settings.GraphFormat = "svg"
+			},
+			wantErr: true,
+			errMsg:  "'svg' is not a valid graph format",
+		},
+		"HealthScoreWeights": {
+			config: func(c *Config) {
+				c.HealthScore.Enabled = true
+				c.HealthScore.Weights.Header = 0
+			},
+			wantErr: false,
+			errMsg:  "",
+		},
+		"HealthScoreNegativeWeight": {
+			config: func(c *Config) {
+				c.HealthScore.Enabled = true
+				c.HealthScore.Weights.Outputs = -1
+			},
+			wantErr: true,
+			errMsg:  "value of 'health-score.weights.outputs' can't be negative",
+		},
+		"HealthScoreZeroWeights": {
+			config: func(c *Config) {
+				c.HealthScore.Enabled = true
+				c.HealthScore.Weights.Inputs = 0
+				c.HealthScore.Weights.Outputs = 0
+				c.HealthScore.Weights.Header = 0
+				c.HealthScore.Weights.Requirements = 0
+			},
+			wantErr: true,
+			errMsg:  "value of 'health-score.weights' can't be all zero",
+		},
+		"InputCardinality": {
+			config: func(c *Config) {
+				c.Settings.InputCardinality = CardinalityEnglish
+			},
+			wantErr: false,
+			errMsg:  "",
+		},
+		"InputCardinalityInvalid": {
+			config: func(c *Config) {
+				c.Settings.InputCardinality = "latin"
+			},
+			wantErr: true,
+			errMsg:  "'latin' is not a valid cardinality notation",
+		},
+		"SourceLinkTemplates": {
+			config: func(c *Config) {
+				c.SourceLinks["acme"] = sourcelink{Source: "github.com/acme/", URL: "https://docs.acme.com/{{ .Repo }}"}
+			},
+			wantErr: false,
+			errMsg:  "",
+		},
+		"SourceLinkTemplatesSourceEmpty": {
+			config: func(c *Config) {
+				c.SourceLinks["acme"] = sourcelink{Source: "", URL: "https://docs.acme.com/{{ .Repo }}"}
+			},
+			wantErr: true,
+			errMsg:  "value of 'source-link-templates.acme.source' can't be empty",
+		},
+		"SourceLinkTemplatesURLEmpty": {
+			config: func(c *Config) {
+				c.SourceLinks["acme"] = sourcelink{Source: "github.com/acme/", URL: ""}
+			},
+			wantErr: true,
+			errMsg:  "value of 'source-link-templates.acme.url' can't be empty",
+		},
+		"SourceLinkTemplatesURLInvalid": {
+			config: func(c *Config) {
+				c.SourceLinks["acme"] = sourcelink{Source: "github.com/acme/", URL: "https://docs.acme.com/{{ .Repo"}
+			},
+			wantErr: true,
+			errMsg:  "value of 'source-link-templates.acme.url' is not a valid template: template: acme:1: unclosed action",
+		},
+		"CallGraphDepth": {
+			config: func(c *Config) {
+				c.Settings.ModuleCallGraph = true
+				c.Settings.CallGraphDepth = 0
+			},
+			wantErr: true,
+			errMsg:  "value of '--call-graph-depth' can't be less than 1, got 0",
+		},
+		"PaginationSize": {
+			config: func(c *Config) {
+				c.Settings.InputTablePagination = true
+				c.Settings.PaginationSize = 0
+			},
+			wantErr: true,
+			errMsg:  "value of '--pagination-size' can't be less than 1, got 0",
+		},
+		"HelmValuesPattern": {
+			config: func(c *Config) {
+				c.Settings.HelmValuesOutput = true
+				c.Settings.HelmValuesPattern = "^helm_("
+			},
+			wantErr: true,
+			errMsg:  "value of '--helm-values-pattern' is not a valid regular expression: ^helm_(",
+		},
+		"SortProvidersByName": {
+			config: func(c *Config) {
+				c.Sort.Providers = SortName
+				c.Sort.Requirements = SortName
+			},
+			wantErr: false,
+			errMsg:  "",
+		},
+		"SortProvidersByType": {
+			config: func(c *Config) {
+				c.Sort.Providers = SortType
+			},
+			wantErr: true,
+			errMsg:  "'type' is not a valid sort type of providers",
+		},
+		"SortRequirementsByRequired": {
+			config: func(c *Config) {
+				c.Sort.Requirements = SortRequired
+			},
+			wantErr: true,
+			errMsg:  "'required' is not a valid sort type of requirements",
+		},
+		"RegistryLink": {
+			config: func(c *Config) {
+				c.Registry.Enabled = true
+				c.Registry.Namespace = "terraform-aws-modules"
+				c.Registry.Name = "vpc"
+				c.Registry.Provider = "aws"
+			},
+			wantErr: false,
+			errMsg:  "",
+		},
+		"RegistryLinkWithoutProvider": {
+			config: func(c *Config) {
+				c.Registry.Enabled = true
+				c.Registry.Namespace = "terraform-aws-modules"
+				c.Registry.Name = "vpc"
+			},
+			wantErr: true,
+			errMsg:  "value of '--registry-provider' can't be empty with '--with-module-registry-link'",
+		},
+		"SensitivePattern": {
+			config: func(c *Config) {
+				c.Sensitive.RedactDefaults = true
+				c.Sensitive.Patterns = []string{"(?i)password", "^AKIA[0-9A-Z]{16}$"}
+			},
+			wantErr: false,
+			errMsg:  "",
+		},
+		"SensitiveInvalidPattern": {
+			config: func(c *Config) {
+				c.Sensitive.Patterns = []string{"(password"}
+			},
+			wantErr: true,
+			errMsg:  "value of '--sensitive-patterns' is not a valid regular expression: (password",
+		},
+		"ContentFromWithContent": {
+			config: func(c *Config) {
+				c.Content = "{{ .Inputs }}"
+				c.ContentFrom = "README.md.tmpl"
+			},
+			wantErr: true,
+			errMsg:  "value of '--with-readme-template' can't be used with 'content'",
+		},
+		"NotionWithRecursive": {
+			config: func(c *Config) {
+				c.Recursive.Enabled = true
+				c.Output.Notion = "page-id"
+			},
+			wantErr: true,
+			errMsg:  "value of '--output-notion' can't be used with '--recursive'",
+		},
 		"HeaderFromEmpty": {
 			config: func(c *Config) {
 				c.HeaderFrom = ""
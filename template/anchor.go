@@ -28,6 +28,21 @@ func CreateAnchorMarkdown(prefix string, value string, anchor bool, escape bool)
 	return sanitizedName
 }
 
+// CreateAnchorAzureDevOps creates HTML anchor for Markdown format of Azure
+// DevOps Wiki, which strips 'name' attribute of '<a>' tag and only supports 'id'.
+func CreateAnchorAzureDevOps(prefix string, value string, anchor bool, escape bool) string {
+	sanitizedName := SanitizeName(value, escape)
+
+	if anchor {
+		anchorName := fmt.Sprintf("%s_%s", prefix, value)
+		sanitizedAnchorName := SanitizeName(anchorName, escape)
+		// the <a> link is purposely not sanitized as this breaks markdown formatting
+		return fmt.Sprintf("<a id=\"%s\"></a> [%s](#%s)", anchorName, sanitizedName, sanitizedAnchorName)
+	}
+
+	return sanitizedName
+}
+
 // CreateAnchorAsciidoc creates HTML anchor for AsciiDoc format.
 func CreateAnchorAsciidoc(prefix string, value string, anchor bool, escape bool) string {
 	sanitizedName := SanitizeName(value, escape)
@@ -64,6 +64,47 @@ func TestAnchorMarkdown(t *testing.T) {
 	}
 }
 
+func TestAnchorAzureDevOps(t *testing.T) {
+	tests := []struct {
+		typeSection string
+		name        string
+		anchor      bool
+		escape      bool
+		expected    string
+	}{
+		{
+			typeSection: "module",
+			name:        "banana_anchor_escape",
+			anchor:      true,
+			escape:      true,
+			expected:    "<a id=\"module_banana_anchor_escape\"></a> [banana\\_anchor\\_escape](#module\\_banana\\_anchor\\_escape)",
+		},
+		{
+			typeSection: "module",
+			name:        "banana_anchor_noescape",
+			anchor:      true,
+			escape:      false,
+			expected:    "<a id=\"module_banana_anchor_noescape\"></a> [banana_anchor_noescape](#module_banana_anchor_noescape)",
+		},
+		{
+			typeSection: "module",
+			name:        "banana_anchor_noescape",
+			anchor:      false,
+			escape:      false,
+			expected:    "banana_anchor_noescape",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := CreateAnchorAzureDevOps(tt.typeSection, tt.name, tt.anchor, tt.escape)
+
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
 func TestAnchorAsciidoc(t *testing.T) {
 	tests := []struct {
 		typeSection string
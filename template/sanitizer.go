@@ -101,6 +101,17 @@ func SanitizeDocument(s string, escape bool, html bool) string {
 // SanitizeMarkdownTable converts passed 'string' to suitable Markdown representation
 // for a table. (including line-break, illegal characters, code blocks etc).
 func SanitizeMarkdownTable(s string, escape bool, html bool) string {
+	return sanitizeMarkdownTable(s, escape, html, escapedPipeGitHub)
+}
+
+// SanitizeMarkdownTableGitLab converts passed 'string' to suitable Markdown
+// representation for a table rendered by GitLab, which requires the pipes to be
+// escaped as HTML entity.
+func SanitizeMarkdownTableGitLab(s string, escape bool, html bool) string {
+	return sanitizeMarkdownTable(s, escape, html, escapedPipeGitLab)
+}
+
+func sanitizeMarkdownTable(s string, escape bool, html bool, pipe string) string {
 	if s == "" {
 		return "n/a"
 	}
@@ -108,7 +119,7 @@ func SanitizeMarkdownTable(s string, escape bool, html bool) string {
 		s,
 		"```",
 		func(segment string, first bool, last bool) string {
-			segment = EscapeCharacters(segment, escape, true)
+			segment = escapeCharacters(segment, escape, pipe)
 			segment = ConvertMultiLineText(segment, true, false, html)
 			segment = NormalizeURLs(segment, escape)
 			return segment
@@ -219,16 +230,32 @@ func ConvertOneLineCodeBlock(s string) string {
 	return strings.Join(result, " ")
 }
 
+// Escaped representation of pipe in tables.
+const (
+	escapedPipeGitHub = "\\|"
+	escapedPipeGitLab = "&#124;"
+)
+
 // EscapeCharacters escapes characters which have special meaning in Markdown into
 // their corresponding literal.
 func EscapeCharacters(s string, escape bool, escapePipe bool) string {
-	// Escape pipe (only for 'markdown table' or 'asciidoc table')
+	pipe := ""
 	if escapePipe {
+		pipe = escapedPipeGitHub
+	}
+	return escapeCharacters(s, escape, pipe)
+}
+
+// escapeCharacters escapes characters which have special meaning in Markdown,
+// and the pipes into 'pipe' if it's not empty.
+func escapeCharacters(s string, escape bool, pipe string) string {
+	// Escape pipe (only for 'markdown table' or 'asciidoc table')
+	if pipe != "" {
 		s = processSegments(
 			s,
 			"`",
 			func(segment string, first bool, last bool) string {
-				return strings.ReplaceAll(segment, "|", "\\|")
+				return strings.ReplaceAll(segment, "|", pipe)
 			},
 			func(segment string, first bool, last bool) string {
 				return fmt.Sprintf("`%s`", segment)
@@ -242,6 +242,53 @@ func TestSanitizeMarkdownTable(t *testing.T) {
 	}
 }
 
+func TestSanitizeMarkdownTableGitLab(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		expected string
+		html     bool
+		escape   bool
+	}{
+		{
+			name:     "sanitize table item empty for gitlab",
+			filename: "empty",
+			expected: "empty",
+			html:     true,
+			escape:   true,
+		},
+		{
+			name:     "sanitize table item complex for gitlab with html",
+			filename: "complex",
+			expected: "complex-gitlab-html",
+			html:     true,
+			escape:   true,
+		},
+		{
+			name:     "sanitize table item complex for gitlab without html",
+			filename: "complex",
+			expected: "complex-gitlab-nohtml",
+			html:     false,
+			escape:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			bytes, err := ioutil.ReadFile(filepath.Join("testdata", "table", tt.filename+".golden"))
+			assert.Nil(err)
+
+			actual := SanitizeMarkdownTableGitLab(string(bytes), tt.escape, tt.html)
+
+			expected, err := ioutil.ReadFile(filepath.Join("testdata", "table", tt.expected+".markdown.expected"))
+			assert.Nil(err)
+
+			assert.Equal(string(expected), actual)
+		})
+	}
+}
+
 func TestSanitizeAsciidocTable(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -142,6 +142,9 @@ func builtinFuncs(config *print.Config) gotemplate.FuncMap { // nolint:gocyclo
 			return _default
 		},
 		"indent": func(extra int, char string) string {
+			if char == "#" {
+				return GenerateMarkdownIndentation(config.Settings.IndentationLevel, config.Settings.Indent, extra)
+			}
 			return GenerateIndentation(config.Settings.Indent, extra, char)
 		},
 		"name": func(name string) string {
@@ -206,6 +209,9 @@ func builtinFuncs(config *print.Config) gotemplate.FuncMap { // nolint:gocyclo
 			return SanitizeDocument(s, config.Settings.Escape, config.Settings.HTML)
 		},
 		"sanitizeMarkdownTbl": func(s string) string {
+			if config.Settings.EscapedPipes == print.EscapedPipesGitLab {
+				return SanitizeMarkdownTableGitLab(s, config.Settings.Escape, config.Settings.HTML)
+			}
 			return SanitizeMarkdownTable(s, config.Settings.Escape, config.Settings.HTML)
 		},
 		"sanitizeAsciidocTbl": func(s string) string {
@@ -214,6 +220,9 @@ func builtinFuncs(config *print.Config) gotemplate.FuncMap { // nolint:gocyclo
 
 		// anchors
 		"anchorNameMarkdown": func(prefix string, value string) string {
+			if config.Settings.AzureDevOpsWiki {
+				return CreateAnchorAzureDevOps(prefix, value, config.Settings.Anchor, config.Settings.Escape)
+			}
 			return CreateAnchorMarkdown(prefix, value, config.Settings.Anchor, config.Settings.Escape)
 		},
 		"anchorNameAsciidoc": func(prefix string, value string) string {
@@ -260,3 +269,17 @@ func GenerateIndentation(base int, extra int, char string) string {
 	}
 	return indent
 }
+
+// GenerateMarkdownIndentation generates indentation of Markdown headers with
+// base level of provided 'settings.IndentationLevel' plus any extra level needed
+// for subsection. 'settings.Indent' is used instead if the level is not set (or
+// is left to its default value) to keep backward compatibility.
+func GenerateMarkdownIndentation(level int, indent int, extra int) string {
+	if level == 0 || (level == 2 && indent != 2) {
+		return GenerateIndentation(indent, extra, "#")
+	}
+	if level < 1 || level > 6 {
+		level = 2
+	}
+	return strings.Repeat("#", level+extra)
+}
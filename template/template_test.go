@@ -509,6 +509,67 @@ func TestGenerateIndentation(t *testing.T) {
 	}
 }
 
+func TestGenerateMarkdownIndentation(t *testing.T) {
+	tests := []struct {
+		name     string
+		level    int
+		indent   int
+		extra    int
+		expected string
+	}{
+		{
+			name:     "generate markdown indentation",
+			level:    1,
+			indent:   2,
+			extra:    0,
+			expected: "#",
+		},
+		{
+			name:     "generate markdown indentation",
+			level:    3,
+			indent:   2,
+			extra:    1,
+			expected: "####",
+		},
+		{
+			name:     "generate markdown indentation",
+			level:    6,
+			indent:   2,
+			extra:    1,
+			expected: "#######",
+		},
+		{
+			name:     "generate markdown indentation",
+			level:    2,
+			indent:   4,
+			extra:    0,
+			expected: "####",
+		},
+		{
+			name:     "generate markdown indentation",
+			level:    0,
+			indent:   3,
+			extra:    1,
+			expected: "####",
+		},
+		{
+			name:     "generate markdown indentation",
+			level:    7,
+			indent:   2,
+			extra:    0,
+			expected: "##",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := GenerateMarkdownIndentation(tt.level, tt.indent, tt.extra)
+
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
 func TestNormalize(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// backendTypeS3 is the type of the 'backend' block storing the state in S3.
+const backendTypeS3 = "s3"
+
+// s3BackendAttributes are the attributes of S3 backend which are required to
+// initialize it, they're included in its configuration with a placeholder if
+// they're not set in the 'backend' block.
+var s3BackendAttributes = []string{"bucket", "key", "region"}
+
+// Backend represents the 'backend' block of Terraform module, i.e. where its
+// state is stored, e.g. in 's3' bucket.
+type Backend struct {
+	Type       string              `json:"type" toml:"type" xml:"type" yaml:"type"`
+	Attributes []*BackendAttribute `json:"attributes" toml:"attributes" xml:"attributes>attribute" yaml:"attributes"`
+}
+
+// BackendAttribute represents an attribute of the 'backend' block, with its
+// value as is in HCL (e.g. "my-bucket" with quotes) or a placeholder if it's
+// not set (e.g. "<bucket>").
+type BackendAttribute struct {
+	Name  string `json:"name" toml:"name" xml:"name" yaml:"name"`
+	Value string `json:"value" toml:"value" xml:"value" yaml:"value"`
+}
+
+// newS3Backend returns Backend of type 's3' with the 'attributes' of its block
+// (name to value in HCL). The required attributes come first, followed by the
+// rest of them sorted by name.
+func newS3Backend(attributes map[string]string) *Backend {
+	backend := &Backend{
+		Type:       backendTypeS3,
+		Attributes: make([]*BackendAttribute, 0, len(attributes)),
+	}
+
+	required := make(map[string]bool, len(s3BackendAttributes))
+	for _, name := range s3BackendAttributes {
+		required[name] = true
+
+		value, ok := attributes[name]
+		if !ok {
+			value = fmt.Sprintf("%q", "<"+name+">")
+		}
+		backend.Attributes = append(backend.Attributes, &BackendAttribute{Name: name, Value: value})
+	}
+
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		if !required[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		backend.Attributes = append(backend.Attributes, &BackendAttribute{Name: name, Value: attributes[name]})
+	}
+
+	return backend
+}
+
+// Config returns the partial configuration of the backend, i.e. content of the
+// 'backend.hcl' file to be passed to 'terraform init -backend-config'.
+func (b *Backend) Config() string {
+	width := 0
+	for _, attribute := range b.Attributes {
+		if len(attribute.Name) > width {
+			width = len(attribute.Name)
+		}
+	}
+
+	lines := make([]string, 0, len(b.Attributes))
+	for _, attribute := range b.Attributes {
+		lines = append(lines, fmt.Sprintf("%-*s = %s", width, attribute.Name, attribute.Value))
+	}
+	return strings.Join(lines, "\n")
+}
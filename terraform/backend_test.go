@@ -0,0 +1,55 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewS3Backend(t *testing.T) {
+	tests := map[string]struct {
+		attributes map[string]string
+		expected   string
+	}{
+		"Empty": {
+			attributes: map[string]string{},
+			expected:   "bucket = \"<bucket>\"\nkey    = \"<key>\"\nregion = \"<region>\"",
+		},
+		"Partial": {
+			attributes: map[string]string{
+				"region":         `"eu-west-1"`,
+				"encrypt":        "true",
+				"dynamodb_table": `"terraform-locks"`,
+			},
+			expected: "bucket         = \"<bucket>\"\nkey            = \"<key>\"\nregion         = \"eu-west-1\"\ndynamodb_table = \"terraform-locks\"\nencrypt        = true",
+		},
+		"Complete": {
+			attributes: map[string]string{
+				"bucket": `"my-state"`,
+				"key":    `"prod/terraform.tfstate"`,
+				"region": `"us-east-1"`,
+			},
+			expected: "bucket = \"my-state\"\nkey    = \"prod/terraform.tfstate\"\nregion = \"us-east-1\"",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			backend := newS3Backend(tt.attributes)
+
+			assert.Equal("s3", backend.Type)
+			assert.Equal(tt.expected, backend.Config())
+		})
+	}
+}
@@ -0,0 +1,314 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/terraform-docs/terraform-docs/internal/types"
+	"github.com/terraform-docs/terraform-docs/internal/version"
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+var (
+	cacheVersion     string
+	cacheVersionOnce sync.Once
+)
+
+func init() {
+	gob.Register(new(types.Bool))
+	gob.Register(new(types.Empty))
+	gob.Register(new(types.List))
+	gob.Register(new(types.Map))
+	gob.Register(new(types.Nil))
+	gob.Register(new(types.Number))
+	gob.Register(new(types.String))
+}
+
+// cacheStore stores the encoded modules by their key.
+type cacheStore interface {
+	Read(key string) ([]byte, error)
+	Write(key string, content []byte) error
+}
+
+// dirStore stores the encoded modules as files in a directory.
+type dirStore struct {
+	dir string
+}
+
+func (d *dirStore) Read(key string) ([]byte, error) {
+	return os.ReadFile(filepath.Join(d.dir, key+".gob"))
+}
+
+func (d *dirStore) Write(key string, content []byte) error {
+	if err := os.MkdirAll(d.dir, 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(d.dir, key+".gob"), content, 0644)
+}
+
+// cacheDir returns the directory of the cache, which is 'config.Cache.Dir' if
+// provided, or 'terraform-docs' in the cache directory of the user otherwise
+// (e.g. $XDG_CACHE_HOME/terraform-docs).
+func cacheDir(config *print.Config) (string, error) {
+	if config.Cache.Dir != "" {
+		return config.Cache.Dir, nil
+	}
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "terraform-docs"), nil
+}
+
+// isCacheable indicates if the module loaded with the given config can be
+// cached. Modules relying on output of external commands (e.g. 'terraform
+// plan' or 'git show') or on other modules (e.g. consumers of the outputs or the
+// tree of nested module calls) can't be cached as they're not only based on
+// content of the module. The exclusions are listed in 'cache' page of the user
+// guide too, which has to be kept in sync.
+func isCacheable(config *print.Config) bool {
+	return config.Cache.Enabled &&
+		!config.ExamplePlan.Enabled &&
+		!config.Settings.ExampleOutputs &&
+		!config.Settings.ExamplesRunner &&
+		!config.Settings.InputHistory &&
+		!config.Settings.ModuleCallGraph &&
+		!config.Settings.OutputConsumers &&
+		!config.LockDiff.Enabled &&
+		!config.OutputValues.Enabled &&
+		!config.Settings.RunTests &&
+		!config.Settings.TfsecResults &&
+		!config.Settings.CostEstimate
+}
+
+// loadWithCache returns the module from the 'store' if its content and the
+// config haven't been changed since it was cached, otherwise it loads the
+// module with 'load' and caches it. The cache is best effort, failing to read
+// or write it doesn't fail loading the module.
+func loadWithCache(store cacheStore, config *print.Config, load func(*print.Config) (*Module, error)) (*Module, error) {
+	cacheVersionOnce.Do(func() {
+		cacheVersion = version.Full()
+	})
+
+	key, err := cacheKey(config, cacheVersion)
+	if err != nil {
+		return load(config)
+	}
+
+	if content, err := store.Read(key); err == nil {
+		if module, err := decodeModule(content); err == nil {
+			return module, nil
+		}
+	}
+
+	module, err := load(config)
+	if err != nil {
+		return nil, err
+	}
+
+	if content, err := encodeModule(module); err == nil {
+		store.Write(key, content) //nolint:errcheck,gosec
+	}
+
+	return module, nil
+}
+
+// cacheKey returns SHA-256 hash of the 'binaryVersion' of terraform-docs, the
+// config and the content of all the files which the module is loaded from (i.e.
+// all the files in the module root and 'tests' folder, as well as the files to
+// read header, footer, deprecations, excludes and var file defaults from,
+// wherever they are), except the output file. Missing files are hashed as such.
+func cacheKey(config *print.Config, binaryVersion string) (string, error) {
+	hash := sha256.New()
+	hash.Write([]byte(binaryVersion)) //nolint:errcheck,gosec
+
+	cfg, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	hash.Write(cfg) //nolint:errcheck,gosec
+
+	root, err := filepath.Abs(config.ModuleRoot)
+	if err != nil {
+		return "", err
+	}
+	hash.Write([]byte(root)) //nolint:errcheck,gosec
+
+	files := []string{}
+	for _, dir := range []string{root, filepath.Join(root, "tests")} {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			if dir == root {
+				return "", err
+			}
+			continue
+		}
+		for _, entry := range entries {
+			if entry.Type().IsRegular() {
+				files = append(files, filepath.Join(dir, entry.Name()))
+			}
+		}
+	}
+	// header and footer are always relative to module root, the others can be
+	// absolute paths too, the same as how they're loaded
+	for _, file := range []string{config.HeaderFrom, config.FooterFrom} {
+		if file != "" {
+			files = append(files, filepath.Join(root, file))
+		}
+	}
+	for _, file := range append([]string{config.DeprecationsFrom, config.Exclude.File}, config.VarFileDefaults...) {
+		if file == "" {
+			continue
+		}
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(root, file)
+		}
+		files = append(files, filepath.Clean(file))
+	}
+
+	// output file is excluded as it changes by every run, unless the header
+	// or footer is read from it too
+	output := ""
+	if config.Output.File != "" && config.Output.File != config.HeaderFrom && config.Output.File != config.FooterFrom {
+		output = filepath.Join(root, config.Output.File)
+	}
+
+	sort.Strings(files)
+
+	previous := ""
+	for _, file := range files {
+		if file == previous || file == output {
+			continue
+		}
+		previous = file
+
+		// a missing file (e.g. header or var file which is going to be
+		// created) changes the key too, so that creating it invalidates cache
+		exists := []byte{1}
+		content, err := os.ReadFile(filepath.Clean(file))
+		if err != nil {
+			if !errors.Is(err, os.ErrNotExist) {
+				return "", err
+			}
+			exists = []byte{0}
+		}
+
+		hash.Write([]byte(file)) //nolint:errcheck,gosec
+		hash.Write([]byte{0})    //nolint:errcheck,gosec
+		hash.Write(exists)       //nolint:errcheck,gosec
+		hash.Write(content)      //nolint:errcheck,gosec
+		hash.Write([]byte{0})    //nolint:errcheck,gosec
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func encodeModule(module *Module) ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	if err := gob.NewEncoder(buffer).Encode(module); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func decodeModule(content []byte) (*Module, error) {
+	module := new(Module)
+	if err := gob.NewDecoder(bytes.NewReader(content)).Decode(module); err != nil {
+		return nil, err
+	}
+	restoreDecoded(reflect.ValueOf(module))
+	return module, nil
+}
+
+var valueType = reflect.TypeOf((*types.Value)(nil)).Elem()
+
+// restoreDecoded restores the items of decoded module to how they were loaded
+// originally. Empty slices are not encoded by 'encoding/gob', these are set to
+// empty slices again (otherwise they'd be marshaled as 'null' instead of '[]'),
+// and 'types.Value' items are decoded as pointers.
+func restoreDecoded(v reflect.Value) {
+	//nolint:exhaustive
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			restoreDecoded(v.Elem())
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			restoreDecoded(v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Field(i)
+			if !field.CanSet() {
+				continue
+			}
+			switch {
+			case field.Type() == valueType:
+				if !field.IsNil() {
+					field.Set(reflect.ValueOf(restoreValue(field.Interface().(types.Value))))
+				}
+			case field.Kind() == reflect.Slice && field.IsNil():
+				field.Set(reflect.MakeSlice(field.Type(), 0, 0))
+			default:
+				restoreDecoded(field)
+			}
+		}
+	}
+}
+
+func restoreValue(value types.Value) types.Value {
+	switch v := value.(type) {
+	case *types.Bool:
+		return *v
+	case *types.Empty:
+		return *v
+	case *types.Number:
+		return *v
+	case *types.String:
+		return *v
+	case *types.List:
+		return types.List(restoreRaw([]interface{}(*v)).([]interface{}))
+	case *types.Map:
+		return types.Map(restoreRaw(map[string]interface{}(*v)).(map[string]interface{}))
+	}
+	return value
+}
+
+// restoreRaw sets the nested nil lists and maps to empty ones.
+func restoreRaw(raw interface{}) interface{} {
+	switch r := raw.(type) {
+	case []interface{}:
+		list := make([]interface{}, 0, len(r))
+		for _, e := range r {
+			list = append(list, restoreRaw(e))
+		}
+		return list
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(r))
+		for k, e := range r {
+			m[k] = restoreRaw(e)
+		}
+		return m
+	}
+	return raw
+}
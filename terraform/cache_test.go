@@ -0,0 +1,323 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/version"
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+// memoryStore is in-memory cacheStore which counts the reads and writes.
+type memoryStore struct {
+	items  map[string][]byte
+	reads  int
+	writes int
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{items: map[string][]byte{}}
+}
+
+func (m *memoryStore) Read(key string) ([]byte, error) {
+	m.reads++
+	content, ok := m.items[key]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return content, nil
+}
+
+func (m *memoryStore) Write(key string, content []byte) error {
+	m.writes++
+	m.items[key] = content
+	return nil
+}
+
+func TestLoadWithCache(t *testing.T) {
+	assert := assert.New(t)
+
+	config := print.DefaultConfig()
+	config.ModuleRoot = filepath.Join("..", "examples")
+
+	store := newMemoryStore()
+
+	loads := 0
+	load := func(c *print.Config) (*Module, error) {
+		loads++
+		return loadWithOptions(context.Background(), c)
+	}
+
+	// miss
+	expected, err := loadWithCache(store, config, load)
+	assert.Nil(err)
+	assert.Equal(1, loads)
+	assert.Equal(1, store.writes)
+
+	// hit
+	actual, err := loadWithCache(store, config, load)
+	assert.Nil(err)
+	assert.Equal(1, loads)
+	assert.Equal(1, store.writes)
+	assert.Equal(expected, actual)
+
+	// miss, config has been changed
+	config.Settings.ShowSummary = true
+
+	actual, err = loadWithCache(store, config, load)
+	assert.Nil(err)
+	assert.Equal(2, loads)
+	assert.Equal(2, store.writes)
+	assert.NotNil(actual.Summary)
+}
+
+func TestLoadWithCacheCorrupted(t *testing.T) {
+	assert := assert.New(t)
+
+	config := print.DefaultConfig()
+	config.ModuleRoot = filepath.Join("..", "examples")
+
+	store := newMemoryStore()
+
+	cacheVersionOnce.Do(func() {
+		cacheVersion = version.Full()
+	})
+
+	key, err := cacheKey(config, cacheVersion)
+	assert.Nil(err)
+
+	store.items[key] = []byte("corrupted")
+
+	loads := 0
+	module, err := loadWithCache(store, config, func(c *print.Config) (*Module, error) {
+		loads++
+		return loadWithOptions(context.Background(), c)
+	})
+	assert.Nil(err)
+	assert.NotNil(module)
+	assert.Equal(1, loads)
+	assert.Equal(1, store.writes)
+	assert.NotEqual([]byte("corrupted"), store.items[key])
+}
+
+func TestCacheKey(t *testing.T) {
+	dir := t.TempDir()
+
+	write := func(name string, content string) {
+		os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0755)     //nolint:errcheck,gosec
+		os.WriteFile(filepath.Join(dir, name), []byte(content), 0644) //nolint:errcheck,gosec
+	}
+
+	write("main.tf", `variable "foo" {}`)
+	write("README.md", "generated")
+
+	config := print.DefaultConfig()
+	config.ModuleRoot = dir
+	config.Output.File = "README.md"
+
+	base, err := cacheKey(config, "v1.0.0")
+	assert.Nil(t, err)
+
+	tests := map[string]struct {
+		change   func(c *print.Config) string
+		expected bool
+	}{
+		"SameContent": {
+			change: func(c *print.Config) string {
+				return "v1.0.0"
+			},
+			expected: true,
+		},
+		"OutputFileChanged": {
+			change: func(c *print.Config) string {
+				write("README.md", "generated again")
+				return "v1.0.0"
+			},
+			expected: true,
+		},
+		"TerraformFileChanged": {
+			change: func(c *print.Config) string {
+				write("main.tf", `variable "bar" {}`)
+				return "v1.0.0"
+			},
+			expected: false,
+		},
+		"TestFileAdded": {
+			change: func(c *print.Config) string {
+				write("tests/main.tftest.hcl", `run "foo" {}`)
+				return "v1.0.0"
+			},
+			expected: false,
+		},
+		"VersionChanged": {
+			change: func(c *print.Config) string {
+				return "v1.0.1"
+			},
+			expected: false,
+		},
+		"ConfigChanged": {
+			change: func(c *print.Config) string {
+				c.Settings.ShowSummary = true
+				return "v1.0.0"
+			},
+			expected: false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			write("main.tf", `variable "foo" {}`)
+			os.RemoveAll(filepath.Join(dir, "tests")) //nolint:errcheck,gosec
+
+			config := *config
+			binaryVersion := tt.change(&config)
+
+			actual, err := cacheKey(&config, binaryVersion)
+			assert.Nil(err)
+			assert.Equal(tt.expected, base == actual)
+		})
+	}
+}
+
+func TestCacheKeyFilesOutsideModule(t *testing.T) {
+	parent := t.TempDir()
+	dir := filepath.Join(parent, "module")
+	outside := t.TempDir()
+
+	write := func(name string, content string) {
+		os.MkdirAll(filepath.Dir(name), 0755)     //nolint:errcheck,gosec
+		os.WriteFile(name, []byte(content), 0644) //nolint:errcheck,gosec
+	}
+
+	write(filepath.Join(dir, "main.tf"), `variable "foo" {}`)
+	write(filepath.Join(parent, "footer.md"), "footer")
+	write(filepath.Join(outside, "deprecations.yml"), "foo: deprecated")
+	write(filepath.Join(outside, "excludes.yml"), "variables: []")
+
+	config := print.DefaultConfig()
+	config.ModuleRoot = dir
+	config.FooterFrom = "../footer.md"
+	config.DeprecationsFrom = filepath.Join(outside, "deprecations.yml")
+	config.Exclude.File = filepath.Join(outside, "excludes.yml")
+	config.VarFileDefaults = []string{"../dev.tfvars", filepath.Join(outside, "prod.tfvars")}
+
+	tests := map[string]struct {
+		change func()
+	}{
+		"RelativeOutsideModuleChanged": {
+			change: func() { write(filepath.Join(parent, "footer.md"), "footer changed") },
+		},
+		"AbsoluteChanged": {
+			change: func() { write(filepath.Join(outside, "deprecations.yml"), "foo: gone") },
+		},
+		"AbsoluteExcludesChanged": {
+			change: func() { write(filepath.Join(outside, "excludes.yml"), "variables: [foo]") },
+		},
+		"MissingRelativeCreated": {
+			change: func() { write(filepath.Join(parent, "dev.tfvars"), `foo = "dev"`) },
+		},
+		"MissingAbsoluteCreated": {
+			change: func() { write(filepath.Join(outside, "prod.tfvars"), `foo = "prod"`) },
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			before, err := cacheKey(config, "v1.0.0")
+			assert.Nil(err)
+
+			tt.change()
+
+			after, err := cacheKey(config, "v1.0.0")
+			assert.Nil(err)
+			assert.NotEqual(before, after)
+		})
+	}
+}
+
+func TestIsCacheable(t *testing.T) {
+	tests := map[string]struct {
+		config   func(c *print.Config)
+		expected bool
+	}{
+		"Enabled": {
+			config:   func(c *print.Config) {},
+			expected: true,
+		},
+		"Disabled": {
+			config:   func(c *print.Config) { c.Cache.Enabled = false },
+			expected: false,
+		},
+		"LibraryDefault": {
+			config:   func(c *print.Config) { c.Cache = print.DefaultConfig().Cache },
+			expected: false,
+		},
+		"ExamplePlan": {
+			config:   func(c *print.Config) { c.ExamplePlan.Enabled = true },
+			expected: false,
+		},
+		"ExampleOutputs": {
+			config:   func(c *print.Config) { c.Settings.ExampleOutputs = true },
+			expected: false,
+		},
+		"ExamplesRunner": {
+			config:   func(c *print.Config) { c.Settings.ExamplesRunner = true },
+			expected: false,
+		},
+		"InputHistory": {
+			config:   func(c *print.Config) { c.Settings.InputHistory = true },
+			expected: false,
+		},
+		"OutputConsumers": {
+			config:   func(c *print.Config) { c.Settings.OutputConsumers = true },
+			expected: false,
+		},
+		"ModuleCallGraph": {
+			config:   func(c *print.Config) { c.Settings.ModuleCallGraph = true },
+			expected: false,
+		},
+		"OutputValues": {
+			config:   func(c *print.Config) { c.OutputValues.Enabled = true },
+			expected: false,
+		},
+		"CostEstimate": {
+			config:   func(c *print.Config) { c.Settings.CostEstimate = true },
+			expected: false,
+		},
+		"TfsecResults": {
+			config:   func(c *print.Config) { c.Settings.TfsecResults = true },
+			expected: false,
+		},
+		"RunTests": {
+			config:   func(c *print.Config) { c.Settings.RunTests = true },
+			expected: false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.DefaultConfig()
+			config.Cache.Enabled = true
+			tt.config(config)
+
+			assert.Equal(tt.expected, isCacheable(config))
+		})
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"regexp"
+	"strings"
+)
+
+// changelogFile is the name of the file changelog of the module is read from.
+const changelogFile = "CHANGELOG.md"
+
+// changelogVersion matches the level 2 heading of a version, e.g. '## v1.2.0'
+// or '## [1.2.0] - 2021-01-01'.
+var changelogVersion = regexp.MustCompile(`^##\s+\S`)
+
+// parseChangelog returns the version sections of the changelog 'content',
+// without its title and preamble, limited to the most recent 'versions' of
+// them (or all of them if 'versions' is 0). The content is returned as is if
+// it doesn't have any version section.
+func parseChangelog(content string, versions int) string {
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+
+	headings := []int{}
+	fenced := false
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			fenced = !fenced
+			continue
+		}
+		if !fenced && changelogVersion.MatchString(line) {
+			headings = append(headings, i)
+		}
+	}
+
+	if len(headings) == 0 {
+		return strings.TrimSpace(content)
+	}
+
+	end := len(lines)
+	if versions > 0 && versions < len(headings) {
+		end = headings[versions]
+	}
+
+	return strings.TrimSpace(strings.Join(lines[headings[0]:end], "\n"))
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseChangelog(t *testing.T) {
+	content := "# Changelog\n\nPreamble.\n\n## v1.1.0\n\n### Fixed\n\n- bar\n\n```md\n## not a version\n```\n\n## [1.0.0] - 2021-01-01\n\n- foo\n"
+
+	tests := map[string]struct {
+		content  string
+		versions int
+		expected string
+	}{
+		"Empty": {
+			content:  "",
+			versions: 0,
+			expected: "",
+		},
+		"AllVersions": {
+			content:  content,
+			versions: 0,
+			expected: "## v1.1.0\n\n### Fixed\n\n- bar\n\n```md\n## not a version\n```\n\n## [1.0.0] - 2021-01-01\n\n- foo",
+		},
+		"LimitedVersions": {
+			content:  content,
+			versions: 1,
+			expected: "## v1.1.0\n\n### Fixed\n\n- bar\n\n```md\n## not a version\n```",
+		},
+		"MoreVersions": {
+			content:  content,
+			versions: 5,
+			expected: "## v1.1.0\n\n### Fixed\n\n- bar\n\n```md\n## not a version\n```\n\n## [1.0.0] - 2021-01-01\n\n- foo",
+		},
+		"CRLF": {
+			content:  "# Changelog\r\n\r\n## v1.0.0\r\n\r\n- foo\r\n",
+			versions: 0,
+			expected: "## v1.0.0\n\n- foo",
+		},
+		"NoVersions": {
+			content:  "\nSome notes.\n",
+			versions: 1,
+			expected: "Some notes.",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := parseChangelog(tt.content, tt.versions)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+// Check represents an 'assert' of a 'check' block of Terraform module (e.g.
+// available in Terraform 1.5+). A 'check' block with multiple 'assert' blocks
+// results in multiple Check with the same name.
+type Check struct {
+	Name         string       `json:"name" toml:"name" xml:"name" yaml:"name"`
+	Condition    types.String `json:"condition" toml:"condition" xml:"condition" yaml:"condition"`
+	ErrorMessage types.String `json:"error_message" toml:"error_message" xml:"error_message" yaml:"error_message"`
+	Position     Position     `json:"-" toml:"-" xml:"-" yaml:"-"`
+}
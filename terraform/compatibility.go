@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"sort"
+
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+// Compatibility represents a tested combination of Terraform and provider
+// versions, as declared in 'compatibility_matrix.yml' of Terraform module.
+type Compatibility struct {
+	Terraform types.String             `json:"terraform" toml:"terraform" xml:"terraform" yaml:"terraform"`
+	Providers []*CompatibilityProvider `json:"providers" toml:"providers" xml:"providers>provider" yaml:"providers"`
+}
+
+// CompatibilityProvider represents a provider version of a tested combination.
+type CompatibilityProvider struct {
+	Name    string       `json:"name" toml:"name" xml:"name" yaml:"name"`
+	Version types.String `json:"version" toml:"version" xml:"version" yaml:"version"`
+}
+
+// ProviderVersion returns the version of provider with given 'name' in this
+// combination, or an empty string if the provider is not part of it.
+func (c *Compatibility) ProviderVersion(name string) string {
+	for _, p := range c.Providers {
+		if p.Name == name {
+			return string(p.Version)
+		}
+	}
+	return ""
+}
+
+type compatibilities []*Compatibility
+
+// providerNames returns sorted list of all the provider names found in any
+// of the combinations.
+func (cc compatibilities) providerNames() []string {
+	seen := make(map[string]bool)
+	names := make([]string, 0)
+
+	for _, c := range cc {
+		for _, p := range c.Providers {
+			if !seen[p.Name] {
+				seen[p.Name] = true
+				names = append(names, p.Name)
+			}
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// currencySymbols contains the symbol of the common currencies supported by
+// Infracost, the ones not listed here are rendered with their ISO 4217 code.
+var currencySymbols = map[string]string{
+	"AUD": "A$",
+	"CAD": "C$",
+	"CNY": "¥",
+	"EUR": "€",
+	"GBP": "£",
+	"INR": "₹",
+	"JPY": "¥",
+	"USD": "$",
+}
+
+// CostEstimate represents the estimated monthly cost of the resources of the
+// module reported by 'infracost diff'.
+type CostEstimate struct {
+	Currency             string `json:"currency" toml:"currency" xml:"currency" yaml:"currency"`
+	TotalMonthlyCost     string `json:"total_monthly_cost" toml:"total_monthly_cost" xml:"total_monthly_cost" yaml:"total_monthly_cost"`
+	DiffTotalMonthlyCost string `json:"diff_total_monthly_cost" toml:"diff_total_monthly_cost" xml:"diff_total_monthly_cost" yaml:"diff_total_monthly_cost"`
+}
+
+// Callout returns the estimated total monthly cost as one line callout, e.g.
+// "💰 Estimated cost: $12.34/month".
+func (c *CostEstimate) Callout() string {
+	return fmt.Sprintf("💰 Estimated cost: %s/month", formatCost(c.Currency, c.TotalMonthlyCost))
+}
+
+// formatCost returns the amount rounded to two decimal places, prefixed with
+// the symbol (or the code) of its currency.
+func formatCost(currency string, amount string) string {
+	value, err := strconv.ParseFloat(amount, 64)
+	if err != nil {
+		value = 0
+	}
+	if symbol, ok := currencySymbols[currency]; ok {
+		return fmt.Sprintf("%s%.2f", symbol, value)
+	}
+	if currency == "" {
+		return fmt.Sprintf("%.2f", value)
+	}
+	return fmt.Sprintf("%s %.2f", currency, value)
+}
+
+// parseCostEstimate reads the output of 'infracost diff --format json' and
+// returns the estimated monthly cost of the module. The costs are reported as
+// 'null' by Infracost if the module doesn't have any priced resources, which
+// are considered to be zero.
+func parseCostEstimate(content []byte) (*CostEstimate, error) {
+	type breakdown struct {
+		Currency             string  `json:"currency"`
+		TotalMonthlyCost     *string `json:"totalMonthlyCost"`
+		DiffTotalMonthlyCost *string `json:"diffTotalMonthlyCost"`
+	}
+	var b breakdown
+
+	if err := json.Unmarshal(content, &b); err != nil {
+		return nil, fmt.Errorf("unable to decode cost estimate, %w", err)
+	}
+
+	cost := func(s *string) string {
+		if s == nil || *s == "" {
+			return "0"
+		}
+		return *s
+	}
+
+	return &CostEstimate{
+		Currency:             b.Currency,
+		TotalMonthlyCost:     cost(b.TotalMonthlyCost),
+		DiffTotalMonthlyCost: cost(b.DiffTotalMonthlyCost),
+	}, nil
+}
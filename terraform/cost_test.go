@@ -0,0 +1,115 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCostEstimate(t *testing.T) {
+	tests := map[string]struct {
+		content  func() []byte
+		expected *CostEstimate
+		wantErr  bool
+	}{
+		"FromFile": {
+			content: func() []byte {
+				content, _ := ioutil.ReadFile(filepath.Join("testdata", "cost-estimate", "infracost.json"))
+				return content
+			},
+			expected: &CostEstimate{
+				Currency:             "EUR",
+				TotalMonthlyCost:     "42.8510",
+				DiffTotalMonthlyCost: "42.8510",
+			},
+			wantErr: false,
+		},
+		"NoCosts": {
+			content: func() []byte {
+				return []byte(`{"currency": "USD", "totalMonthlyCost": null, "diffTotalMonthlyCost": null}`)
+			},
+			expected: &CostEstimate{
+				Currency:             "USD",
+				TotalMonthlyCost:     "0",
+				DiffTotalMonthlyCost: "0",
+			},
+			wantErr: false,
+		},
+		"Invalid": {
+			content: func() []byte {
+				return []byte(`not a json`)
+			},
+			expected: nil,
+			wantErr:  true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual, err := parseCostEstimate(tt.content())
+
+			if tt.wantErr {
+				assert.NotNil(err)
+			} else {
+				assert.Nil(err)
+			}
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestCostEstimateCallout(t *testing.T) {
+	tests := map[string]struct {
+		currency string
+		amount   string
+		expected string
+	}{
+		"USD": {
+			currency: "USD",
+			amount:   "12.3456",
+			expected: "💰 Estimated cost: $12.35/month",
+		},
+		"EUR": {
+			currency: "EUR",
+			amount:   "42.8510",
+			expected: "💰 Estimated cost: €42.85/month",
+		},
+		"UnknownSymbol": {
+			currency: "CHF",
+			amount:   "7",
+			expected: "💰 Estimated cost: CHF 7.00/month",
+		},
+		"NoCurrency": {
+			currency: "",
+			amount:   "7",
+			expected: "💰 Estimated cost: 7.00/month",
+		},
+		"Zero": {
+			currency: "USD",
+			amount:   "0",
+			expected: "💰 Estimated cost: $0.00/month",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			cost := &CostEstimate{Currency: tt.currency, TotalMonthlyCost: tt.amount}
+
+			assert.Equal(tt.expected, cost.Callout())
+		})
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// Kinds of the default value of inputs as declared in their 'variable' block.
+const (
+	DefaultKindLiteral    = "literal"
+	DefaultKindExpression = "expression"
+	DefaultKindNull       = "null"
+)
+
+// defaultKind returns the kind of the 'default' attribute of a variable. Only
+// constants (i.e. numbers, strings, booleans and collections of them) are
+// literal, anything else such as references, function calls or interpolations
+// is an expression.
+func defaultKind(expr hcl.Expression) string {
+	if value, ok := expr.(*hclsyntax.LiteralValueExpr); ok && value.Val.IsNull() {
+		return DefaultKindNull
+	}
+	if _, ok := expr.(hclsyntax.Expression); !ok {
+		if value, diags := expr.Value(nil); !diags.HasErrors() && value.IsNull() {
+			return DefaultKindNull
+		}
+	}
+	if isLiteralExpr(expr) {
+		return DefaultKindLiteral
+	}
+	return DefaultKindExpression
+}
+
+// isLiteralExpr reports whether 'expr' is a constant, which doesn't depend on
+// anything to be evaluated.
+func isLiteralExpr(expr hcl.Expression) bool {
+	switch e := expr.(type) {
+	case *hclsyntax.LiteralValueExpr:
+		return true
+	case *hclsyntax.TemplateExpr:
+		return e.IsStringLiteral()
+	case *hclsyntax.ParenthesesExpr:
+		return isLiteralExpr(e.Expression)
+	case *hclsyntax.UnaryOpExpr:
+		return isLiteralExpr(e.Val) // e.g. negative numbers
+	case *hclsyntax.TupleConsExpr:
+		for _, item := range e.Exprs {
+			if !isLiteralExpr(item) {
+				return false
+			}
+		}
+		return true
+	case *hclsyntax.ObjectConsExpr:
+		for _, item := range e.Items {
+			if !isLiteralExpr(item.KeyExpr) || !isLiteralExpr(item.ValueExpr) {
+				return false
+			}
+		}
+		return true
+	case *hclsyntax.ObjectConsKeyExpr:
+		if !e.ForceNonLiteral && hcl.ExprAsKeyword(e.Wrapped) != "" {
+			return true // bare keys, e.g. '{ foo = "bar" }'
+		}
+		return isLiteralExpr(e.Wrapped)
+	case hclsyntax.Expression:
+		return false
+	}
+	// the expressions of JSON files (i.e. '*.tf.json') are literal if they can
+	// be evaluated without any variables and functions
+	_, diags := expr.Value(nil)
+	return !diags.HasErrors()
+}
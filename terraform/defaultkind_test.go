@@ -0,0 +1,94 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultKind(t *testing.T) {
+	tests := map[string]struct {
+		expr     string
+		expected string
+	}{
+		"Null": {
+			expr:     `null`,
+			expected: DefaultKindNull,
+		},
+		"String": {
+			expr:     `"foo"`,
+			expected: DefaultKindLiteral,
+		},
+		"Number": {
+			expr:     `42`,
+			expected: DefaultKindLiteral,
+		},
+		"NegativeNumber": {
+			expr:     `-1`,
+			expected: DefaultKindLiteral,
+		},
+		"Bool": {
+			expr:     `true`,
+			expected: DefaultKindLiteral,
+		},
+		"EmptyList": {
+			expr:     `[]`,
+			expected: DefaultKindLiteral,
+		},
+		"EmptyMap": {
+			expr:     `{}`,
+			expected: DefaultKindLiteral,
+		},
+		"List": {
+			expr:     `["a", "b"]`,
+			expected: DefaultKindLiteral,
+		},
+		"Map": {
+			expr:     `{ foo = "bar", "baz" = [1, 2] }`,
+			expected: DefaultKindLiteral,
+		},
+		"Reference": {
+			expr:     `var.foo`,
+			expected: DefaultKindExpression,
+		},
+		"FunctionCall": {
+			expr:     `upper("foo")`,
+			expected: DefaultKindExpression,
+		},
+		"Interpolation": {
+			expr:     `"foo-${local.bar}"`,
+			expected: DefaultKindExpression,
+		},
+		"ListWithReference": {
+			expr:     `["a", local.b]`,
+			expected: DefaultKindExpression,
+		},
+		"MapWithComputedKey": {
+			expr:     `{ (local.key) = "bar" }`,
+			expected: DefaultKindExpression,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			expr, diags := hclsyntax.ParseExpression([]byte(tt.expr), "variables.tf", hcl.InitialPos)
+			assert.False(diags.HasErrors())
+
+			actual := defaultKind(expr)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
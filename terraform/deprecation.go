@@ -0,0 +1,67 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"regexp"
+	"strings"
+)
+
+// deprecationHeading matches the level 2 heading of a deprecation, which is
+// the name of the input followed by its deprecation message, e.g.
+//
+//	## var_name: Use var_new_name instead.
+var deprecationHeading = regexp.MustCompile(`^##\s+([\w-]+)\s*:\s*(.+?)\s*$`)
+
+// deprecationAnnotation matches the deprecation annotation in the comment of
+// a block, followed by its optional deprecation message, e.g.
+//
+//	# @deprecated Use module.new_module.output_name instead
+var deprecationAnnotation = regexp.MustCompile(`^@deprecated(?:\s+(.*?))?\s*$`)
+
+// defaultDeprecationMessage is used if the deprecation annotation doesn't have
+// any message.
+const defaultDeprecationMessage = "This output is deprecated."
+
+// parseDeprecations returns the deprecation messages of the inputs by their
+// name from the 'content' of deprecations file (e.g. DEPRECATIONS.md). Lines
+// other than deprecation headings are ignored.
+func parseDeprecations(content string) map[string]string {
+	deprecations := make(map[string]string)
+	for _, line := range strings.Split(content, "\n") {
+		matches := deprecationHeading.FindStringSubmatch(strings.TrimRight(line, "\r"))
+		if matches == nil {
+			continue
+		}
+		deprecations[matches[1]] = matches[2]
+	}
+	return deprecations
+}
+
+// parseDeprecationComment returns the deprecation message of the annotation
+// in the 'lines' of a comment (the last one wins if there are multiple), and
+// the rest of the lines without the annotation.
+func parseDeprecationComment(lines []string) (string, []string) {
+	var message string
+	rest := make([]string, 0, len(lines))
+	for _, line := range lines {
+		matches := deprecationAnnotation.FindStringSubmatch(line)
+		if matches == nil {
+			rest = append(rest, line)
+			continue
+		}
+		message = matches[1]
+		if message == "" {
+			message = defaultDeprecationMessage
+		}
+	}
+	return message, rest
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDeprecations(t *testing.T) {
+	tests := map[string]struct {
+		content  string
+		expected map[string]string
+	}{
+		"Empty": {
+			content:  "",
+			expected: map[string]string{},
+		},
+		"Deprecations": {
+			content: "# Deprecations\n\n## var_name: Use var_new_name instead.\n\nMore details.\n\n## var-other : Will be removed.  \n",
+			expected: map[string]string{
+				"var_name":  "Use var_new_name instead.",
+				"var-other": "Will be removed.",
+			},
+		},
+		"CRLF": {
+			content: "## var_name: Use var_new_name instead.\r\n",
+			expected: map[string]string{
+				"var_name": "Use var_new_name instead.",
+			},
+		},
+		"OtherHeadings": {
+			content:  "# var_name: not a level 2 heading\n\n### var_name: not a level 2 heading\n\n## Without message\n\n## var_name:\n",
+			expected: map[string]string{},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := parseDeprecations(tt.content)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestParseDeprecationComment(t *testing.T) {
+	tests := map[string]struct {
+		lines    []string
+		message  string
+		expected []string
+	}{
+		"Empty": {
+			lines:    []string{},
+			message:  "",
+			expected: []string{},
+		},
+		"WithoutAnnotation": {
+			lines:    []string{"The name of the bucket."},
+			message:  "",
+			expected: []string{"The name of the bucket."},
+		},
+		"WithMessage": {
+			lines:    []string{"The name of the bucket.", "@deprecated Use module.new_module.bucket instead  "},
+			message:  "Use module.new_module.bucket instead",
+			expected: []string{"The name of the bucket."},
+		},
+		"WithoutMessage": {
+			lines:    []string{"@deprecated"},
+			message:  "This output is deprecated.",
+			expected: []string{},
+		},
+		"NotAnAnnotation": {
+			lines:    []string{"@deprecatedfoo", "not @deprecated"},
+			message:  "",
+			expected: []string{"@deprecatedfoo", "not @deprecated"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			message, rest := parseDeprecationComment(tt.lines)
+			assert.Equal(tt.message, message)
+			assert.Equal(tt.expected, rest)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"sort"
+
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+// EphemeralResource represents an 'ephemeral' block of the module (available
+// since Terraform 1.10), which is not persisted in plan or state.
+type EphemeralResource struct {
+	Type           string       `json:"type" toml:"type" xml:"type" yaml:"type"`
+	Name           string       `json:"name" toml:"name" xml:"name" yaml:"name"`
+	ProviderName   string       `json:"provider" toml:"provider" xml:"provider" yaml:"provider"`
+	ProviderSource string       `json:"source" toml:"source" xml:"source" yaml:"source"`
+	Version        types.String `json:"version" toml:"version" xml:"version" yaml:"version"`
+	Description    types.String `json:"description" toml:"description" xml:"description" yaml:"description"`
+	Position       Position     `json:"-" toml:"-" xml:"-" yaml:"-"`
+}
+
+// Spec returns the address of the ephemeral resource in the config, in the form
+// of 'ephemeral.resource_type.resource_name'.
+func (r *EphemeralResource) Spec() string {
+	return "ephemeral." + r.ProviderName + "_" + r.Type + "." + r.Name
+}
+
+// URL returns a best guess at the URL for ephemeral resource documentation
+func (r *EphemeralResource) URL() string {
+	return providerDocsURL(r.ProviderSource, string(r.Version), "ephemeral-resources", r.Type)
+}
+
+type ephemeralResources []*EphemeralResource
+
+func (rr ephemeralResources) sort() {
+	// always sort by type, same as resources
+	sort.Slice(rr, func(i, j int) bool {
+		if rr[i].Spec() == rr[j].Spec() {
+			return rr[i].Name < rr[j].Name
+		}
+		return rr[i].Spec() < rr[j].Spec()
+	})
+}
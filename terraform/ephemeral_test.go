@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+func TestEphemeralResourceSpec(t *testing.T) {
+	assert := assert.New(t)
+	resource := EphemeralResource{
+		Type:         "secretsmanager_secret_version",
+		Name:         "db",
+		ProviderName: "aws",
+	}
+	assert.Equal("ephemeral.aws_secretsmanager_secret_version.db", resource.Spec())
+}
+
+func TestEphemeralResourceURL(t *testing.T) {
+	tests := map[string]struct {
+		source   string
+		expected string
+	}{
+		"Registry": {
+			source:   "hashicorp/aws",
+			expected: "https://registry.terraform.io/providers/hashicorp/aws/5.80/docs/ephemeral-resources/secretsmanager_secret_version",
+		},
+		"PrivateRegistry": {
+			source:   "app.terraform.io/acme/aws",
+			expected: "",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			resource := EphemeralResource{
+				Type:           "secretsmanager_secret_version",
+				Name:           "db",
+				ProviderName:   "aws",
+				ProviderSource: tt.source,
+				Version:        types.String("5.80"),
+			}
+			assert.Equal(tt.expected, resource.URL())
+		})
+	}
+}
+
+func TestEphemeralResourcesSort(t *testing.T) {
+	assert := assert.New(t)
+	resources := ephemeralResources{
+		{Type: "password", Name: "b", ProviderName: "random"},
+		{Type: "secretsmanager_secret_version", Name: "a", ProviderName: "aws"},
+		{Type: "password", Name: "a", ProviderName: "random"},
+	}
+
+	resources.sort()
+
+	actual := make([]string, 0, len(resources))
+	for _, r := range resources {
+		actual = append(actual, r.Spec())
+	}
+	assert.Equal([]string{
+		"ephemeral.aws_secretsmanager_secret_version.a",
+		"ephemeral.random_password.a",
+		"ephemeral.random_password.b",
+	}, actual)
+}
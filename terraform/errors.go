@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/terraform-docs/terraform-config-inspect/tfconfig"
+)
+
+// ParseError represents an error of parsing a file of the module, e.g. a syntax
+// error or an unexpected block. 'File' is relative to the module root, 'Line'
+// and 'Column' are zero if position of the error is unknown.
+type ParseError struct {
+	File    string
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e ParseError) Error() string {
+	switch {
+	case e.File == "":
+		return e.Message
+	case e.Line == 0:
+		return fmt.Sprintf("%s: %s", e.File, e.Message)
+	case e.Column == 0:
+		return fmt.Sprintf("%s:%d: %s", e.File, e.Line, e.Message)
+	default:
+		return fmt.Sprintf("%s:%d,%d: %s", e.File, e.Line, e.Column, e.Message)
+	}
+}
+
+// ParseErrors represents all the errors of parsing the files of the module,
+// which are reported together rather than stopping at the first one.
+type ParseErrors []ParseError
+
+func (e ParseErrors) Error() string {
+	if len(e) == 1 {
+		return e[0].Error()
+	}
+	messages := make([]string, 0, len(e))
+	for _, p := range e {
+		messages = append(messages, p.Error())
+	}
+	return fmt.Sprintf("failed to parse module, %d errors:\n%s", len(e), strings.Join(messages, "\n"))
+}
+
+// newParseErrors returns the errors of 'diags' with their file relative to the
+// module 'root', or nil if there's no error (e.g. only warnings).
+func newParseErrors(root string, diags hcl.Diagnostics) error {
+	result := ParseErrors{}
+	for _, diag := range diags {
+		if diag.Severity != hcl.DiagError {
+			continue
+		}
+		p := ParseError{Message: diagMessage(diag.Summary, diag.Detail)}
+		if diag.Subject != nil {
+			p.File = relativeFile(root, diag.Subject.Filename)
+			p.Line = diag.Subject.Start.Line
+			p.Column = diag.Subject.Start.Column
+		}
+		result = append(result, p)
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+// newConfigParseErrors returns the errors of 'diags' of terraform-config-inspect
+// with their file relative to the module 'root', which don't include column.
+func newConfigParseErrors(root string, diags tfconfig.Diagnostics) error {
+	result := ParseErrors{}
+	for _, diag := range diags {
+		if diag.Severity != tfconfig.DiagError {
+			continue
+		}
+		p := ParseError{Message: diagMessage(diag.Summary, diag.Detail)}
+		if diag.Pos != nil {
+			p.File = relativeFile(root, diag.Pos.Filename)
+			p.Line = diag.Pos.Line
+		}
+		result = append(result, p)
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+// wrapParseErrors converts 'err' to ParseErrors if it's 'hcl.Diagnostics', so
+// all the errors of parsing the module are reported with their position.
+func wrapParseErrors(root string, err error) error {
+	var diags hcl.Diagnostics
+	if errors.As(err, &diags) {
+		if perr := newParseErrors(root, diags); perr != nil {
+			return perr
+		}
+	}
+	return err
+}
+
+func diagMessage(summary string, detail string) string {
+	if detail == "" {
+		return summary
+	}
+	return fmt.Sprintf("%s; %s", summary, detail)
+}
+
+func relativeFile(root string, filename string) string {
+	if rel, err := filepath.Rel(root, filename); err == nil && !strings.HasPrefix(rel, "..") {
+		return filepath.ToSlash(rel)
+	}
+	return filename
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-config-inspect/tfconfig"
+)
+
+func TestParseError(t *testing.T) {
+	tests := map[string]struct {
+		err      ParseError
+		expected string
+	}{
+		"Full": {
+			err:      ParseError{File: "main.tf", Line: 3, Column: 5, Message: "Invalid expression"},
+			expected: "main.tf:3,5: Invalid expression",
+		},
+		"NoColumn": {
+			err:      ParseError{File: "main.tf", Line: 3, Column: 0, Message: "Invalid expression"},
+			expected: "main.tf:3: Invalid expression",
+		},
+		"NoLine": {
+			err:      ParseError{File: "main.tf", Line: 0, Column: 0, Message: "Invalid expression"},
+			expected: "main.tf: Invalid expression",
+		},
+		"NoFile": {
+			err:      ParseError{File: "", Line: 0, Column: 0, Message: "Invalid expression"},
+			expected: "Invalid expression",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			assert.Equal(tt.expected, tt.err.Error())
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	single := ParseErrors{
+		{File: "main.tf", Line: 1, Column: 2, Message: "foo"},
+	}
+	assert.Equal("main.tf:1,2: foo", single.Error())
+
+	multiple := ParseErrors{
+		{File: "main.tf", Line: 1, Column: 2, Message: "foo"},
+		{File: "outputs.tf", Line: 3, Column: 4, Message: "bar"},
+	}
+	assert.Equal("failed to parse module, 2 errors:\nmain.tf:1,2: foo\noutputs.tf:3,4: bar", multiple.Error())
+}
+
+func TestNewParseErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	root := filepath.Join("testdata", "module")
+	diags := hcl.Diagnostics{
+		{
+			Severity: hcl.DiagWarning,
+			Summary:  "Deprecated",
+		},
+		{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid expression",
+			Detail:   "Expected the start of an expression.",
+			Subject: &hcl.Range{
+				Filename: filepath.Join(root, "modules", "main.tf"),
+				Start:    hcl.Pos{Line: 6, Column: 13},
+			},
+		},
+		{
+			Severity: hcl.DiagError,
+			Summary:  "Failed to read file",
+		},
+	}
+
+	err := newParseErrors(root, diags)
+	assert.Equal(ParseErrors{
+		{File: "modules/main.tf", Line: 6, Column: 13, Message: "Invalid expression; Expected the start of an expression."},
+		{File: "", Line: 0, Column: 0, Message: "Failed to read file"},
+	}, err)
+
+	assert.Nil(newParseErrors(root, diags[:1]))
+}
+
+func TestNewConfigParseErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	root := filepath.Join("testdata", "module")
+	diags := tfconfig.Diagnostics{
+		{
+			Severity: tfconfig.DiagError,
+			Summary:  "Duplicate variable",
+			Pos:      &tfconfig.SourcePos{Filename: filepath.Join(root, "variables.tf"), Line: 5},
+		},
+	}
+
+	err := newConfigParseErrors(root, diags)
+	assert.Equal(ParseErrors{
+		{File: "variables.tf", Line: 5, Column: 0, Message: "Duplicate variable"},
+	}, err)
+}
+
+func TestWrapParseErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	other := errors.New("foo")
+	assert.Equal(other, wrapParseErrors("", other))
+
+	diags := hcl.Diagnostics{
+		{Severity: hcl.DiagError, Summary: "Unsupported block type"},
+	}
+	assert.Equal(ParseErrors{{Message: "Unsupported block type"}}, wrapParseErrors("", diags))
+}
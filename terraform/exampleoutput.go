@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// ExampleOutput represents value of an output of an example of the module (i.e.
+// folder in 'examples'), as reported by 'terraform output -json' of the example.
+type ExampleOutput struct {
+	Example   string      `json:"example" toml:"example" xml:"example" yaml:"example"`
+	Name      string      `json:"name" toml:"name" xml:"name" yaml:"name"`
+	Value     interface{} `json:"value,omitempty" toml:"value,omitempty" xml:"-" yaml:"value,omitempty"`
+	Sensitive bool        `json:"sensitive" toml:"sensitive" xml:"sensitive" yaml:"sensitive"`
+}
+
+// GetValue returns JSON representation of the 'Value', similar to Output.GetValue.
+// Sensitive values are never returned.
+func (o *ExampleOutput) GetValue() string {
+	if o.Sensitive || o.Value == nil {
+		return ""
+	}
+	marshaled, err := json.MarshalIndent(o.Value, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	value := string(marshaled)
+	if value == `null` {
+		return ""
+	}
+	return value
+}
+
+// parseExampleOutputs reads the output of 'terraform output -json' of 'example'
+// and returns its outputs sorted by name. Values of sensitive outputs are dropped.
+func parseExampleOutputs(example string, content []byte) ([]*ExampleOutput, error) {
+	var outputs map[string]*output
+	if err := json.Unmarshal(content, &outputs); err != nil {
+		return nil, fmt.Errorf("unable to decode outputs of example '%s', %w", example, err)
+	}
+
+	items := make([]*ExampleOutput, 0, len(outputs))
+	for name, o := range outputs {
+		item := &ExampleOutput{
+			Example:   example,
+			Name:      name,
+			Sensitive: o.Sensitive,
+		}
+		if !o.Sensitive {
+			item.Value = o.Value
+		}
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Name < items[j].Name
+	})
+
+	return items, nil
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseExampleOutputs(t *testing.T) {
+	tests := map[string]struct {
+		content  string
+		expected []*ExampleOutput
+		wantErr  bool
+	}{
+		"Empty": {
+			content:  "{}",
+			expected: []*ExampleOutput{},
+			wantErr:  false,
+		},
+		"Outputs": {
+			content: `{
+				"vpc_id": {"sensitive": false, "type": "string", "value": "vpc-0123"},
+				"password": {"sensitive": true, "type": "string", "value": "secret"},
+				"subnets": {"sensitive": false, "type": ["list", "string"], "value": ["subnet-1"]}
+			}`,
+			expected: []*ExampleOutput{
+				{Example: "complete", Name: "password", Sensitive: true},
+				{Example: "complete", Name: "subnets", Value: []interface{}{"subnet-1"}},
+				{Example: "complete", Name: "vpc_id", Value: "vpc-0123"},
+			},
+			wantErr: false,
+		},
+		"Invalid": {
+			content:  "not json",
+			expected: nil,
+			wantErr:  true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual, err := parseExampleOutputs("complete", []byte(tt.content))
+			if tt.wantErr {
+				assert.NotNil(err)
+			} else {
+				assert.Nil(err)
+				assert.Equal(tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestExampleOutputValue(t *testing.T) {
+	tests := map[string]struct {
+		output   *ExampleOutput
+		expected string
+	}{
+		"String": {
+			output:   &ExampleOutput{Name: "vpc_id", Value: "vpc-0123"},
+			expected: `"vpc-0123"`,
+		},
+		"List": {
+			output:   &ExampleOutput{Name: "subnets", Value: []interface{}{"subnet-1", "subnet-2"}},
+			expected: "[\n  \"subnet-1\",\n  \"subnet-2\"\n]",
+		},
+		"Nil": {
+			output:   &ExampleOutput{Name: "empty"},
+			expected: "",
+		},
+		"Sensitive": {
+			output:   &ExampleOutput{Name: "password", Value: "secret", Sensitive: true},
+			expected: "",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, tt.output.GetValue())
+		})
+	}
+}
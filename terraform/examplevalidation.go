@@ -0,0 +1,42 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ExampleValidation represents the result of 'terraform validate' of an example
+// of the module (i.e. folder in 'examples').
+type ExampleValidation struct {
+	Example    string `json:"example" toml:"example" xml:"example" yaml:"example"`
+	Valid      bool   `json:"valid" toml:"valid" xml:"valid" yaml:"valid"`
+	ErrorCount int    `json:"error_count" toml:"error_count" xml:"error_count" yaml:"error_count"`
+}
+
+// parseExampleValidation reads the output of 'terraform validate -json' of
+// 'example'. Only the validity and number of errors are kept, the diagnostics
+// themselves are never included.
+func parseExampleValidation(example string, content []byte) (*ExampleValidation, error) {
+	var result struct {
+		Valid      bool `json:"valid"`
+		ErrorCount int  `json:"error_count"`
+	}
+	if err := json.Unmarshal(content, &result); err != nil {
+		return nil, fmt.Errorf("unable to decode validation of example '%s', %w", example, err)
+	}
+	return &ExampleValidation{
+		Example:    example,
+		Valid:      result.Valid,
+		ErrorCount: result.ErrorCount,
+	}, nil
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseExampleValidation(t *testing.T) {
+	tests := map[string]struct {
+		content  string
+		expected *ExampleValidation
+		wantErr  bool
+	}{
+		"Valid": {
+			content: `{
+				"format_version": "1.0",
+				"valid": true,
+				"error_count": 0,
+				"warning_count": 0,
+				"diagnostics": []
+			}`,
+			expected: &ExampleValidation{Example: "complete", Valid: true, ErrorCount: 0},
+			wantErr:  false,
+		},
+		"Invalid": {
+			content: `{
+				"format_version": "1.0",
+				"valid": false,
+				"error_count": 2,
+				"warning_count": 1,
+				"diagnostics": [{"severity": "error", "summary": "Missing required argument"}]
+			}`,
+			expected: &ExampleValidation{Example: "complete", Valid: false, ErrorCount: 2},
+			wantErr:  false,
+		},
+		"NotJSON": {
+			content:  "not json",
+			expected: nil,
+			wantErr:  true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual, err := parseExampleValidation("complete", []byte(tt.content))
+			if tt.wantErr {
+				assert.NotNil(err)
+			} else {
+				assert.Nil(err)
+				assert.Equal(tt.expected, actual)
+			}
+		})
+	}
+}
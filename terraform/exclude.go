@@ -0,0 +1,78 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"fmt"
+	"path"
+
+	"gopkg.in/yaml.v3"
+)
+
+// excludes represents the glob patterns (e.g. 'secret_*') of names of the
+// inputs and outputs to exclude from the module, as read from CLI flags and
+// the file of '--exclude-file'.
+type excludes struct {
+	Variables []string `yaml:"variables"`
+	Outputs   []string `yaml:"outputs"`
+}
+
+// parseExcludes reads the patterns of the file of '--exclude-file', which is
+// a YAML document with 'variables' and 'outputs' lists.
+func parseExcludes(content []byte) (*excludes, error) {
+	e := &excludes{}
+	if err := yaml.Unmarshal(content, e); err != nil {
+		return nil, fmt.Errorf("unable to decode exclude file, %w", err)
+	}
+	for _, pattern := range append(append([]string{}, e.Variables...), e.Outputs...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid pattern of exclude file", pattern)
+		}
+	}
+	return e, nil
+}
+
+// isExcluded indicates if the 'name' matches any of the 'patterns'. Patterns
+// are validated beforehand, so errors of malformed ones are ignored here.
+func isExcluded(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *excludes) inputs(items []*Input) []*Input {
+	if len(e.Variables) == 0 {
+		return items
+	}
+	result := make([]*Input, 0, len(items))
+	for _, item := range items {
+		if !isExcluded(item.Name, e.Variables) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func (e *excludes) outputs(items []*Output) []*Output {
+	if len(e.Outputs) == 0 {
+		return items
+	}
+	result := make([]*Output, 0, len(items))
+	for _, item := range items {
+		if !isExcluded(item.Name, e.Outputs) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
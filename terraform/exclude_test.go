@@ -0,0 +1,120 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsExcluded(t *testing.T) {
+	tests := map[string]struct {
+		name     string
+		patterns []string
+		expected bool
+	}{
+		"NoPatterns": {
+			name:     "db_password",
+			patterns: []string{},
+			expected: false,
+		},
+		"ExactName": {
+			name:     "db_password",
+			patterns: []string{"db_password"},
+			expected: true,
+		},
+		"ExactNameMismatch": {
+			name:     "db_password_rotation",
+			patterns: []string{"db_password"},
+			expected: false,
+		},
+		"WildcardPrefix": {
+			name:     "api_token",
+			patterns: []string{"*_token"},
+			expected: true,
+		},
+		"WildcardPrefixMismatch": {
+			name:     "token_ttl",
+			patterns: []string{"*_token"},
+			expected: false,
+		},
+		"WildcardSuffix": {
+			name:     "secret_key",
+			patterns: []string{"secret_*"},
+			expected: true,
+		},
+		"WildcardSuffixMismatch": {
+			name:     "no_secret",
+			patterns: []string{"secret_*"},
+			expected: false,
+		},
+		"AnyPattern": {
+			name:     "db_password",
+			patterns: []string{"secret_*", "*_password"},
+			expected: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := isExcluded(tt.name, tt.patterns)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestParseExcludes(t *testing.T) {
+	tests := map[string]struct {
+		content  string
+		expected *excludes
+		wantErr  bool
+	}{
+		"Empty": {
+			content:  "",
+			expected: &excludes{},
+			wantErr:  false,
+		},
+		"Patterns": {
+			content: "variables:\n  - \"*_token\"\n  - db_password\noutputs:\n  - private_*\n",
+			expected: &excludes{
+				Variables: []string{"*_token", "db_password"},
+				Outputs:   []string{"private_*"},
+			},
+			wantErr: false,
+		},
+		"InvalidYAML": {
+			content:  "variables: [",
+			expected: nil,
+			wantErr:  true,
+		},
+		"InvalidPattern": {
+			content:  "outputs:\n  - \"[private\"\n",
+			expected: nil,
+			wantErr:  true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual, err := parseExcludes([]byte(tt.content))
+
+			if tt.wantErr {
+				assert.NotNil(err)
+			} else {
+				assert.Nil(err)
+			}
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
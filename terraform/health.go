@@ -0,0 +1,104 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"math"
+	"strings"
+
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+// HealthScore represents the documentation completeness of Terraform module as
+// percentage, from 0 (nothing documented) to 100 (everything documented).
+type HealthScore struct {
+	Score int `json:"score" toml:"score" xml:"score" yaml:"score"`
+}
+
+// NewHealthScore returns HealthScore of the given module, which is the weighted
+// average of the fraction of inputs and outputs with description, whether the
+// header exists and the fraction of requirements pinned to a version. Weights
+// are read from 'health-score.weights' of the config.
+func NewHealthScore(config *print.Config, module *Module) *HealthScore {
+	weights := config.HealthScore.Weights
+	checks := []struct {
+		weight int
+		value  float64
+	}{
+		{weights.Inputs, documentedInputs(module.Inputs)},
+		{weights.Outputs, documentedOutputs(module.Outputs)},
+		{weights.Header, boolToFloat(strings.TrimSpace(module.Header) != "")},
+		{weights.Requirements, pinnedRequirements(module.Requirements)},
+	}
+
+	total, score := 0, 0.0
+	for _, check := range checks {
+		total += check.weight
+		score += float64(check.weight) * check.value
+	}
+	if total == 0 {
+		return &HealthScore{Score: 0}
+	}
+	return &HealthScore{Score: int(math.Round(score * 100 / float64(total)))}
+}
+
+// documentedInputs returns the fraction of inputs with description, a module
+// without any input is fully documented.
+func documentedInputs(inputs []*Input) float64 {
+	if len(inputs) == 0 {
+		return 1
+	}
+	documented := 0
+	for _, input := range inputs {
+		if strings.TrimSpace(string(input.Description)) != "" {
+			documented++
+		}
+	}
+	return float64(documented) / float64(len(inputs))
+}
+
+// documentedOutputs returns the fraction of outputs with description, a module
+// without any output is fully documented.
+func documentedOutputs(outputs []*Output) float64 {
+	if len(outputs) == 0 {
+		return 1
+	}
+	documented := 0
+	for _, output := range outputs {
+		if strings.TrimSpace(string(output.Description)) != "" {
+			documented++
+		}
+	}
+	return float64(documented) / float64(len(outputs))
+}
+
+// pinnedRequirements returns the fraction of requirements (including Terraform
+// itself) with a version constraint, a module without any requirement is not
+// pinned at all.
+func pinnedRequirements(requirements []*Requirement) float64 {
+	if len(requirements) == 0 {
+		return 0
+	}
+	pinned := 0
+	for _, requirement := range requirements {
+		if requirement.Version != "" {
+			pinned++
+		}
+	}
+	return float64(pinned) / float64(len(requirements))
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+func TestNewHealthScore(t *testing.T) {
+	tests := map[string]struct {
+		module   *Module
+		weights  func(c *print.Config)
+		expected int
+	}{
+		"Empty": {
+			module:   &Module{},
+			expected: 70,
+		},
+		"FullyDocumented": {
+			module: &Module{
+				Header:       "Usage of the module.",
+				Inputs:       []*Input{{Name: "a", Description: "a"}},
+				Outputs:      []*Output{{Name: "b", Description: "b"}},
+				Requirements: []*Requirement{{Name: "terraform", Version: ">= 1.0"}},
+			},
+			expected: 100,
+		},
+		"PartiallyDocumented": {
+			module: &Module{
+				Header:       " ",
+				Inputs:       []*Input{{Name: "a", Description: "a"}, {Name: "b", Description: " "}},
+				Outputs:      []*Output{{Name: "c", Description: "c"}, {Name: "d"}, {Name: "e"}},
+				Requirements: []*Requirement{{Name: "terraform", Version: ">= 1.0"}, {Name: "aws"}},
+			},
+			expected: 40, // 40 * 1/2 + 30 * 1/3 + 0 + 20 * 1/2
+		},
+		"CustomWeights": {
+			module: &Module{
+				Header:  "Usage of the module.",
+				Inputs:  []*Input{{Name: "a"}},
+				Outputs: []*Output{{Name: "b"}},
+			},
+			weights: func(c *print.Config) {
+				c.HealthScore.Weights.Inputs = 1
+				c.HealthScore.Weights.Outputs = 0
+				c.HealthScore.Weights.Header = 3
+				c.HealthScore.Weights.Requirements = 0
+			},
+			expected: 75,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.DefaultConfig()
+			if tt.weights != nil {
+				tt.weights(config)
+			}
+
+			assert.Equal(&HealthScore{Score: tt.expected}, NewHealthScore(config, tt.module))
+		})
+	}
+}
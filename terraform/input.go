@@ -13,6 +13,7 @@ package terraform
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"sort"
 	"strings"
 
@@ -22,27 +23,28 @@ import (
 
 // Input represents a Terraform input.
 type Input struct {
-	Name        string       `json:"name" toml:"name" xml:"name" yaml:"name"`
-	Type        types.String `json:"type" toml:"type" xml:"type" yaml:"type"`
-	Description types.String `json:"description" toml:"description" xml:"description" yaml:"description"`
-	Default     types.Value  `json:"default" toml:"default" xml:"default" yaml:"default"`
-	Required    bool         `json:"required" toml:"required" xml:"required" yaml:"required"`
-	Position    Position     `json:"-" toml:"-" xml:"-" yaml:"-"`
+	Name               string               `json:"name" toml:"name" xml:"name" yaml:"name"`
+	Type               types.String         `json:"type" toml:"type" xml:"type" yaml:"type"`
+	Description        types.String         `json:"description" toml:"description" xml:"description" yaml:"description"`
+	Default            types.Value          `json:"default" toml:"default" xml:"default" yaml:"default"`
+	DefaultKind        string               `json:"default_kind,omitempty" toml:"default_kind,omitempty" xml:"default_kind,omitempty" yaml:"default_kind,omitempty"`
+	EnvDefault         types.Value          `json:"env_default,omitempty" toml:"env_default,omitempty" xml:"env_default,omitempty" yaml:"env_default,omitempty"`
+	Required           bool                 `json:"required" toml:"required" xml:"required" yaml:"required"`
+	Sensitive          bool                 `json:"sensitive,omitempty" toml:"sensitive,omitempty" xml:"sensitive,omitempty" yaml:"sensitive,omitempty"`
+	Nullable           *bool                `json:"nullable,omitempty" toml:"nullable,omitempty" xml:"nullable,omitempty" yaml:"nullable,omitempty"`
+	Deprecated         string               `json:"deprecated,omitempty" toml:"deprecated,omitempty" xml:"deprecated,omitempty" yaml:"deprecated,omitempty"`
+	Cloud              string               `json:"cloud,omitempty" toml:"cloud,omitempty" xml:"cloud,omitempty" yaml:"cloud,omitempty"`
+	Validations        []*Condition         `json:"validations,omitempty" toml:"validations,omitempty" xml:"-" yaml:"validations,omitempty"`
+	DescriptionHistory []*DescriptionChange `json:"description_history,omitempty" toml:"description_history,omitempty" xml:"-" yaml:"description_history,omitempty"`
+	Position           Position             `json:"-" toml:"-" xml:"-" yaml:"-"`
 }
 
 // GetValue returns JSON representation of the 'Default' value, which is an 'interface'.
 // If 'Default' is a primitive type, the primitive value of 'Default' will be returned
-// and not the JSON formatted of it.
+// and not the JSON formatted of it. If 'Default' has no JSON representation (e.g.
+// infinite number) its raw value is returned as is.
 func (i *Input) GetValue() string {
-	var buf bytes.Buffer
-	encoder := json.NewEncoder(&buf)
-	encoder.SetIndent("", "  ")
-	encoder.SetEscapeHTML(false)
-	err := encoder.Encode(i.Default)
-	if err != nil {
-		panic(err)
-	}
-	value := strings.TrimSpace(buf.String())
+	value := jsonValue(i.Default)
 	if value == `null` {
 		if i.Required {
 			return ""
@@ -52,6 +54,34 @@ func (i *Input) GetValue() string {
 	return value // everything else
 }
 
+// GetEnvValue returns JSON representation of the 'EnvDefault' value, which is
+// the value of the input merged from '--with-var-file-defaults' files. It falls
+// back to 'GetValue' if the input is not set in any of them.
+func (i *Input) GetEnvValue() string {
+	if i.EnvDefault == nil {
+		return i.GetValue()
+	}
+	return jsonValue(i.EnvDefault)
+}
+
+func jsonValue(v types.Value) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	err := encoder.Encode(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v.Raw())
+	}
+	return strings.TrimSpace(buf.String())
+}
+
+// IsNullable indicates if a Terraform variable accepts 'null' as its value,
+// which is the case unless it's declared with 'nullable = false'.
+func (i *Input) IsNullable() bool {
+	return i.Nullable == nil || *i.Nullable
+}
+
 // HasDefault indicates if a Terraform variable has a default value set.
 func (i *Input) HasDefault() bool {
 	return i.Default.HasDefault() || !i.Required
@@ -108,3 +138,25 @@ func (ii inputs) sort(enabled bool, by string) {
 		}
 	}
 }
+
+// pinBottom moves the inputs with the given 'names' to the end, in the same
+// order as they are listed in 'names', and keeps the order of the others.
+func (ii inputs) pinBottom(names []string) {
+	if len(names) == 0 {
+		return
+	}
+	positions := make(map[string]int, len(names))
+	for i, name := range names {
+		if _, ok := positions[name]; !ok {
+			positions[name] = i
+		}
+	}
+	sort.SliceStable(ii, func(i, j int) bool {
+		pi, pinnedi := positions[ii[i].Name]
+		pj, pinnedj := positions[ii[j].Name]
+		if pinnedi != pinnedj {
+			return pinnedj
+		}
+		return pinnedi && pi < pj
+	})
+}
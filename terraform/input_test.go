@@ -11,6 +11,7 @@ the root directory of this source tree.
 package terraform
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -199,6 +200,34 @@ func TestInputValue(t *testing.T) {
 			expectDefault:  true,
 			expectRequired: false,
 		},
+		{
+			name: "input Value and HasDefault",
+			input: Input{
+				Name:        inputName,
+				Type:        inputType,
+				Description: inputDescr,
+				Default:     types.ValueOf(types.Map{"name": "foo", "tags": []interface{}{"a"}, "nested": map[string]interface{}{"enabled": true}}.Underlying()),
+				Required:    false,
+				Position:    inputPos,
+			},
+			expectValue:    "{\n  \"name\": \"foo\",\n  \"nested\": {\n    \"enabled\": true\n  },\n  \"tags\": [\n    \"a\"\n  ]\n}",
+			expectDefault:  true,
+			expectRequired: false,
+		},
+		{
+			name: "input Value and HasDefault",
+			input: Input{
+				Name:        inputName,
+				Type:        inputType,
+				Description: inputDescr,
+				Default:     types.ValueOf(math.Inf(1)),
+				Required:    false,
+				Position:    inputPos,
+			},
+			expectValue:    "+Inf",
+			expectDefault:  true,
+			expectRequired: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -210,6 +239,59 @@ func TestInputValue(t *testing.T) {
 	}
 }
 
+func TestInputEnvValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Input
+		expected string
+	}{
+		{
+			name: "input EnvValue of required input",
+			input: Input{
+				Name:     "input",
+				Default:  types.ValueOf(nil),
+				Required: true,
+			},
+			expected: "",
+		},
+		{
+			name: "input EnvValue falls back to Default",
+			input: Input{
+				Name:     "input",
+				Default:  types.ValueOf("foo"),
+				Required: false,
+			},
+			expected: `"foo"`,
+		},
+		{
+			name: "input EnvValue overrides Default",
+			input: Input{
+				Name:       "input",
+				Default:    types.ValueOf("foo"),
+				EnvDefault: types.ValueOf("bar"),
+				Required:   false,
+			},
+			expected: `"bar"`,
+		},
+		{
+			name: "input EnvValue of required input in var file",
+			input: Input{
+				Name:       "input",
+				Default:    types.ValueOf(nil),
+				EnvDefault: types.ValueOf([]interface{}{"a", "b"}),
+				Required:   true,
+			},
+			expected: "[\n  \"a\",\n  \"b\"\n]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, tt.input.GetEnvValue())
+		})
+	}
+}
+
 func TestInputsSorted(t *testing.T) {
 	inputs := sampleInputs()
 	tests := map[string]struct {
@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclparse"
+)
+
+// DescriptionChange represents a previous description of an input, and the
+// date of the commit which changed it.
+type DescriptionChange struct {
+	Description string `json:"description" toml:"description" xml:"description" yaml:"description"`
+	Date        string `json:"date" toml:"date" xml:"date" yaml:"date"`
+}
+
+// fileRevision represents the descriptions of the variables declared in a file
+// at a commit.
+type fileRevision struct {
+	Date         string
+	Descriptions map[string]string
+}
+
+// parseCommits reads the output of 'git log --format="%H %ad" --date=short' and
+// returns the hash and date of the commits, oldest first.
+func parseCommits(content []byte) [][2]string {
+	commits := make([][2]string, 0)
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		commits = append([][2]string{{fields[0], fields[1]}}, commits...)
+	}
+
+	return commits
+}
+
+// parseVariableDescriptions returns the literal descriptions of the variables
+// declared in 'content' of 'filename'. Content which can't be parsed (e.g. work
+// in progress commits) returns nothing.
+func parseVariableDescriptions(filename string, content []byte) map[string]string {
+	descriptions := make(map[string]string)
+
+	file, diags := hclparse.NewParser().ParseHCL(content, filename)
+	if diags.HasErrors() {
+		return nil
+	}
+
+	variableSchema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{
+			{Type: "variable", LabelNames: []string{"name"}},
+		},
+	}
+	descriptionSchema := &hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{
+			{Name: "description"},
+		},
+	}
+
+	body, _, diags := file.Body.PartialContent(variableSchema)
+	if diags.HasErrors() {
+		return nil
+	}
+	for _, block := range body.Blocks {
+		attrs, _, diags := block.Body.PartialContent(descriptionSchema)
+		if diags.HasErrors() {
+			continue
+		}
+		var description string
+		if attr, ok := attrs.Attributes["description"]; ok {
+			if diags := gohcl.DecodeExpression(attr.Expr, nil, &description); diags.HasErrors() {
+				continue
+			}
+		}
+		descriptions[block.Labels[0]] = description
+	}
+
+	return descriptions
+}
+
+// descriptionHistory returns the previous descriptions of the variables of the
+// 'revisions' of a file (oldest first), newest first. A change is recorded only
+// if the description of an existing variable is changed, i.e. adding a variable
+// or its description for the first time isn't a change.
+func descriptionHistory(revisions []*fileRevision) map[string][]*DescriptionChange {
+	history := make(map[string][]*DescriptionChange)
+	current := make(map[string]string)
+
+	for _, revision := range revisions {
+		for name, description := range revision.Descriptions {
+			previous, ok := current[name]
+			current[name] = description
+			if !ok || previous == "" || previous == description {
+				continue
+			}
+			change := &DescriptionChange{Description: previous, Date: revision.Date}
+			history[name] = append([]*DescriptionChange{change}, history[name]...)
+		}
+	}
+
+	return history
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCommits(t *testing.T) {
+	tests := map[string]struct {
+		content  string
+		expected [][2]string
+	}{
+		"Empty": {
+			content:  "",
+			expected: [][2]string{},
+		},
+		"Commits": {
+			content:  "c3 2021-05-03\nc2 2021-03-15\n\nc1 2021-02-01\n",
+			expected: [][2]string{{"c1", "2021-02-01"}, {"c2", "2021-03-15"}, {"c3", "2021-05-03"}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, parseCommits([]byte(tt.content)))
+		})
+	}
+}
+
+func TestParseVariableDescriptions(t *testing.T) {
+	tests := map[string]struct {
+		content  string
+		expected map[string]string
+	}{
+		"Variables": {
+			content: `
+				variable "foo" {
+				  description = "The foo."
+				}
+				variable "bar" {}
+				variable "baz" {
+				  description = "${var.foo} isn't literal"
+				}
+				output "qux" {
+				  description = "not a variable"
+				  value       = 1
+				}
+			`,
+			expected: map[string]string{"foo": "The foo.", "bar": ""},
+		},
+		"Invalid": {
+			content:  `variable "foo" {`,
+			expected: nil,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, parseVariableDescriptions("variables.tf", []byte(tt.content)))
+		})
+	}
+}
+
+func TestDescriptionHistory(t *testing.T) {
+	revisions := []*fileRevision{
+		{Date: "2021-01-01", Descriptions: map[string]string{"foo": "", "bar": "The bar."}},
+		{Date: "2021-02-01", Descriptions: map[string]string{"foo": "The foo.", "bar": "The bar."}},
+		{Date: "2021-03-15", Descriptions: nil},
+		{Date: "2021-03-16", Descriptions: map[string]string{"foo": "It's foo.", "bar": "The bar.", "baz": "The baz."}},
+		{Date: "2021-05-03", Descriptions: map[string]string{"foo": "It's the foo.", "bar": "The bar.", "baz": "The baz."}},
+	}
+	expected := map[string][]*DescriptionChange{
+		"foo": {
+			{Description: "It's foo.", Date: "2021-05-03"},
+			{Description: "The foo.", Date: "2021-03-16"},
+		},
+	}
+
+	assert.Equal(t, expected, descriptionHistory(revisions))
+}
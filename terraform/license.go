@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"regexp"
+	"strings"
+)
+
+// licenseFiles are the names of the files license of the module is read from,
+// in the order of precedence.
+var licenseFiles = []string{
+	"LICENSE",
+	"LICENSE.md",
+	"LICENSE.txt",
+	"COPYING",
+}
+
+// licenseMatchers are the SPDX identifier of the known licenses with the
+// phrases they can be identified by. Order matters, more specific ones (e.g.
+// LGPL and AGPL) have to come before the generic ones (e.g. GPL).
+var licenseMatchers = []struct {
+	id      string
+	phrases []string
+}{
+	{id: "AGPL-3.0", phrases: []string{"gnu affero general public license", "version 3"}},
+	{id: "LGPL-3.0", phrases: []string{"gnu lesser general public license", "version 3"}},
+	{id: "LGPL-2.1", phrases: []string{"gnu lesser general public license", "version 2.1"}},
+	{id: "GPL-3.0", phrases: []string{"gnu general public license", "version 3"}},
+	{id: "GPL-2.0", phrases: []string{"gnu general public license", "version 2"}},
+	{id: "MPL-2.0", phrases: []string{"mozilla public license", "2.0"}},
+	{id: "Apache-2.0", phrases: []string{"apache license", "version 2.0"}},
+	{id: "BSD-3-Clause", phrases: []string{"redistribution and use in source and binary forms", "neither the name"}},
+	{id: "BSD-2-Clause", phrases: []string{"redistribution and use in source and binary forms"}},
+	{id: "ISC", phrases: []string{"permission to use, copy, modify, and/or distribute this software"}},
+	{id: "MIT", phrases: []string{"permission is hereby granted, free of charge"}},
+	{id: "Unlicense", phrases: []string{"this is free and unencumbered software released into the public domain"}},
+}
+
+var whitespaces = regexp.MustCompile(`\s+`)
+
+// detectLicense returns SPDX identifier of the license of given 'content' by
+// looking for well-known phrases in it, or an empty string if the license is
+// not known.
+func detectLicense(content string) string {
+	content = strings.ToLower(whitespaces.ReplaceAllString(content, " "))
+
+	for _, m := range licenseMatchers {
+		found := true
+		for _, p := range m.phrases {
+			if !strings.Contains(content, p) {
+				found = false
+				break
+			}
+		}
+		if found {
+			return m.id
+		}
+	}
+	return ""
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectLicense(t *testing.T) {
+	tests := map[string]struct {
+		content  string
+		expected string
+	}{
+		"MIT": {
+			content:  "MIT License\n\nPermission is hereby granted, free of\ncharge, to any person obtaining a copy",
+			expected: "MIT",
+		},
+		"Apache": {
+			content:  "Apache License\nVersion 2.0, January 2004",
+			expected: "Apache-2.0",
+		},
+		"MPL": {
+			content:  "Mozilla Public License Version 2.0\n==================================",
+			expected: "MPL-2.0",
+		},
+		"GPL3": {
+			content:  "GNU GENERAL PUBLIC LICENSE\nVersion 3, 29 June 2007",
+			expected: "GPL-3.0",
+		},
+		"LGPL3": {
+			content:  "GNU LESSER GENERAL PUBLIC LICENSE\nVersion 3, 29 June 2007",
+			expected: "LGPL-3.0",
+		},
+		"BSD3": {
+			content:  "Redistribution and use in source and binary forms, with or without modification ... Neither the name of the copyright holder",
+			expected: "BSD-3-Clause",
+		},
+		"BSD2": {
+			content:  "Redistribution and use in source and binary forms, with or without modification",
+			expected: "BSD-2-Clause",
+		},
+		"Unknown": {
+			content:  "All rights reserved.",
+			expected: "",
+		},
+		"Empty": {
+			content:  "",
+			expected: "",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := detectLicense(tt.content)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
@@ -11,6 +11,7 @@ the root directory of this source tree.
 package terraform
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -22,7 +23,15 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclparse"
 	"github.com/hashicorp/hcl/v2/hclsimple"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gopkg.in/yaml.v3"
 
 	"github.com/terraform-docs/terraform-config-inspect/tfconfig"
 	"github.com/terraform-docs/terraform-docs/internal/reader"
@@ -30,31 +39,111 @@ import (
 	"github.com/terraform-docs/terraform-docs/print"
 )
 
+// tracer creates the spans of loading the modules, which are only exported if
+// a tracer provider is registered (e.g. by '--with-experimental-opentelemetry').
+var tracer = otel.Tracer("github.com/terraform-docs/terraform-docs/terraform")
+
 // LoadWithOptions returns new instance of Module with all the inputs and
 // outputs discovered from provided 'path' containing Terraform config
 func LoadWithOptions(config *print.Config) (*Module, error) {
+	return LoadWithContext(context.Background(), config)
+}
+
+// LoadWithContext is the same as LoadWithOptions, and the spans of loading the
+// module are created as children of the span of 'ctx' (if any).
+func LoadWithContext(ctx context.Context, config *print.Config) (*Module, error) {
+	ctx, span := tracer.Start(ctx, "load", trace.WithAttributes(
+		attribute.String("module.root", config.ModuleRoot),
+	))
+	defer span.End()
+
+	module, err := loadWithCacheOrOptions(ctx, config)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return module, err
+}
+
+func loadWithCacheOrOptions(ctx context.Context, config *print.Config) (*Module, error) {
+	if isCacheable(config) {
+		if dir, err := cacheDir(config); err == nil {
+			return loadWithCache(&dirStore{dir: dir}, config, func(c *print.Config) (*Module, error) {
+				return loadWithOptions(ctx, c)
+			})
+		}
+	}
+	return loadWithOptions(ctx, config)
+}
+
+func loadWithOptions(ctx context.Context, config *print.Config) (*Module, error) {
+	_, span := tracer.Start(ctx, "parse", trace.WithAttributes(
+		attribute.Int("module.files", countModuleFiles(config.ModuleRoot)),
+	))
 	tfmodule, err := loadModule(config.ModuleRoot)
 	if err != nil {
+		span.End()
 		return nil, err
 	}
 
 	module, err := loadModuleItems(tfmodule, config)
 	if err != nil {
-		return nil, err
+		span.End()
+		return nil, wrapParseErrors(config.ModuleRoot, err)
 	}
+	span.SetAttributes(
+		attribute.Int("module.variables", len(module.Inputs)),
+		attribute.Int("module.outputs", len(module.Outputs)),
+		attribute.Int("module.resources", len(module.Resources)),
+	)
+	span.End()
+
+	_, span = tracer.Start(ctx, "sort", trace.WithAttributes(
+		attribute.Bool("sort.enabled", config.Sort.Enabled),
+		attribute.String("sort.by", config.Sort.By),
+	))
 	sortItems(module, config)
+	span.End()
+
 	return module, nil
 }
 
+// countModuleFiles returns the number of Terraform files in the module root,
+// in either native or JSON syntax.
+func countModuleFiles(dir string) int {
+	count := 0
+	for _, pattern := range []string{"*.tf", "*.tf.json"} {
+		if files, err := filepath.Glob(filepath.Join(dir, pattern)); err == nil {
+			count += len(files)
+		}
+	}
+	return count
+}
+
 func loadModule(path string) (*tfconfig.Module, error) {
 	module, diag := tfconfig.LoadModule(path)
 	if diag != nil && diag.HasErrors() {
+		// parse the files again to report all the syntax errors with their
+		// column, which are not provided by terraform-config-inspect
+		if _, err := loadHCLFiles(path); err != nil {
+			return nil, err
+		}
+		if err := newConfigParseErrors(path, diag); err != nil {
+			return nil, err
+		}
 		return nil, diag
 	}
 	return module, nil
 }
 
 func loadModuleItems(tfmodule *tfconfig.Module, config *print.Config) (*Module, error) {
+	// the files are parsed once for all the items which are not known to
+	// terraform-config-inspect
+	files, err := loadHCLFiles(config.ModuleRoot)
+	if err != nil {
+		return nil, err
+	}
+
 	header, err := loadHeader(config)
 	if err != nil {
 		return nil, err
@@ -65,17 +154,137 @@ func loadModuleItems(tfmodule *tfconfig.Module, config *print.Config) (*Module,
 		return nil, err
 	}
 
+	excludes, err := loadExcludes(config)
+	if err != nil {
+		return nil, err
+	}
+
 	inputs, required, optional := loadInputs(tfmodule, config)
+	inputs = excludes.inputs(inputs)
+	required = excludes.inputs(required)
+	optional = excludes.inputs(optional)
+	if err := loadDeprecations(config, inputs); err != nil {
+		return nil, err
+	}
+	if err := loadDefaultKinds(config, files, inputs); err != nil {
+		return nil, err
+	}
+	if err := loadVarFileDefaults(config, inputs); err != nil {
+		return nil, err
+	}
+	if err := loadRedactedDefaults(config, files, inputs); err != nil {
+		return nil, err
+	}
+	if err := loadInputHistory(config, inputs); err != nil {
+		return nil, err
+	}
+	if err := loadInputValidations(config, files, inputs); err != nil {
+		return nil, err
+	}
+	if err := loadInputAttributes(config, files, inputs); err != nil {
+		return nil, err
+	}
 	modulecalls := loadModulecalls(tfmodule, config)
-	outputs, err := loadOutputs(tfmodule, config)
+	if err := loadSourceLinks(config, modulecalls); err != nil {
+		return nil, err
+	}
+	calltree, err := loadModuleCallTree(tfmodule, config)
+	if err != nil {
+		return nil, err
+	}
+	outputs, err := loadOutputs(tfmodule, config, files)
 	if err != nil {
 		return nil, err
 	}
+	outputs = excludes.outputs(outputs)
+	if err := loadOutputConsumers(config, outputs); err != nil {
+		return nil, err
+	}
 	providers := loadProviders(tfmodule, config)
+	loadRegions(config, inputs, providers)
 	requirements := loadRequirements(tfmodule)
+	requiredCore, pinnedCore, err := loadCoreVersion(tfmodule, config)
+	if err != nil {
+		return nil, err
+	}
 	resources := loadResources(tfmodule, config)
+	if err := loadTagCompliance(config, files, resources); err != nil {
+		return nil, err
+	}
+	compatibility, err := loadCompatibilityMatrix(config)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := loadExamplePlan(config)
+	if err != nil {
+		return nil, err
+	}
+	exampleOutputs, err := loadExampleOutputs(config)
+	if err != nil {
+		return nil, err
+	}
+	validations, err := loadExampleValidations(config)
+	if err != nil {
+		return nil, err
+	}
+	cost, err := loadCostEstimate(config)
+	if err != nil {
+		return nil, err
+	}
+	findings, err := loadSecurityFindings(config)
+	if err != nil {
+		return nil, err
+	}
+	lockChanges, err := loadProviderVersionChanges(config)
+	if err != nil {
+		return nil, err
+	}
+	checks, err := loadChecks(config, files)
+	if err != nil {
+		return nil, err
+	}
+	testRuns, err := loadTestRuns(config)
+	if err != nil {
+		return nil, err
+	}
+	testResults, err := loadTestResults(config)
+	if err != nil {
+		return nil, err
+	}
+	moved, err := loadMoved(config, files)
+	if err != nil {
+		return nil, err
+	}
+	ephemeral, err := loadEphemeralResources(tfmodule, config, files)
+	if err != nil {
+		return nil, err
+	}
+	license, err := loadLicense(config)
+	if err != nil {
+		return nil, err
+	}
+	purpose, err := loadPurpose(config, header)
+	if err != nil {
+		return nil, err
+	}
+	changelog, err := loadChangelog(config)
+	if err != nil {
+		return nil, err
+	}
+	sensitive, err := loadSensitiveSummary(tfmodule, config, files, inputs, outputs)
+	if err != nil {
+		return nil, err
+	}
+	backend, err := loadBackend(config, files)
+	if err != nil {
+		return nil, err
+	}
+	variableSummary, err := loadVariableSummary(config, files, inputs)
+	if err != nil {
+		return nil, err
+	}
 
-	return &Module{
+	module := &Module{
 		Header:       header,
 		Footer:       footer,
 		Inputs:       inputs,
@@ -85,9 +294,41 @@ func loadModuleItems(tfmodule *tfconfig.Module, config *print.Config) (*Module,
 		Requirements: requirements,
 		Resources:    resources,
 
+		RequiredCoreVersion: requiredCore,
+		PinnedCoreVersion:   pinnedCore,
+
+		Purpose:             purpose,
+		License:             license,
+		Checks:              checks,
+		TestRuns:            testRuns,
+		TestResults:         testResults,
+		Moved:               moved,
+		ModuleCallTree:      calltree,
+		EphemeralResources:  ephemeral,
+		CompatibilityMatrix: compatibility,
+		ExamplePlan:         plan,
+		ExampleOutputs:      exampleOutputs,
+		ExampleValidations:  validations,
+		CostEstimate:        cost,
+		SecurityFindings:    findings,
+		LockChanges:         lockChanges,
+		Backend:             backend,
+		Changelog:           changelog,
+		SensitiveSummary:    sensitive,
+		VariableSummary:     variableSummary,
+
 		RequiredInputs: required,
 		OptionalInputs: optional,
-	}, nil
+	}
+
+	if config.Settings.ShowSummary {
+		module.Summary = NewSummary(module)
+	}
+	if config.HealthScore.Enabled {
+		module.HealthScore = NewHealthScore(config, module)
+	}
+
+	return module, nil
 }
 
 func getFileFormat(filename string) string {
@@ -195,11 +436,13 @@ func loadInputs(tfmodule *tfconfig.Module, config *print.Config) ([]*Input, []*I
 		}
 
 		i := &Input{
-			Name:        input.Name,
-			Type:        types.TypeOf(input.Type, input.Default),
-			Description: types.String(inputDescription),
-			Default:     types.ValueOf(input.Default),
-			Required:    input.Required,
+			Name:               input.Name,
+			Type:               types.TypeOf(input.Type, input.Default),
+			Description:        types.String(inputDescription),
+			Default:            types.ValueOf(input.Default),
+			Required:           input.Required,
+			Validations:        make([]*Condition, 0),
+			DescriptionHistory: make([]*DescriptionChange, 0),
 			Position: Position{
 				Filename: input.Pos.Filename,
 				Line:     input.Pos.Line,
@@ -218,6 +461,35 @@ func loadInputs(tfmodule *tfconfig.Module, config *print.Config) ([]*Input, []*I
 	return inputs, required, optional
 }
 
+// loadModuleCallTree returns the tree of nested module calls of the module, up
+// to 'call-graph-depth' levels, if '--with-module-call-graph' is set.
+func loadModuleCallTree(tfmodule *tfconfig.Module, config *print.Config) ([]*ModuleCallNode, error) {
+	if !config.Settings.ModuleCallGraph {
+		return make([]*ModuleCallNode, 0), nil
+	}
+	root, err := filepath.Abs(config.ModuleRoot)
+	if err != nil {
+		return nil, err
+	}
+	return newModuleCallNodes(tfmodule, root, 1, config.Settings.CallGraphDepth, map[string]bool{root: true}), nil
+}
+
+// loadSourceLinks sets the URL of documentation of the module calls, by the
+// templates of 'source-link-templates', if '--with-gruntwork-links' is set.
+func loadSourceLinks(config *print.Config, modulecalls []*ModuleCall) error {
+	if !config.Settings.GruntworkLinks {
+		return nil
+	}
+	for _, m := range modulecalls {
+		url, err := sourceLinkURL(config, m)
+		if err != nil {
+			return err
+		}
+		m.DocsURL = url
+	}
+	return nil
+}
+
 func formatSource(s, v string) (source, version string) {
 	substr := "?ref="
 
@@ -267,7 +539,7 @@ func loadModulecalls(tfmodule *tfconfig.Module, config *print.Config) []*ModuleC
 	return modules
 }
 
-func loadOutputs(tfmodule *tfconfig.Module, config *print.Config) ([]*Output, error) {
+func loadOutputs(tfmodule *tfconfig.Module, config *print.Config, files []*hcl.File) ([]*Output, error) {
 	outputs := make([]*Output, 0, len(tfmodule.Outputs))
 	values := make(map[string]*output)
 	if config.OutputValues.Enabled {
@@ -277,29 +549,62 @@ func loadOutputs(tfmodule *tfconfig.Module, config *print.Config) ([]*Output, er
 			return nil, err
 		}
 	}
+	preconditions, postconditions, err := loadOutputConditions(config, files)
+	if err != nil {
+		return nil, err
+	}
+	valueTypes, err := loadOutputTypes(config, files)
+	if err != nil {
+		return nil, err
+	}
 	for _, o := range tfmodule.Outputs {
 		// convert CRLF to LF early on (https://github.com/terraform-docs/terraform-docs/issues/584)
 		description := strings.ReplaceAll(o.Description, "\r\n", "\n")
-		if description == "" && config.Settings.ReadComments {
-			description = loadComments(o.Pos.Filename, o.Pos.Line)
+		readComments := description == "" && config.Settings.ReadComments
+
+		var comments []string
+		if readComments || config.Settings.OutputDeprecation {
+			comments = loadCommentLines(o.Pos.Filename, o.Pos.Line)
+		}
+
+		var deprecated string
+		if config.Settings.OutputDeprecation {
+			deprecated, comments = parseDeprecationComment(comments)
+		}
+		if readComments {
+			description = strings.Join(comments, " ")
 		}
 
 		output := &Output{
 			Name:        o.Name,
 			Description: types.String(description),
+			Deprecated:  deprecated,
+			Type:        types.String(valueTypes[o.Name]),
+			Consumers:   make([]string, 0),
 			Position: Position{
 				Filename: o.Pos.Filename,
 				Line:     o.Pos.Line,
 			},
 			ShowValue: config.OutputValues.Enabled,
+
+			ShowSensitive: config.Settings.ShowAttributes,
+
+			Preconditions:  conditionsOf(preconditions, o.Name),
+			Postconditions: conditionsOf(postconditions, o.Name),
+		}
+
+		if config.Settings.ShowAttributes {
+			output.Sensitive = o.Sensitive
 		}
 
-		if config.OutputValues.Enabled {
-			output.Sensitive = values[output.Name].Sensitive
-			if values[output.Name].Sensitive {
+		// outputs which are not applied yet are missing from the values, and
+		// values of the outputs which are removed from the module are ignored
+		if value, ok := values[output.Name]; ok && value != nil {
+			output.Sensitive = value.Sensitive
+			if value.Sensitive {
 				output.Value = types.ValueOf(`<sensitive>`)
 			} else {
-				output.Value = types.ValueOf(values[output.Name].Value)
+				output.Value = types.ValueOf(value.Value)
 			}
 		}
 		outputs = append(outputs, output)
@@ -307,112 +612,1619 @@ func loadOutputs(tfmodule *tfconfig.Module, config *print.Config) ([]*Output, er
 	return outputs, nil
 }
 
-func loadOutputValues(config *print.Config) (map[string]*output, error) {
-	var out []byte
-	var err error
-	if config.OutputValues.From == "" {
-		cmd := exec.Command("terraform", "output", "-json")
-		cmd.Dir = config.ModuleRoot
-		if out, err = cmd.Output(); err != nil {
-			return nil, fmt.Errorf("caught error while reading the terraform outputs: %w", err)
-		}
-	} else if out, err = ioutil.ReadFile(config.OutputValues.From); err != nil {
-		return nil, fmt.Errorf("caught error while reading the terraform outputs file at %s: %w", config.OutputValues.From, err)
+// loadOutputConsumers attaches the names of the sibling modules (i.e. the other
+// folders in the parent folder of the module) which consume each output through
+// their module calls of the module, if '--with-output-consumers' is set.
+func loadOutputConsumers(config *print.Config, outputs []*Output) error {
+	if !config.Settings.OutputConsumers {
+		return nil
 	}
-	var terraformOutputs map[string]*output
-	err = json.Unmarshal(out, &terraformOutputs)
+
+	root, err := filepath.Abs(config.ModuleRoot)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return terraformOutputs, err
+	parent := filepath.Dir(root)
+
+	entries, err := os.ReadDir(parent)
+	if err != nil {
+		return err
+	}
+
+	consumers := make(map[string][]string)
+	for _, entry := range entries {
+		dir := filepath.Join(parent, entry.Name())
+		if !entry.IsDir() || dir == root {
+			continue
+		}
+		for name := range consumedOutputs(dir, root) {
+			consumers[name] = append(consumers[name], entry.Name())
+		}
+	}
+
+	for _, output := range outputs {
+		if items, ok := consumers[output.Name]; ok {
+			output.Consumers = items
+		}
+	}
+
+	return nil
 }
 
-func loadProviders(tfmodule *tfconfig.Module, config *print.Config) []*Provider {
-	type provider struct {
-		Name        string   `hcl:"name,label"`
-		Version     string   `hcl:"version"`
-		Constraints *string  `hcl:"constraints"`
-		Hashes      []string `hcl:"hashes"`
+// loadOutputConditions returns preconditions and postconditions of outputs,
+// keyed by output name. They can be declared either directly in the output
+// block or inside of its 'lifecycle' block. Nothing is returned if the target
+// version of Terraform (i.e. '--terraform-version') doesn't support them.
+func loadOutputConditions(config *print.Config, files []*hcl.File) (map[string][]*Condition, map[string][]*Condition, error) {
+	preconditions := make(map[string][]*Condition)
+	postconditions := make(map[string][]*Condition)
+
+	if !supportsFeature(config, outputConditionsSince) {
+		return preconditions, postconditions, nil
 	}
-	type lockfile struct {
-		Provider []provider `hcl:"provider,block"`
+
+	outputSchema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{
+			{Type: "output", LabelNames: []string{"name"}},
+		},
+	}
+	conditionsSchema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{
+			{Type: "lifecycle"},
+			{Type: "precondition"},
+			{Type: "postcondition"},
+		},
+	}
+	conditionSchema := &hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{
+			{Name: "condition", Required: true},
+			{Name: "error_message", Required: true},
+		},
 	}
-	lock := make(map[string]provider)
 
-	if config.Settings.LockFile {
-		var lf lockfile
+	var collect func(name string, body hcl.Body, src []byte) error
+	collect = func(name string, body hcl.Body, src []byte) error {
+		content, _, diags := body.PartialContent(conditionsSchema)
+		if diags.HasErrors() {
+			return diags
+		}
 
-		filename := filepath.Join(config.ModuleRoot, ".terraform.lock.hcl")
-		if err := hclsimple.DecodeFile(filename, nil, &lf); err == nil {
-			for i := range lf.Provider {
-				segments := strings.Split(lf.Provider[i].Name, "/")
-				name := segments[len(segments)-1]
-				lock[name] = lf.Provider[i]
+		for _, block := range content.Blocks {
+			if block.Type == "lifecycle" {
+				if err := collect(name, block.Body, src); err != nil {
+					return err
+				}
+				continue
 			}
-		}
-	}
 
-	resources := []map[string]*tfconfig.Resource{tfmodule.ManagedResources, tfmodule.DataResources}
-	discovered := make(map[string]*Provider)
+			attrs, _, diags := block.Body.PartialContent(conditionSchema)
+			if diags.HasErrors() {
+				return diags
+			}
 
-	for _, resource := range resources {
-		for _, r := range resource {
-			var version = ""
-			if l, ok := lock[r.Provider.Name]; ok {
-				version = l.Version
-			} else if rv, ok := tfmodule.RequiredProviders[r.Provider.Name]; ok && len(rv.VersionConstraints) > 0 {
-				version = strings.Join(rv.VersionConstraints, " ")
+			condition := &Condition{
+				Expression:   types.String(expressionSource(attrs.Attributes["condition"].Expr, src)),
+				ErrorMessage: types.String(decodeErrorMessage(attrs.Attributes["error_message"].Expr, src)),
 			}
 
-			key := fmt.Sprintf("%s.%s", r.Provider.Name, r.Provider.Alias)
-			discovered[key] = &Provider{
-				Name:    r.Provider.Name,
-				Alias:   types.String(r.Provider.Alias),
-				Version: types.String(version),
-				Position: Position{
-					Filename: r.Pos.Filename,
-					Line:     r.Pos.Line,
-				},
+			if block.Type == "precondition" {
+				preconditions[name] = append(preconditions[name], condition)
+			} else {
+				postconditions[name] = append(postconditions[name], condition)
 			}
 		}
+		return nil
 	}
 
-	providers := make([]*Provider, 0, len(discovered))
-	for _, provider := range discovered {
-		providers = append(providers, provider)
+	for _, file := range files {
+		content, _, diags := file.Body.PartialContent(outputSchema)
+		if diags.HasErrors() {
+			return nil, nil, diags
+		}
+
+		for _, block := range content.Blocks {
+			if err := collect(block.Labels[0], block.Body, file.Bytes); err != nil {
+				return nil, nil, err
+			}
+		}
 	}
-	return providers
+
+	return preconditions, postconditions, nil
 }
 
-func loadRequirements(tfmodule *tfconfig.Module) []*Requirement {
-	var requirements = make([]*Requirement, 0)
-	for _, core := range tfmodule.RequiredCore {
-		requirements = append(requirements, &Requirement{
-			Name:    "terraform",
-			Version: types.String(core),
-		})
+// loadOutputTypes returns the type of outputs inferred from their 'value'
+// expression, keyed by output name. The types are also used for placeholders
+// of '--with-helm-values-output'.
+func loadOutputTypes(config *print.Config, files []*hcl.File) (map[string]string, error) {
+	valueTypes := make(map[string]string)
+
+	if !config.Settings.OutputValueType && !config.Settings.HelmValuesOutput {
+		return valueTypes, nil
 	}
 
-	names := make([]string, 0, len(tfmodule.RequiredProviders))
-	for n := range tfmodule.RequiredProviders {
-		names = append(names, n)
+	outputSchema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{
+			{Type: "output", LabelNames: []string{"name"}},
+		},
+	}
+	valueSchema := &hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{
+			{Name: "value"},
+		},
 	}
 
-	sort.Strings(names)
+	for _, file := range files {
+		content, _, diags := file.Body.PartialContent(outputSchema)
+		if diags.HasErrors() {
+			return nil, diags
+		}
 
-	for _, name := range names {
-		for _, version := range tfmodule.RequiredProviders[name].VersionConstraints {
-			requirements = append(requirements, &Requirement{
-				Name:    name,
-				Version: types.String(version),
-			})
+		for _, block := range content.Blocks {
+			attrs, _, diags := block.Body.PartialContent(valueSchema)
+			if diags.HasErrors() {
+				return nil, diags
+			}
+
+			valueTypes[block.Labels[0]] = outputTypeAny
+			if value, ok := attrs.Attributes["value"]; ok {
+				valueTypes[block.Labels[0]] = inferOutputType(value.Expr)
+			}
 		}
 	}
-	return requirements
+
+	return valueTypes, nil
 }
 
-func loadResources(tfmodule *tfconfig.Module, config *print.Config) []*Resource {
-	allResources := []map[string]*tfconfig.Resource{tfmodule.ManagedResources, tfmodule.DataResources}
+func conditionsOf(conditions map[string][]*Condition, name string) []*Condition {
+	if c, ok := conditions[name]; ok {
+		return c
+	}
+	return make([]*Condition, 0)
+}
+
+func loadOutputValues(config *print.Config) (map[string]*output, error) {
+	var out []byte
+	var err error
+	if config.OutputValues.From == "" {
+		cmd := exec.Command("terraform", "output", "-json")
+		cmd.Dir = config.ModuleRoot
+		if out, err = cmd.Output(); err != nil {
+			return nil, fmt.Errorf("caught error while reading the terraform outputs: %w", err)
+		}
+	} else if out, err = ioutil.ReadFile(config.OutputValues.From); err != nil {
+		return nil, fmt.Errorf("caught error while reading the terraform outputs file at %s: %w", config.OutputValues.From, err)
+	}
+	var terraformOutputs map[string]*output
+	err = json.Unmarshal(out, &terraformOutputs)
+	if err != nil {
+		return nil, err
+	}
+	return terraformOutputs, err
+}
+
+// loadExamplePlan returns the resources to be created by 'terraform plan' of the
+// module with the inputs of '--example-plan-vars' (relative to module root), if
+// '--example-plan' is set.
+func loadExamplePlan(config *print.Config) ([]*PlannedResource, error) {
+	if !config.ExamplePlan.Enabled {
+		return make([]*PlannedResource, 0), nil
+	}
+
+	vars := config.ExamplePlan.Vars
+	if !filepath.IsAbs(vars) {
+		vars = filepath.Join(config.ModuleRoot, vars)
+	}
+	vars, err := filepath.Abs(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := ioutil.TempDir("", "terraform-docs")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir) //nolint:errcheck
+
+	planfile := filepath.Join(dir, "plan.tfplan")
+
+	cmd := exec.Command("terraform", "plan", "-input=false", "-var-file="+vars, "-out="+planfile) //nolint:gosec
+	cmd.Dir = config.ModuleRoot
+	if _, err := cmd.Output(); err != nil {
+		return nil, fmt.Errorf("caught error while running the terraform plan: %w", withStderr(err))
+	}
+
+	cmd = exec.Command("terraform", "show", "-json", planfile) //nolint:gosec
+	cmd.Dir = config.ModuleRoot
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("caught error while reading the terraform plan: %w", withStderr(err))
+	}
+
+	return parsePlan(out)
+}
+
+// withStderr returns 'err' of running a command along with its standard error,
+// if any, which contains the actual reason of the failure (e.g. diagnostics of
+// terraform).
+func withStderr(err error) error {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		if stderr := strings.TrimSpace(string(exitErr.Stderr)); stderr != "" {
+			return fmt.Errorf("%w, %s", err, stderr)
+		}
+	}
+	return err
+}
+
+// loadExampleOutputs returns the output values of the examples of the module (i.e.
+// folders in 'examples') which are initialized, by 'terraform output -json' of
+// each of them. Examples which their outputs can't be read (e.g. terraform isn't
+// installed or they haven't been applied) are silently skipped.
+func loadExampleOutputs(config *print.Config) ([]*ExampleOutput, error) {
+	items := make([]*ExampleOutput, 0)
+
+	if !config.Settings.ExampleOutputs {
+		return items, nil
+	}
+
+	examples, err := filepath.Glob(filepath.Join(config.ModuleRoot, "examples", "*", ".terraform"))
+	if err != nil {
+		return nil, err
+	}
+	for _, example := range examples {
+		dir := filepath.Dir(example)
+
+		cmd := exec.Command("terraform", "output", "-json")
+		cmd.Dir = dir
+		out, err := cmd.Output()
+		if err != nil {
+			continue
+		}
+
+		outputs, err := parseExampleOutputs(filepath.Base(dir), out)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, outputs...)
+	}
+
+	return items, nil
+}
+
+// loadExampleValidations runs 'terraform validate -json' in each example of the
+// module (i.e. folder in 'examples') and returns their results, if
+// '--with-examples-runner' is set. Nothing is returned if 'terraform' is not
+// available.
+func loadExampleValidations(config *print.Config) ([]*ExampleValidation, error) {
+	items := make([]*ExampleValidation, 0)
+
+	if !config.Settings.ExamplesRunner {
+		return items, nil
+	}
+	if _, err := exec.LookPath("terraform"); err != nil {
+		return items, nil
+	}
+
+	examples, err := filepath.Glob(filepath.Join(config.ModuleRoot, "examples", "*"))
+	if err != nil {
+		return nil, err
+	}
+	for _, dir := range examples {
+		if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+			continue
+		}
+
+		cmd := exec.Command("terraform", "validate", "-json")
+		cmd.Dir = dir
+		out, err := cmd.Output()
+
+		// 'terraform validate' exits with non-zero code if the example is
+		// invalid, which is already reported in its output
+		var exitErr *exec.ExitError
+		if err != nil && (!errors.As(err, &exitErr) || len(out) == 0) {
+			return nil, fmt.Errorf("caught error while running the terraform validate: %w", err)
+		}
+
+		validation, err := parseExampleValidation(filepath.Base(dir), out)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, validation)
+	}
+
+	return items, nil
+}
+
+func loadProviderVersionChanges(config *print.Config) ([]*ProviderVersionChange, error) {
+	if !config.LockDiff.Enabled {
+		return make([]*ProviderVersionChange, 0), nil
+	}
+
+	from, err := loadLockFileAt(config.ModuleRoot, config.LockDiff.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := loadLockFileAt(config.ModuleRoot, config.LockDiff.To)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffLockFiles(from, to), nil
+}
+
+// loadLockFileAt returns the locked version of the providers in the dependency
+// lock file of the module at Git 'ref', or nothing if the module doesn't have
+// the lock file at that ref (e.g. it's added later).
+func loadLockFileAt(dir string, ref string) (map[string]string, error) {
+	cmd := exec.Command("git", "ls-tree", "--name-only", ref, "--", lockFile) //nolint:gosec
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("caught error while reading Git ref '%s': %w", ref, err)
+	}
+	if strings.TrimSpace(string(out)) == "" {
+		return parseLockFile(nil)
+	}
+
+	cmd = exec.Command("git", "show", ref+":./"+lockFile) //nolint:gosec
+	cmd.Dir = dir
+	content, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("caught error while reading %s at '%s': %w", lockFile, ref, err)
+	}
+
+	return parseLockFile(content)
+}
+
+func loadCostEstimate(config *print.Config) (*CostEstimate, error) {
+	if !config.Settings.CostEstimate {
+		return nil, nil
+	}
+
+	dir, err := filepath.Abs(config.ModuleRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command("infracost", "diff", "--path", dir, "--sync-usage-file", "--usage-file", "infracost-usage.yml", "--format", "json") //nolint:gosec
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("caught error while running the infracost diff: %w", err)
+	}
+
+	return parseCostEstimate(out)
+}
+
+// loadSecurityFindings runs 'tfsec --format json' on the module and returns its
+// findings, if '--with-tfsec-results' is set.
+func loadSecurityFindings(config *print.Config) ([]*SecurityFinding, error) {
+	if !config.Settings.TfsecResults {
+		return make([]*SecurityFinding, 0), nil
+	}
+
+	dir, err := filepath.Abs(config.ModuleRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command("tfsec", "--format", "json", dir) //nolint:gosec
+	cmd.Dir = dir
+	out, err := cmd.Output()
+
+	// 'tfsec' exits with non-zero code if there's any finding, which is already
+	// reported in its output
+	var exitErr *exec.ExitError
+	if err != nil && (!errors.As(err, &exitErr) || len(out) == 0) {
+		return nil, fmt.Errorf("caught error while running the tfsec: %w", err)
+	}
+
+	return parseSecurityFindings(out, dir)
+}
+
+func loadProviders(tfmodule *tfconfig.Module, config *print.Config) []*Provider {
+	type provider struct {
+		Name        string   `hcl:"name,label"`
+		Version     string   `hcl:"version"`
+		Constraints *string  `hcl:"constraints"`
+		Hashes      []string `hcl:"hashes"`
+	}
+	type lockfile struct {
+		Provider []provider `hcl:"provider,block"`
+	}
+	lock := make(map[string]provider)
+
+	if config.Settings.LockFile {
+		var lf lockfile
+
+		filename := filepath.Join(config.ModuleRoot, ".terraform.lock.hcl")
+		if err := hclsimple.DecodeFile(filename, nil, &lf); err == nil {
+			for i := range lf.Provider {
+				segments := strings.Split(lf.Provider[i].Name, "/")
+				name := segments[len(segments)-1]
+				lock[name] = lf.Provider[i]
+			}
+		}
+	}
+
+	resources := []map[string]*tfconfig.Resource{tfmodule.ManagedResources, tfmodule.DataResources}
+	discovered := make(map[string]*Provider)
+
+	for _, resource := range resources {
+		for _, r := range resource {
+			var version = ""
+			if l, ok := lock[r.Provider.Name]; ok {
+				version = l.Version
+			} else if rv, ok := tfmodule.RequiredProviders[r.Provider.Name]; ok && len(rv.VersionConstraints) > 0 {
+				version = strings.Join(rv.VersionConstraints, " ")
+			}
+
+			var source string
+			if config.Settings.ProviderSourceNamespace {
+				source = fmt.Sprintf("%s/%s", officialNamespace, r.Provider.Name)
+				if rv, ok := tfmodule.RequiredProviders[r.Provider.Name]; ok && len(rv.Source) > 0 {
+					source = rv.Source
+				}
+			}
+
+			key := fmt.Sprintf("%s.%s", r.Provider.Name, r.Provider.Alias)
+			discovered[key] = &Provider{
+				Name:    r.Provider.Name,
+				Alias:   types.String(r.Provider.Alias),
+				Version: types.String(version),
+				Source:  source,
+				Position: Position{
+					Filename: r.Pos.Filename,
+					Line:     r.Pos.Line,
+				},
+			}
+		}
+	}
+
+	providers := make([]*Provider, 0, len(discovered))
+	for _, provider := range discovered {
+		providers = append(providers, provider)
+	}
+	return providers
+}
+
+func loadRequirements(tfmodule *tfconfig.Module) []*Requirement {
+	var requirements = make([]*Requirement, 0)
+	for _, core := range tfmodule.RequiredCore {
+		requirements = append(requirements, &Requirement{
+			Name:    "terraform",
+			Version: types.String(core),
+		})
+	}
+
+	names := make([]string, 0, len(tfmodule.RequiredProviders))
+	for n := range tfmodule.RequiredProviders {
+		names = append(names, n)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, version := range tfmodule.RequiredProviders[name].VersionConstraints {
+			requirements = append(requirements, &Requirement{
+				Name:    name,
+				Version: types.String(version),
+			})
+		}
+	}
+	return requirements
+}
+
+// loadCoreVersion returns 'required_version' constraint of Terraform declared
+// in any 'terraform' block and the exact version of Terraform pinned in the
+// '.terraform-version' file (i.e. used by tfenv), if any.
+func loadCoreVersion(tfmodule *tfconfig.Module, config *print.Config) (string, string, error) {
+	if !config.Settings.ShowCoreVersion {
+		return "", "", nil
+	}
+
+	required := strings.Join(tfmodule.RequiredCore, ", ")
+
+	filename := filepath.Join(config.ModuleRoot, ".terraform-version")
+	content, err := ioutil.ReadFile(filepath.Clean(filename))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return required, "", nil // absorb the error, .terraform-version is optional
+		}
+		return "", "", err
+	}
+
+	return required, strings.TrimSpace(string(content)), nil
+}
+
+func loadCompatibilityMatrix(config *print.Config) ([]*Compatibility, error) {
+	matrix := make([]*Compatibility, 0)
+
+	if !config.Settings.ProviderSourceVersionMatrix {
+		return matrix, nil
+	}
+
+	filename := filepath.Join(config.ModuleRoot, "compatibility_matrix.yml")
+	content, err := ioutil.ReadFile(filepath.Clean(filename))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return matrix, nil // absorb the error, compatibility matrix is optional
+		}
+		return nil, err
+	}
+
+	type combination struct {
+		Terraform string            `yaml:"terraform"`
+		Providers map[string]string `yaml:"providers"`
+	}
+	var combinations []combination
+
+	if err := yaml.Unmarshal(content, &combinations); err != nil {
+		return nil, fmt.Errorf("unable to decode compatibility matrix, %w", err)
+	}
+
+	for _, c := range combinations {
+		names := make([]string, 0, len(c.Providers))
+		for n := range c.Providers {
+			names = append(names, n)
+		}
+
+		sort.Strings(names)
+
+		providers := make([]*CompatibilityProvider, 0, len(names))
+		for _, name := range names {
+			providers = append(providers, &CompatibilityProvider{
+				Name:    name,
+				Version: types.String(c.Providers[name]),
+			})
+		}
+
+		matrix = append(matrix, &Compatibility{
+			Terraform: types.String(c.Terraform),
+			Providers: providers,
+		})
+	}
+
+	return matrix, nil
+}
+
+func loadLicense(config *print.Config) (string, error) {
+	if !config.Settings.License {
+		return "", nil
+	}
+
+	for _, name := range licenseFiles {
+		filename := filepath.Join(config.ModuleRoot, name)
+		content, err := ioutil.ReadFile(filepath.Clean(filename))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return "", err
+		}
+		return detectLicense(string(content)), nil
+	}
+
+	return "", nil // absorb the error, license is optional
+}
+
+// loadPurpose returns the first sentence of the header of the module, which is
+// read from 'header-from' even if the header section itself is hidden.
+func loadPurpose(config *print.Config, header string) (string, error) {
+	if !config.Settings.ModulePurpose {
+		return "", nil
+	}
+	if !config.Sections.Header {
+		var err error
+		if header, err = loadSection(config, config.HeaderFrom, "header"); err != nil {
+			return "", err
+		}
+	}
+	return parsePurpose(header), nil
+}
+
+func loadChangelog(config *print.Config) (string, error) {
+	if !config.Changelog.Enabled {
+		return "", nil
+	}
+
+	filename := filepath.Join(config.ModuleRoot, changelogFile)
+	content, err := ioutil.ReadFile(filepath.Clean(filename))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil // absorb the error, not all the modules have changelog
+		}
+		return "", err
+	}
+
+	return parseChangelog(string(content), config.Changelog.Versions), nil
+}
+
+// loadExcludes returns the patterns of the inputs and outputs to exclude, which
+// are the ones of CLI flags along with the ones read from the file of
+// '--exclude-file' (relative to module root).
+func loadExcludes(config *print.Config) (*excludes, error) {
+	e := &excludes{
+		Variables: append([]string{}, config.Exclude.Variables...),
+		Outputs:   append([]string{}, config.Exclude.Outputs...),
+	}
+	if config.Exclude.File == "" {
+		return e, nil
+	}
+
+	filename := config.Exclude.File
+	if !filepath.IsAbs(filename) {
+		filename = filepath.Join(config.ModuleRoot, filename)
+	}
+
+	content, err := ioutil.ReadFile(filepath.Clean(filename))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read exclude file, %w", err)
+	}
+
+	file, err := parseExcludes(content)
+	if err != nil {
+		return nil, err
+	}
+	e.Variables = append(e.Variables, file.Variables...)
+	e.Outputs = append(e.Outputs, file.Outputs...)
+
+	return e, nil
+}
+
+// loadRedactedDefaults replaces the value merged from the files of
+// '--with-var-file-defaults' of the inputs declared with 'sensitive = true' with
+// '[REDACTED]'. The default value, and the merged value, of the inputs matching
+// the patterns of '--sensitive-patterns' (or the default ones) are redacted too
+// if '--with-sensitive-defaults-redacted' is set.
+func loadRedactedDefaults(config *print.Config, files []*hcl.File, inputs []*Input) error {
+	if len(config.VarFileDefaults) > 0 {
+		sensitive, _, err := loadVariableAttributes(files)
+		if err != nil {
+			return err
+		}
+		redactSensitiveEnvDefaults(inputs, sensitive)
+	}
+
+	if !config.Sensitive.RedactDefaults {
+		return nil
+	}
+
+	patterns, err := compileSensitivePatterns(config.Sensitive.Patterns)
+	if err != nil {
+		return err
+	}
+	redactDefaults(inputs, patterns)
+
+	return nil
+}
+
+// loadDefaultKinds annotates the inputs with the kind of their default value as
+// declared in their 'variable' block, if '--with-show-defaults-type' is set. The
+// inputs without default are left as is.
+func loadDefaultKinds(config *print.Config, files []*hcl.File, inputs []*Input) error {
+	if !config.Settings.ShowDefaultsType {
+		return nil
+	}
+
+	variableSchema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{
+			{Type: "variable", LabelNames: []string{"name"}},
+		},
+	}
+	defaultSchema := &hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{
+			{Name: "default"},
+		},
+	}
+
+	kinds := make(map[string]string)
+	for _, file := range files {
+		content, _, diags := file.Body.PartialContent(variableSchema)
+		if diags.HasErrors() {
+			return diags
+		}
+
+		for _, block := range content.Blocks {
+			attrs, _, diags := block.Body.PartialContent(defaultSchema)
+			if diags.HasErrors() {
+				return diags
+			}
+			if attr, ok := attrs.Attributes["default"]; ok {
+				kinds[block.Labels[0]] = defaultKind(attr.Expr)
+			}
+		}
+	}
+
+	for _, input := range inputs {
+		input.DefaultKind = kinds[input.Name]
+	}
+
+	return nil
+}
+
+// loadInputHistory attaches the previous descriptions of the inputs to them, read
+// from the Git history of the files which they're declared in, if
+// '--with-input-history' is set.
+func loadInputHistory(config *print.Config, inputs []*Input) error {
+	if !config.Settings.InputHistory {
+		return nil
+	}
+
+	files := make(map[string][]*Input)
+	for _, input := range inputs {
+		if input.Position.Filename != "" {
+			files[input.Position.Filename] = append(files[input.Position.Filename], input)
+		}
+	}
+
+	for filename, items := range files {
+		history, err := loadDescriptionHistory(filename)
+		if err != nil {
+			return err
+		}
+		for _, input := range items {
+			if changes, ok := history[input.Name]; ok {
+				input.DescriptionHistory = changes
+			}
+		}
+	}
+
+	return nil
+}
+
+// loadDescriptionHistory reads every revision of 'filename' in its Git history
+// and returns the previous descriptions of the variables declared in it.
+func loadDescriptionHistory(filename string) (map[string][]*DescriptionChange, error) {
+	dir, file := filepath.Dir(filename), filepath.Base(filename)
+
+	cmd := exec.Command("git", "log", "--format=%H %ad", "--date=short", "--", file) //nolint:gosec
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("caught error while running the git log: %w", err)
+	}
+
+	revisions := make([]*fileRevision, 0)
+	for _, commit := range parseCommits(out) {
+		cmd := exec.Command("git", "show", commit[0]+":./"+file) //nolint:gosec
+		cmd.Dir = dir
+		content, err := cmd.Output()
+		if err != nil {
+			continue // e.g. the file doesn't exist with this name at the commit
+		}
+		revisions = append(revisions, &fileRevision{
+			Date:         commit[1],
+			Descriptions: parseVariableDescriptions(file, content),
+		})
+	}
+
+	return descriptionHistory(revisions), nil
+}
+
+// loadVarFileDefaults attaches the values of the inputs merged from the tfvars
+// files of '--with-var-file-defaults' (relative to module root) to the inputs.
+// The files are applied in order, i.e. the later ones override the earlier ones
+// as Terraform does.
+func loadVarFileDefaults(config *print.Config, inputs []*Input) error {
+	if len(config.VarFileDefaults) == 0 {
+		return nil
+	}
+
+	merged := make(map[string]types.Value)
+	for _, file := range config.VarFileDefaults {
+		filename := file
+		if !filepath.IsAbs(filename) {
+			filename = filepath.Join(config.ModuleRoot, filename)
+		}
+
+		values, err := parseVarFile(filepath.Clean(filename))
+		if err != nil {
+			return fmt.Errorf("unable to read var file '%s', %w", file, err)
+		}
+		for name, value := range values {
+			merged[name] = value
+		}
+	}
+
+	for _, input := range inputs {
+		input.EnvDefault = merged[input.Name]
+	}
+
+	return nil
+}
+
+// loadRegions annotates the inputs which are region of a cloud provider with
+// the cloud, if '--with-auto-detect-regions' is set.
+func loadRegions(config *print.Config, inputs []*Input, providers []*Provider) {
+	if !config.Settings.AutoDetectRegions {
+		return
+	}
+	for _, input := range inputs {
+		input.Cloud = detectRegionCloud(input, providers)
+	}
+}
+
+// loadInputValidations attaches the 'validation' blocks of the variables to the
+// inputs, if '--show-validations' is set, which are not known to
+// terraform-config-inspect.
+func loadInputValidations(config *print.Config, files []*hcl.File, inputs []*Input) error {
+	if !config.Settings.ShowValidations {
+		return nil
+	}
+
+	variableSchema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{
+			{Type: "variable", LabelNames: []string{"name"}},
+		},
+	}
+	validationSchema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{
+			{Type: "validation"},
+		},
+	}
+	conditionSchema := &hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{
+			{Name: "condition", Required: true},
+			{Name: "error_message", Required: true},
+		},
+	}
+
+	validations := make(map[string][]*Condition)
+	for _, file := range files {
+		content, _, diags := file.Body.PartialContent(variableSchema)
+		if diags.HasErrors() {
+			return diags
+		}
+
+		for _, block := range content.Blocks {
+			blocks, _, diags := block.Body.PartialContent(validationSchema)
+			if diags.HasErrors() {
+				return diags
+			}
+
+			for _, validation := range blocks.Blocks {
+				attrs, _, diags := validation.Body.PartialContent(conditionSchema)
+				if diags.HasErrors() {
+					return diags
+				}
+
+				name := block.Labels[0]
+				validations[name] = append(validations[name], &Condition{
+					Expression:   types.String(expressionSource(attrs.Attributes["condition"].Expr, file.Bytes)),
+					ErrorMessage: types.String(decodeErrorMessage(attrs.Attributes["error_message"].Expr, file.Bytes)),
+				})
+			}
+		}
+	}
+
+	for _, input := range inputs {
+		input.Validations = conditionsOf(validations, input.Name)
+	}
+
+	return nil
+}
+
+// loadInputAttributes attaches the 'sensitive' and 'nullable' attributes of the
+// variables to the inputs, if '--show-attributes' is set, which are not known
+// to terraform-config-inspect. Variables are nullable unless declared otherwise.
+func loadInputAttributes(config *print.Config, files []*hcl.File, inputs []*Input) error {
+	if !config.Settings.ShowAttributes {
+		return nil
+	}
+
+	sensitive, nullable, err := loadVariableAttributes(files)
+	if err != nil {
+		return err
+	}
+
+	for _, input := range inputs {
+		value, ok := nullable[input.Name]
+		if !ok {
+			value = true
+		}
+		input.Sensitive = sensitive[input.Name]
+		input.Nullable = &value
+	}
+
+	return nil
+}
+
+// loadDeprecations attaches the deprecation messages read from the file of
+// '--with-module-deprecations-file' (relative to module root) to the inputs.
+func loadDeprecations(config *print.Config, inputs []*Input) error {
+	if config.DeprecationsFrom == "" {
+		return nil
+	}
+
+	filename := config.DeprecationsFrom
+	if !filepath.IsAbs(filename) {
+		filename = filepath.Join(config.ModuleRoot, filename)
+	}
+
+	content, err := ioutil.ReadFile(filepath.Clean(filename))
+	if err != nil {
+		return fmt.Errorf("unable to read deprecations file, %w", err)
+	}
+
+	deprecations := parseDeprecations(string(content))
+	for _, input := range inputs {
+		input.Deprecated = deprecations[input.Name]
+	}
+
+	return nil
+}
+
+func loadChecks(config *print.Config, files []*hcl.File) ([]*Check, error) {
+	checks := make([]*Check, 0)
+
+	if !config.Settings.ShowChecks || !supportsFeature(config, checkBlocksSince) {
+		return checks, nil
+	}
+
+	checkSchema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{
+			{Type: "check", LabelNames: []string{"name"}},
+		},
+	}
+	assertSchema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{
+			{Type: "assert"},
+		},
+	}
+	conditionSchema := &hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{
+			{Name: "condition", Required: true},
+			{Name: "error_message", Required: true},
+		},
+	}
+
+	for _, file := range files {
+		content, _, diags := file.Body.PartialContent(checkSchema)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+
+		for _, block := range content.Blocks {
+			asserts, _, diags := block.Body.PartialContent(assertSchema)
+			if diags.HasErrors() {
+				return nil, diags
+			}
+
+			for _, assert := range asserts.Blocks {
+				attrs, _, diags := assert.Body.PartialContent(conditionSchema)
+				if diags.HasErrors() {
+					return nil, diags
+				}
+
+				condition := attrs.Attributes["condition"].Expr
+				message := attrs.Attributes["error_message"].Expr
+
+				checks = append(checks, &Check{
+					Name:         block.Labels[0],
+					Condition:    types.String(expressionSource(condition, file.Bytes)),
+					ErrorMessage: types.String(decodeErrorMessage(message, file.Bytes)),
+					Position: Position{
+						Filename: assert.DefRange.Filename,
+						Line:     assert.DefRange.Start.Line,
+					},
+				})
+			}
+		}
+	}
+
+	return checks, nil
+}
+
+// loadSensitiveSummary returns the counts of the inputs and outputs which are
+// declared with 'sensitive = true', or nil if there's none of them.
+func loadSensitiveSummary(tfmodule *tfconfig.Module, config *print.Config, files []*hcl.File, inputs []*Input, outputs []*Output) (*SensitiveSummary, error) {
+	if !config.Settings.SensitiveSummary {
+		return nil, nil
+	}
+
+	variables, _, err := loadVariableAttributes(files)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &SensitiveSummary{}
+	for _, input := range inputs {
+		if variables[input.Name] {
+			summary.Inputs++
+		}
+	}
+	for _, output := range outputs {
+		if o, ok := tfmodule.Outputs[output.Name]; ok && o.Sensitive {
+			summary.Outputs++
+		}
+	}
+
+	if summary.Inputs == 0 && summary.Outputs == 0 {
+		return nil, nil
+	}
+	return summary, nil
+}
+
+// loadVariableAttributes returns the values of 'sensitive' and 'nullable'
+// attributes of the variables, by their name, which are not known to
+// terraform-config-inspect. Variables without the attribute (or with a value
+// which is not a literal bool) are not included.
+func loadVariableAttributes(files []*hcl.File) (map[string]bool, map[string]bool, error) {
+	variableSchema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{
+			{Type: "variable", LabelNames: []string{"name"}},
+		},
+	}
+	attributesSchema := &hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{
+			{Name: "sensitive"},
+			{Name: "nullable"},
+		},
+	}
+
+	sensitive := make(map[string]bool)
+	nullable := make(map[string]bool)
+	for _, file := range files {
+		content, _, diags := file.Body.PartialContent(variableSchema)
+		if diags.HasErrors() {
+			return nil, nil, diags
+		}
+
+		for _, block := range content.Blocks {
+			attrs, _, diags := block.Body.PartialContent(attributesSchema)
+			if diags.HasErrors() {
+				return nil, nil, diags
+			}
+
+			name := block.Labels[0]
+			for attrName, values := range map[string]map[string]bool{"sensitive": sensitive, "nullable": nullable} {
+				attr, ok := attrs.Attributes[attrName]
+				if !ok {
+					continue
+				}
+				var value bool
+				if diags := gohcl.DecodeExpression(attr.Expr, nil, &value); diags.HasErrors() {
+					continue
+				}
+				values[name] = value
+			}
+		}
+	}
+
+	return sensitive, nullable, nil
+}
+
+// loadVariableSummary returns the counts of the inputs, after the excluded ones
+// are filtered out, if '--with-variable-summary-table' is set.
+func loadVariableSummary(config *print.Config, files []*hcl.File, inputs []*Input) (*VariableSummary, error) {
+	if !config.Settings.VariableSummaryTable {
+		return nil, nil
+	}
+
+	sensitive, _, err := loadVariableAttributes(files)
+	if err != nil {
+		return nil, err
+	}
+	return NewVariableSummary(inputs, sensitive), nil
+}
+
+// loadBackend returns the S3 backend of the module declared in its 'terraform'
+// block, or nil if it doesn't store its state in S3.
+func loadBackend(config *print.Config, files []*hcl.File) (*Backend, error) {
+	if !config.Settings.S3BackendDocs {
+		return nil, nil
+	}
+
+	terraformSchema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{
+			{Type: "terraform"},
+		},
+	}
+	backendSchema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{
+			{Type: "backend", LabelNames: []string{"type"}},
+		},
+	}
+
+	for _, file := range files {
+		content, _, diags := file.Body.PartialContent(terraformSchema)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+
+		for _, block := range content.Blocks {
+			backends, _, diags := block.Body.PartialContent(backendSchema)
+			if diags.HasErrors() {
+				return nil, diags
+			}
+
+			for _, backend := range backends.Blocks {
+				if backend.Labels[0] != backendTypeS3 {
+					continue
+				}
+
+				attrs, diags := backend.Body.JustAttributes()
+				if diags.HasErrors() {
+					return nil, diags
+				}
+
+				attributes := make(map[string]string, len(attrs))
+				for name, attr := range attrs {
+					attributes[name] = string(attr.Expr.Range().SliceBytes(file.Bytes))
+				}
+				return newS3Backend(attributes), nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// loadHCLFiles parses all the '.tf' and '.tf.json' files of the module in 'dir',
+// to be used for the blocks which are not supported by terraform-config-inspect.
+func loadHCLFiles(dir string) ([]*hcl.File, error) {
+	filenames := make([]string, 0)
+	for _, pattern := range []string{"*.tf", "*.tf.json"} {
+		matches, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return nil, err
+		}
+		filenames = append(filenames, matches...)
+	}
+
+	return parseHCLFiles(dir, filenames)
+}
+
+// parseHCLFiles parses all the 'filenames' and returns the errors of all of
+// them together (with their file relative to the module 'root') if any.
+func parseHCLFiles(root string, filenames []string) ([]*hcl.File, error) {
+	parser := hclparse.NewParser()
+	files := make([]*hcl.File, 0, len(filenames))
+
+	var diags hcl.Diagnostics
+	for _, filename := range filenames {
+		parse := parser.ParseHCLFile
+		if strings.HasSuffix(filename, ".json") {
+			parse = parser.ParseJSONFile
+		}
+		file, fdiags := parse(filename)
+		diags = append(diags, fdiags...)
+		files = append(files, file)
+	}
+	if err := newParseErrors(root, diags); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// loadMoved returns the 'moved' blocks of the module, in the same order as they
+// are declared in the files. Nothing is returned if the target version of
+// Terraform (i.e. '--terraform-version') doesn't support them.
+func loadMoved(config *print.Config, files []*hcl.File) ([]*Moved, error) {
+	moved := make([]*Moved, 0)
+
+	if !supportsFeature(config, movedBlocksSince) {
+		return moved, nil
+	}
+
+	movedSchema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{
+			{Type: "moved"},
+		},
+	}
+	addressSchema := &hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{
+			{Name: "from", Required: true},
+			{Name: "to", Required: true},
+		},
+	}
+
+	for _, file := range files {
+		content, _, diags := file.Body.PartialContent(movedSchema)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+
+		for _, block := range content.Blocks {
+			attrs, _, diags := block.Body.PartialContent(addressSchema)
+			if diags.HasErrors() {
+				return nil, diags
+			}
+
+			from := attrs.Attributes["from"].Expr
+			to := attrs.Attributes["to"].Expr
+
+			moved = append(moved, &Moved{
+				From: types.String(expressionSource(from, file.Bytes)),
+				To:   types.String(expressionSource(to, file.Bytes)),
+				Position: Position{
+					Filename: block.DefRange.Filename,
+					Line:     block.DefRange.Start.Line,
+				},
+			})
+		}
+	}
+
+	return moved, nil
+}
+
+// loadEphemeralResources returns the 'ephemeral' blocks of the module. These are
+// not known to terraform-config-inspect, so they're read from the files directly
+// and their provider is resolved the same way as the one of resources, i.e. from
+// 'provider' argument if set or prefix of their type otherwise.
+func loadEphemeralResources(tfmodule *tfconfig.Module, config *print.Config, files []*hcl.File) ([]*EphemeralResource, error) {
+	resources := make([]*EphemeralResource, 0)
+
+	if !config.Settings.ShowEphemeralResources || !supportsFeature(config, ephemeralResourcesSince) {
+		return resources, nil
+	}
+
+	ephemeralSchema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{
+			{Type: "ephemeral", LabelNames: []string{"type", "name"}},
+		},
+	}
+	providerSchema := &hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{
+			{Name: "provider"},
+		},
+	}
+
+	for _, file := range files {
+		content, _, diags := file.Body.PartialContent(ephemeralSchema)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+
+		for _, block := range content.Blocks {
+			attrs, _, diags := block.Body.PartialContent(providerSchema)
+			if diags.HasErrors() {
+				return nil, diags
+			}
+
+			rType := block.Labels[0]
+
+			provider := rType
+			if i := strings.Index(rType, "_"); i != -1 {
+				provider = rType[:i]
+			}
+			if attr, ok := attrs.Attributes["provider"]; ok {
+				if traversal, diags := hcl.AbsTraversalForExpr(attr.Expr); !diags.HasErrors() {
+					provider = traversal.RootName()
+				}
+			}
+
+			version := ""
+			source := fmt.Sprintf("%s/%s", "hashicorp", provider)
+			if rv, ok := tfmodule.RequiredProviders[provider]; ok {
+				version = resourceVersion(rv.VersionConstraints)
+				if len(rv.Source) > 0 {
+					source = rv.Source
+				}
+			}
+
+			description := ""
+			if config.Settings.ReadComments {
+				description = loadComments(block.DefRange.Filename, block.DefRange.Start.Line)
+			}
+
+			resources = append(resources, &EphemeralResource{
+				Type:           strings.TrimPrefix(rType, provider+"_"),
+				Name:           block.Labels[1],
+				ProviderName:   provider,
+				ProviderSource: source,
+				Version:        types.String(version),
+				Description:    types.String(description),
+				Position: Position{
+					Filename: block.DefRange.Filename,
+					Line:     block.DefRange.Start.Line,
+				},
+			})
+		}
+	}
+
+	return resources, nil
+}
+
+// loadTestResults runs 'terraform test -json' in the module root and returns the
+// results of its tests, if '--with-run-tests' is set and the module has any test
+// file (i.e. '*.tftest.hcl' in module root or 'tests' folder).
+func loadTestResults(config *print.Config) ([]*TestSuite, error) {
+	results := make([]*TestSuite, 0)
+
+	if !config.Settings.RunTests {
+		return results, nil
+	}
+
+	filenames := make([]string, 0)
+	for _, pattern := range []string{"*.tftest.hcl", filepath.Join("tests", "*.tftest.hcl")} {
+		matches, err := filepath.Glob(filepath.Join(config.ModuleRoot, pattern))
+		if err != nil {
+			return nil, err
+		}
+		filenames = append(filenames, matches...)
+	}
+	if len(filenames) == 0 {
+		return results, nil
+	}
+
+	cmd := exec.Command("terraform", "test", "-json")
+	cmd.Dir = config.ModuleRoot
+	out, err := cmd.Output()
+
+	// 'terraform test' exits with non-zero code if any of the tests fails, which
+	// is already reported in its output
+	var exitErr *exec.ExitError
+	if err != nil && (!errors.As(err, &exitErr) || len(out) == 0) {
+		return nil, fmt.Errorf("caught error while running the terraform test: %w", err)
+	}
+
+	return parseTestResults(out)
+}
+
+// loadTestRuns returns the 'run' blocks of Terraform test files found in 'tests'
+// folder of the module, with their 'variables' and 'assert' blocks. Variables
+// declared at the top level of the file apply to all the 'run' blocks, unless
+// they're overridden.
+func loadTestRuns(config *print.Config) ([]*TestRun, error) {
+	runs := make([]*TestRun, 0)
+
+	if !config.Settings.TftestExamples {
+		return runs, nil
+	}
+
+	filenames, err := filepath.Glob(filepath.Join(config.ModuleRoot, "tests", "*.tftest.hcl"))
+	if err != nil {
+		return nil, err
+	}
+
+	fileSchema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{
+			{Type: "variables"},
+			{Type: "run", LabelNames: []string{"name"}},
+		},
+	}
+	blockSchema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{
+			{Type: "variables"},
+			{Type: "assert"},
+		},
+	}
+	conditionSchema := &hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{
+			{Name: "condition", Required: true},
+			{Name: "error_message", Required: true},
+		},
+	}
+
+	files, err := parseHCLFiles(config.ModuleRoot, filenames)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, file := range files {
+		filename := filenames[i]
+
+		content, _, diags := file.Body.PartialContent(fileSchema)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+
+		global := make([]*TestVariable, 0)
+		for _, block := range content.Blocks {
+			if block.Type != "variables" {
+				continue
+			}
+			variables, diags := loadTestVariables(block, file.Bytes)
+			if diags.HasErrors() {
+				return nil, diags
+			}
+			global = mergeTestVariables(global, variables)
+		}
+
+		for _, block := range content.Blocks {
+			if block.Type != "run" {
+				continue
+			}
+
+			run := &TestRun{
+				Name:       block.Labels[0],
+				Filename:   filepath.ToSlash(filepath.Join("tests", filepath.Base(filename))),
+				Variables:  global,
+				Assertions: make([]*TestAssertion, 0),
+			}
+
+			inner, _, diags := block.Body.PartialContent(blockSchema)
+			if diags.HasErrors() {
+				return nil, diags
+			}
+
+			for _, b := range inner.Blocks {
+				switch b.Type {
+				case "variables":
+					variables, diags := loadTestVariables(b, file.Bytes)
+					if diags.HasErrors() {
+						return nil, diags
+					}
+					run.Variables = mergeTestVariables(run.Variables, variables)
+				case "assert":
+					attrs, _, diags := b.Body.PartialContent(conditionSchema)
+					if diags.HasErrors() {
+						return nil, diags
+					}
+
+					condition := attrs.Attributes["condition"].Expr
+					message := attrs.Attributes["error_message"].Expr
+
+					run.Assertions = append(run.Assertions, &TestAssertion{
+						Condition:    types.String(condition.Range().SliceBytes(file.Bytes)),
+						ErrorMessage: types.String(decodeErrorMessage(message, file.Bytes)),
+					})
+				}
+			}
+
+			runs = append(runs, run)
+		}
+	}
+
+	return runs, nil
+}
+
+// loadTestVariables returns the assignments of 'variables' block in the same
+// order as they are declared in the file.
+func loadTestVariables(block *hcl.Block, src []byte) ([]*TestVariable, hcl.Diagnostics) {
+	attrs, diags := block.Body.JustAttributes()
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	variables := make([]*TestVariable, 0, len(attrs))
+	for _, attr := range sortedAttributes(attrs) {
+		variables = append(variables, &TestVariable{
+			Name:  attr.Name,
+			Value: types.String(dedentExpression(attr.Expr.Range().SliceBytes(src), attr.Range.Start.Column)),
+		})
+	}
+	return variables, nil
+}
+
+// mergeTestVariables returns new list of variables with the ones in 'overrides'
+// replacing the ones with the same name in 'base', or appended otherwise.
+func mergeTestVariables(base []*TestVariable, overrides []*TestVariable) []*TestVariable {
+	merged := make([]*TestVariable, len(base))
+	copy(merged, base)
+
+	for _, o := range overrides {
+		found := false
+		for i, v := range merged {
+			if v.Name == o.Name {
+				merged[i] = o
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, o)
+		}
+	}
+	return merged
+}
+
+// sortedAttributes returns the attributes in the same order as they are
+// declared in the file.
+func sortedAttributes(attrs hcl.Attributes) []*hcl.Attribute {
+	sorted := make([]*hcl.Attribute, 0, len(attrs))
+	for _, attr := range attrs {
+		sorted = append(sorted, attr)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Range.Start.Byte < sorted[j].Range.Start.Byte
+	})
+	return sorted
+}
+
+// dedentExpression removes the indentation of the attribute, which starts at
+// 'column', from the subsequent lines of multi-line expression (e.g. map).
+func dedentExpression(expr []byte, column int) string {
+	lines := strings.Split(string(expr), "\n")
+	prefix := strings.Repeat(" ", column-1)
+	for i := 1; i < len(lines); i++ {
+		lines[i] = strings.TrimPrefix(lines[i], prefix)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// decodeErrorMessage returns the value of 'error_message' if it's a literal
+// string, otherwise returns the expression as is (e.g. with interpolation).
+func decodeErrorMessage(expr hcl.Expression, src []byte) string {
+	var message string
+	if diags := gohcl.DecodeExpression(expr, nil, &message); diags.HasErrors() {
+		return expressionSource(expr, src)
+	}
+	return message
+}
+
+// expressionSource returns the source of 'expr' as it's written in 'src'. The
+// expressions of JSON files (i.e. '*.tf.json') are strings, which are returned
+// unquoted and without the wrapping '${...}' if the whole string is a single
+// interpolation, e.g. '"${length(var.name) > 0}"' is returned as
+// 'length(var.name) > 0'.
+func expressionSource(expr hcl.Expression, src []byte) string {
+	source := expr.Range().SliceBytes(src)
+	if !strings.HasSuffix(expr.Range().Filename, ".json") {
+		return string(source)
+	}
+
+	var value string
+	if err := json.Unmarshal(source, &value); err != nil {
+		return string(source) // not a string, e.g. number or bool
+	}
+	if strings.HasPrefix(value, "${") && strings.HasSuffix(value, "}") && strings.Count(value, "${") == 1 {
+		return strings.TrimSpace(value[2 : len(value)-1])
+	}
+	return value
+}
+
+// loadTagCompliance checks 'tags' of the managed resources against the required
+// tags of 'tag_policy.yml' in module root, if '--with-tag-policy' is set. Nothing
+// is checked if the file doesn't exist.
+func loadTagCompliance(config *print.Config, files []*hcl.File, resources []*Resource) error {
+	if !config.Settings.TagPolicy {
+		return nil
+	}
+
+	filename := filepath.Join(config.ModuleRoot, "tag_policy.yml")
+	content, err := ioutil.ReadFile(filepath.Clean(filename))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil // absorb the error, tag policy is optional
+		}
+		return err
+	}
+	required, err := parseTagPolicy(content)
+	if err != nil {
+		return err
+	}
+
+	resourceSchema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{
+			{Type: "resource", LabelNames: []string{"type", "name"}},
+		},
+	}
+	tagsSchema := &hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{
+			{Name: "tags"},
+		},
+	}
+
+	compliance := make(map[string]*TagCompliance)
+	for _, file := range files {
+		content, _, diags := file.Body.PartialContent(resourceSchema)
+		if diags.HasErrors() {
+			return diags
+		}
+
+		for _, block := range content.Blocks {
+			attrs, _, diags := block.Body.PartialContent(tagsSchema)
+			if diags.HasErrors() {
+				return diags
+			}
+			compliance[block.Labels[0]+"."+block.Labels[1]] = checkTags(attrs.Attributes["tags"], required)
+		}
+	}
+
+	for _, r := range resources {
+		if r.Mode == "managed" {
+			r.TagCompliance = compliance[r.Spec()]
+		}
+	}
+
+	return nil
+}
+
+func loadResources(tfmodule *tfconfig.Module, config *print.Config) []*Resource {
+	allResources := []map[string]*tfconfig.Resource{tfmodule.ManagedResources, tfmodule.DataResources}
 	discovered := make(map[string]*Resource)
 
 	for _, resource := range allResources {
@@ -483,6 +2295,12 @@ func resourceVersion(constraints []string) string {
 }
 
 func loadComments(filename string, lineNum int) string {
+	return strings.Join(loadCommentLines(filename, lineNum), " ")
+}
+
+// loadCommentLines returns the lines of the comment immediately before the
+// 'lineNum' of 'filename' without their '#' or '//' prefix.
+func loadCommentLines(filename string, lineNum int) []string {
 	lines := reader.Lines{
 		FileName: filename,
 		LineNum:  lineNum,
@@ -499,9 +2317,9 @@ func loadComments(filename string, lineNum int) string {
 	}
 	comment, err := lines.Extract()
 	if err != nil {
-		return "" // absorb the error, we don't need to bubble it up or break the execution
+		return nil // absorb the error, we don't need to bubble it up or break the execution
 	}
-	return strings.Join(comment, " ")
+	return comment
 }
 
 func sortItems(tfmodule *Module, config *print.Config) {
@@ -510,14 +2328,23 @@ func sortItems(tfmodule *Module, config *print.Config) {
 	inputs(tfmodule.RequiredInputs).sort(config.Sort.Enabled, config.Sort.By)
 	inputs(tfmodule.OptionalInputs).sort(config.Sort.Enabled, config.Sort.By)
 
+	// pinned inputs are shown at the bottom regardless of sorting
+	inputs(tfmodule.Inputs).pinBottom(config.Sort.PinnedBottomVariables)
+	inputs(tfmodule.RequiredInputs).pinBottom(config.Sort.PinnedBottomVariables)
+	inputs(tfmodule.OptionalInputs).pinBottom(config.Sort.PinnedBottomVariables)
+
 	// outputs
 	outputs(tfmodule.Outputs).sort(config.Sort.Enabled, config.Sort.By)
 
-	// providers
-	providers(tfmodule.Providers).sort(config.Sort.Enabled, config.Sort.By)
+	// providers, sorted by name if sorting is enabled or '--with-sorted-providers' is set
+	providers(tfmodule.Providers).sort(config.Sort.Enabled || config.Sort.Providers == print.SortName, config.Sort.By)
+
+	// requirements
+	requirements(tfmodule.Requirements).sort(config.Sort.Requirements)
 
 	// resources
 	resources(tfmodule.Resources).sort(config.Sort.Enabled, config.Sort.By)
+	ephemeralResources(tfmodule.EphemeralResources).sort()
 
 	// modules
 	modulecalls(tfmodule.ModuleCalls).sort(config.Sort.Enabled, config.Sort.By)
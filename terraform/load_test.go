@@ -11,13 +11,25 @@ the root directory of this source tree.
 package terraform
 
 import (
+	"context"
+	"errors"
 	"io/ioutil"
+	"os"
+	"os/exec"
 	"path/filepath"
 	"sort"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/hcl/v2"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 
+	"github.com/terraform-docs/terraform-docs/internal/types"
 	"github.com/terraform-docs/terraform-docs/print"
 )
 
@@ -49,6 +61,40 @@ func TestLoadModuleWithOptions(t *testing.T) {
 	assert.Equal(false, module.HasHeader())
 }
 
+func TestLoadWithContextSpans(t *testing.T) {
+	assert := assert.New(t)
+
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	config := print.NewConfig()
+	config.ModuleRoot = filepath.Join("testdata", "full-example")
+	config.Sort.Enabled = true
+	config.Sort.By = print.SortName
+
+	_, err := LoadWithContext(context.Background(), config)
+	assert.Nil(err)
+
+	spans := recorder.Ended()
+	names := []string{}
+	for _, span := range spans {
+		names = append(names, span.Name())
+	}
+	assert.Equal([]string{"parse", "sort", "load"}, names)
+
+	attributes := map[attribute.Key]attribute.Value{}
+	for _, attr := range spans[0].Attributes() {
+		attributes[attr.Key] = attr.Value
+	}
+	assert.Equal(int64(4), attributes["module.files"].AsInt64())
+	assert.Equal(int64(7), attributes["module.variables"].AsInt64())
+	assert.Equal(int64(3), attributes["module.outputs"].AsInt64())
+
+	assert.Equal(spans[2].SpanContext().SpanID(), spans[0].Parent().SpanID())
+	assert.Equal(spans[2].SpanContext().SpanID(), spans[1].Parent().SpanID())
+}
+
 func TestLoadModule(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -79,6 +125,60 @@ func TestLoadModule(t *testing.T) {
 	}
 }
 
+func TestLoadModuleParseErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		expected ParseErrors
+	}{
+		{
+			name: "load module with syntax errors",
+			path: "with-parse-errors",
+			expected: ParseErrors{
+				{
+					File:    "outputs.tf",
+					Line:    7,
+					Column:  2,
+					Message: "Missing newline after block definition; A block definition must end with a newline.",
+				},
+				{
+					File:    "variables.tf",
+					Line:    6,
+					Column:  13,
+					Message: "Invalid expression; Expected the start of an expression, but found an invalid expression token.",
+				},
+			},
+		},
+		{
+			name: "load module with invalid blocks",
+			path: "with-invalid-checks",
+			expected: ParseErrors{
+				{
+					File:    "main.tf",
+					Line:    6,
+					Column:  10,
+					Message: "Missing required argument; The argument \"condition\" is required, but no definition was found.",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", tt.path)
+			config.Settings.ShowChecks = true
+
+			_, err := LoadWithOptions(config)
+
+			var actual ParseErrors
+			assert.True(errors.As(err, &actual))
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
 func TestGetFileFormat(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -545,6 +645,141 @@ func TestLoadModulecalls(t *testing.T) {
 	}
 }
 
+func TestLoadModulecallsSources(t *testing.T) {
+	assert := assert.New(t)
+
+	config := print.NewConfig()
+	module, _ := loadModule(filepath.Join("testdata", "with-module-sources"))
+	modulecalls := loadModulecalls(module, config)
+
+	expected := map[string][3]string{
+		"registry":  {"terraform-aws-modules/vpc/aws", "~> 5.0", "https://registry.terraform.io/modules/terraform-aws-modules/vpc/aws"},
+		"git":       {"git::https://example.com/vpc.git", "v1.2.0", ""},
+		"local":     {"./modules/local", "", ""},
+		"gruntwork": {"git::git@github.com:gruntwork-io/terraform-aws-vpc.git//modules/vpc-app", "v0.26.0", ""},
+	}
+
+	assert.Equal(len(expected), len(modulecalls))
+	for _, m := range modulecalls {
+		assert.Equal(expected[m.Name], [3]string{m.Source, m.Version, m.RegistryURL()})
+	}
+}
+
+func TestLoadModuleCallTree(t *testing.T) {
+	tests := []struct {
+		name     string
+		enabled  bool
+		depth    int
+		expected []*ModuleCallNode
+	}{
+		{
+			name:    "load module call tree",
+			enabled: true,
+			depth:   3,
+			expected: []*ModuleCallNode{
+				{Name: "missing", Source: "./modules/missing"},
+				{
+					Name:   "network",
+					Source: "./modules/network",
+					Children: []*ModuleCallNode{
+						{
+							Name:   "vpc",
+							Source: "../vpc",
+							Children: []*ModuleCallNode{
+								{Name: "network", Source: "../network"},
+								{Name: "subnets", Source: "../subnets"},
+							},
+						},
+					},
+				},
+				{Name: "registry", Source: "terraform-aws-modules/vpc/aws"},
+			},
+		},
+		{
+			name:    "load module call tree with depth",
+			enabled: true,
+			depth:   1,
+			expected: []*ModuleCallNode{
+				{Name: "missing", Source: "./modules/missing"},
+				{Name: "network", Source: "./modules/network"},
+				{Name: "registry", Source: "terraform-aws-modules/vpc/aws"},
+			},
+		},
+		{
+			name:     "load module call tree disabled",
+			enabled:  false,
+			depth:    3,
+			expected: []*ModuleCallNode{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", "with-module-call-tree")
+			config.Settings.ModuleCallGraph = tt.enabled
+			config.Settings.CallGraphDepth = tt.depth
+
+			module, err := loadModule(config.ModuleRoot)
+			assert.Nil(err)
+
+			actual, err := loadModuleCallTree(module, config)
+			assert.Nil(err)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestLoadSourceLinks(t *testing.T) {
+	tests := []struct {
+		name     string
+		enabled  bool
+		expected map[string]string
+	}{
+		{
+			name:    "load source links",
+			enabled: true,
+			expected: map[string]string{
+				"registry":  "",
+				"git":       "",
+				"local":     "",
+				"gruntwork": "https://docs.gruntwork.io/reference/modules/terraform-aws-vpc/vpc-app/",
+			},
+		},
+		{
+			name:    "load source links disabled",
+			enabled: false,
+			expected: map[string]string{
+				"registry":  "",
+				"git":       "",
+				"local":     "",
+				"gruntwork": "",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.DefaultConfig()
+			config.Settings.GruntworkLinks = tt.enabled
+
+			module, _ := loadModule(filepath.Join("testdata", "with-module-sources"))
+			modulecalls := loadModulecalls(module, config)
+
+			err := loadSourceLinks(config, modulecalls)
+			assert.Nil(err)
+
+			actual := map[string]string{}
+			for _, m := range modulecalls {
+				actual[m.Name] = m.DocsURL
+			}
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
 func TestLoadInputsLineEnding(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -606,7 +841,7 @@ func TestLoadOutputs(t *testing.T) {
 
 			config := print.NewConfig()
 			module, _ := loadModule(filepath.Join("testdata", tt.path))
-			outputs, err := loadOutputs(module, config)
+			outputs, err := loadOutputs(module, config, nil)
 
 			assert.Nil(err)
 			assert.Equal(tt.expected.outputs, len(outputs))
@@ -618,6 +853,57 @@ func TestLoadOutputs(t *testing.T) {
 	}
 }
 
+func TestLoadOutputConsumers(t *testing.T) {
+	tests := []struct {
+		name     string
+		enabled  bool
+		expected map[string][]string
+	}{
+		{
+			name:    "load output consumers",
+			enabled: true,
+			expected: map[string][]string{
+				"vpc_id":     {"app", "web"},
+				"subnet_ids": {"web"},
+				"unused":     {},
+			},
+		},
+		{
+			name:    "load output consumers disabled",
+			enabled: false,
+			expected: map[string][]string{
+				"vpc_id":     {},
+				"subnet_ids": {},
+				"unused":     {},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", "with-output-consumers", "vpc")
+			config.Settings.OutputConsumers = tt.enabled
+
+			tfmodule, err := loadModule(config.ModuleRoot)
+			assert.Nil(err)
+
+			outputs, err := loadOutputs(tfmodule, config, loadTestHCLFiles(t, config.ModuleRoot))
+			assert.Nil(err)
+
+			err = loadOutputConsumers(config, outputs)
+			assert.Nil(err)
+
+			actual := map[string][]string{}
+			for _, o := range outputs {
+				actual[o.Name] = o.Consumers
+			}
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
 func TestLoadOutputsLineEnding(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -641,7 +927,7 @@ func TestLoadOutputsLineEnding(t *testing.T) {
 
 			config := print.NewConfig()
 			module, _ := loadModule(filepath.Join("testdata", tt.path))
-			outputs, _ := loadOutputs(module, config)
+			outputs, _ := loadOutputs(module, config, nil)
 
 			assert.Equal(1, len(outputs))
 			assert.Equal(tt.expected, string(outputs[0].Description))
@@ -693,7 +979,7 @@ func TestLoadOutputsValues(t *testing.T) {
 			config.OutputValues.From = filepath.Join("testdata", tt.path, tt.outputPath)
 
 			module, _ := loadModule(filepath.Join("testdata", tt.path))
-			outputs, err := loadOutputs(module, config)
+			outputs, err := loadOutputs(module, config, nil)
 
 			if tt.wantErr {
 				assert.NotNil(err)
@@ -709,45 +995,69 @@ func TestLoadOutputsValues(t *testing.T) {
 	}
 }
 
-func TestLoadProviders(t *testing.T) {
+func TestLoadOutputsValuesMismatch(t *testing.T) {
+	assert := assert.New(t)
+
+	config := print.NewConfig()
+	config.OutputValues.Enabled = true
+	config.OutputValues.From = filepath.Join("testdata", "full-example", "output-values-partial.json")
+
+	module, _ := loadModule(filepath.Join("testdata", "full-example"))
+	outputs, err := loadOutputs(module, config, nil)
+	assert.Nil(err)
+
 	type expected struct {
-		providers []string
+		value     types.Value
+		sensitive bool
+	}
+	actual := map[string]expected{}
+	for _, o := range outputs {
+		actual[o.Name] = expected{value: o.Value, sensitive: o.Sensitive}
+	}
+
+	// 'B' is not in the values file, and 'D' is not in the module
+	assert.Equal(map[string]expected{
+		"A": {value: types.ValueOf("a value"), sensitive: false},
+		"B": {value: nil, sensitive: false},
+		"C": {value: types.ValueOf("<sensitive>"), sensitive: true},
+	}, actual)
+}
+
+func TestLoadInputAttributes(t *testing.T) {
+	type attributes struct {
+		sensitive bool
+		nullable  bool
 	}
 	tests := []struct {
-		name     string
-		path     string
-		lockfile bool
-		expected expected
+		name    string
+		enabled bool
+		inputs  map[string]attributes
+		outputs map[string]bool
 	}{
 		{
-			name:     "load module providers from path",
-			path:     "full-example",
-			lockfile: false,
-			expected: expected{
-				providers: []string{"aws->= 2.15.0", "null-", "tls-"},
+			name:    "load input attributes",
+			enabled: true,
+			inputs: map[string]attributes{
+				"password": {sensitive: true, nullable: true},
+				"name":     {sensitive: false, nullable: false},
+				"size":     {sensitive: false, nullable: true},
 			},
-		},
-		{
-			name:     "load module providers from path",
-			path:     "with-lock-file",
-			lockfile: true,
-			expected: expected{
-				providers: []string{"aws-3.42.0", "null-3.1.0", "tls-3.1.0"},
+			outputs: map[string]bool{
+				"password": true,
+				"name":     false,
 			},
 		},
 		{
-			name:     "load module providers from path",
-			path:     "with-lock-file",
-			lockfile: false,
-			expected: expected{
-				providers: []string{"aws->= 2.15.0", "null-", "tls-"},
+			name:    "load input attributes disabled",
+			enabled: false,
+			inputs: map[string]attributes{
+				"password": {sensitive: false, nullable: true},
+				"name":     {sensitive: false, nullable: true},
+				"size":     {sensitive: false, nullable: true},
 			},
-		},
-		{
-			name: "load module providers from path",
-			path: "no-providers",
-			expected: expected{
-				providers: []string{},
+			outputs: map[string]bool{
+				"password": false,
+				"name":     false,
 			},
 		},
 	}
@@ -756,98 +1066,68 @@ func TestLoadProviders(t *testing.T) {
 			assert := assert.New(t)
 
 			config := print.NewConfig()
-			config.ModuleRoot = filepath.Join("testdata", tt.path)
-			config.Settings.LockFile = tt.lockfile
+			config.ModuleRoot = filepath.Join("testdata", "with-input-attributes")
+			config.Settings.ShowAttributes = tt.enabled
 
-			module, _ := loadModule(filepath.Join("testdata", tt.path))
-			providers := loadProviders(module, config)
+			tfmodule, err := loadModule(config.ModuleRoot)
+			assert.Nil(err)
 
-			actual := []string{}
+			module, err := loadModuleItems(tfmodule, config)
+			assert.Nil(err)
 
-			for _, p := range providers {
-				actual = append(actual, p.FullName()+"-"+string(p.Version))
+			assert.Equal(len(tt.inputs), len(module.Inputs))
+			for _, i := range module.Inputs {
+				assert.Equal(tt.inputs[i.Name].sensitive, i.Sensitive)
+				assert.Equal(tt.inputs[i.Name].nullable, i.IsNullable())
+				assert.Equal(tt.enabled, i.Nullable != nil)
 			}
-			sort.Strings(actual)
-
-			assert.Equal(tt.expected.providers, actual)
-		})
-	}
-}
 
-func TestLoadComments(t *testing.T) {
-	tests := []struct {
-		name       string
-		path       string
-		fileName   string
-		lineNumber int
-		expected   string
-	}{
-		{
-			name:       "load resource comment from file",
-			path:       "full-example",
-			fileName:   "variables.tf",
-			lineNumber: 2,
-			expected:   "D description",
-		},
-		{
-			name:       "load resource comment from file",
-			path:       "full-example",
-			fileName:   "variables.tf",
-			lineNumber: 16,
-			expected:   "A Description in multiple lines",
-		},
-		{
-			name:       "load resource comment from file with wrong line number",
-			path:       "full-example",
-			fileName:   "variables.tf",
-			lineNumber: 100,
-			expected:   "",
-		},
-		{
-			name:       "load resource comment from non existing file",
-			path:       "full-example",
-			fileName:   "non-exist.tf",
-			lineNumber: 5,
-			expected:   "",
-		},
-		{
-			name:       "load resource comment from non existing file",
-			path:       "non-exist",
-			fileName:   "variables.tf",
-			lineNumber: 5,
-			expected:   "",
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			assert := assert.New(t)
-			actual := loadComments(filepath.Join("testdata", tt.path, tt.fileName), tt.lineNumber)
-			assert.Equal(tt.expected, actual)
+			assert.Equal(len(tt.outputs), len(module.Outputs))
+			for _, o := range module.Outputs {
+				assert.Equal(tt.outputs[o.Name], o.Sensitive)
+				assert.Equal(tt.enabled, o.ShowSensitive)
+			}
 		})
 	}
 }
 
-func TestReadComments(t *testing.T) {
+func TestLoadInputValidations(t *testing.T) {
 	tests := []struct {
-		name         string
-		path         string
-		fileName     string
-		readComments bool
-		expected     string
+		name     string
+		enabled  bool
+		expected map[string][]*Condition
 	}{
 		{
-			name:         "Validate description when 'ReadComments' is false",
-			path:         "read-comments",
-			fileName:     "variables.tf",
-			readComments: false,
-			expected:     "",
+			name:    "load input validations",
+			enabled: true,
+			expected: map[string][]*Condition{
+				"name": {
+					{
+						Expression:   types.String("length(var.name) > 0"),
+						ErrorMessage: types.String("The name must not be empty."),
+					},
+					{
+						Expression:   types.String(`can(regex("^[a-z]+$", var.name))`),
+						ErrorMessage: types.String(`"The name of ${var.name} must be lowercase."`),
+					},
+				},
+				"size": {
+					{
+						Expression:   types.String("var.size > 0"),
+						ErrorMessage: types.String("The size must be positive."),
+					},
+				},
+				"none": {},
+			},
 		},
 		{
-			name:         "Validate description when 'ReadComments' is true",
-			path:         "read-comments",
-			fileName:     "variables.tf",
-			readComments: true,
-			expected:     "B description",
+			name:    "load input validations disabled",
+			enabled: false,
+			expected: map[string][]*Condition{
+				"name": {},
+				"size": {},
+				"none": {},
+			},
 		},
 	}
 	for _, tt := range tests {
@@ -855,148 +1135,2021 @@ func TestReadComments(t *testing.T) {
 			assert := assert.New(t)
 
 			config := print.NewConfig()
-			config.Settings.ReadComments = tt.readComments
+			config.ModuleRoot = filepath.Join("testdata", "with-input-validations")
+			config.Settings.ShowValidations = tt.enabled
 
-			module, err := loadModule(filepath.Join("testdata", tt.path))
+			tfmodule, err := loadModule(config.ModuleRoot)
+			assert.Nil(err)
 
+			module, err := loadModuleItems(tfmodule, config)
 			assert.Nil(err)
 
-			inputs, _, _ := loadInputs(module, config)
-			assert.Equal(1, len(inputs))
-			assert.Equal(tt.expected, string(inputs[0].Description))
+			assert.Equal(len(tt.expected), len(module.Inputs))
+			for _, i := range module.Inputs {
+				assert.Equal(tt.expected[i.Name], i.Validations)
+			}
+			assert.Equal(tt.enabled, module.HasInputValidations())
+		})
+	}
+}
 
-			outputs, _ := loadOutputs(module, config)
-			assert.Equal(1, len(outputs))
-			assert.Equal(tt.expected, string(outputs[0].Description))
+func TestLoadOutputConditions(t *testing.T) {
+	assert := assert.New(t)
+
+	config := print.NewConfig()
+	config.ModuleRoot = filepath.Join("testdata", "with-output-conditions")
+
+	module, err := loadModule(config.ModuleRoot)
+	assert.Nil(err)
+
+	outputs, err := loadOutputs(module, config, loadTestHCLFiles(t, config.ModuleRoot))
+	assert.Nil(err)
+
+	expected := map[string]struct {
+		preconditions  []*Condition
+		postconditions []*Condition
+	}{
+		"id": {
+			preconditions: []*Condition{
+				{
+					Expression:   types.String("length(var.name) > 0"),
+					ErrorMessage: types.String("The name must not be empty."),
+				},
+			},
+			postconditions: []*Condition{
+				{
+					Expression:   types.String("self != null"),
+					ErrorMessage: types.String(`"The id of ${var.name} must be known."`),
+				},
+			},
+		},
+		"name": {
+			preconditions: []*Condition{
+				{
+					Expression:   types.String(`var.name != "foo"`),
+					ErrorMessage: types.String("The name can't be foo."),
+				},
+			},
+			postconditions: []*Condition{},
+		},
+		"none": {
+			preconditions:  []*Condition{},
+			postconditions: []*Condition{},
+		},
+	}
+
+	assert.Equal(len(expected), len(outputs))
+
+	for _, o := range outputs {
+		assert.Equal(expected[o.Name].preconditions, o.Preconditions)
+		assert.Equal(expected[o.Name].postconditions, o.Postconditions)
+		assert.Equal(o.Name != "none", o.HasConditions())
+	}
+}
+
+func TestLoadOutputTypes(t *testing.T) {
+	tests := []struct {
+		name     string
+		enabled  bool
+		expected map[string]string
+	}{
+		{
+			name:    "load output types",
+			enabled: true,
+			expected: map[string]string{
+				"name":    "string",
+				"port":    "number",
+				"enabled": "bool",
+				"url":     "string",
+				"zones":   "tuple",
+				"tags":    "object",
+				"id":      "any",
+			},
+		},
+		{
+			name:    "load output types disabled",
+			enabled: false,
+			expected: map[string]string{
+				"name":    "",
+				"port":    "",
+				"enabled": "",
+				"url":     "",
+				"zones":   "",
+				"tags":    "",
+				"id":      "",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", "with-output-types")
+			config.Settings.OutputValueType = tt.enabled
+
+			module, err := loadModule(config.ModuleRoot)
+			assert.Nil(err)
+
+			outputs, err := loadOutputs(module, config, loadTestHCLFiles(t, config.ModuleRoot))
+			assert.Nil(err)
+
+			actual := map[string]string{}
+			for _, o := range outputs {
+				actual[o.Name] = string(o.Type)
+			}
+			assert.Equal(tt.expected, actual)
 		})
 	}
 }
 
-func TestSortItems(t *testing.T) {
+func TestLoadOutputDeprecations(t *testing.T) {
 	type expected struct {
-		inputs    []string
-		required  []string
-		optional  []string
-		outputs   []string
-		providers []string
+		description string
+		deprecated  string
 	}
 	tests := []struct {
-		name        string
-		path        string
-		sortenabled bool
-		sorttype    string
-		expected    expected
+		name     string
+		enabled  bool
+		expected map[string]expected
 	}{
 		{
-			name:        "sort module items",
-			path:        "full-example",
-			sortenabled: false,
-			sorttype:    "",
-			expected: expected{
-				inputs:    []string{"D", "B", "E", "A", "C", "F", "G"},
-				required:  []string{"A", "F"},
-				optional:  []string{"D", "B", "E", "C", "G"},
-				outputs:   []string{"C", "A", "B"},
-				providers: []string{"tls", "aws", "null"},
+			name:    "load output deprecations",
+			enabled: true,
+			expected: map[string]expected{
+				"old_endpoint": {description: "", deprecated: "Use module.new_module.endpoint instead"},
+				"old_bucket":   {description: "The name of the bucket.", deprecated: "This output is deprecated."},
+				"endpoint":     {description: "The current endpoint.", deprecated: ""},
+				"described":    {description: "Described in the block itself.", deprecated: "Will be removed."},
 			},
 		},
 		{
-			name:        "sort module items",
-			path:        "full-example",
-			sortenabled: false,
-			sorttype:    print.SortName,
-			expected: expected{
-				inputs:    []string{"D", "B", "E", "A", "C", "F", "G"},
-				required:  []string{"A", "F"},
-				optional:  []string{"D", "B", "E", "C", "G"},
-				outputs:   []string{"C", "A", "B"},
-				providers: []string{"tls", "aws", "null"},
+			name:    "load output deprecations disabled",
+			enabled: false,
+			expected: map[string]expected{
+				"old_endpoint": {description: "@deprecated Use module.new_module.endpoint instead", deprecated: ""},
+				"old_bucket":   {description: "The name of the bucket. @deprecated", deprecated: ""},
+				"endpoint":     {description: "The current endpoint.", deprecated: ""},
+				"described":    {description: "Described in the block itself.", deprecated: ""},
 			},
 		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", "with-output-deprecations")
+			config.Settings.OutputDeprecation = tt.enabled
+			config.Settings.ReadComments = true
+
+			module, err := loadModule(config.ModuleRoot)
+			assert.Nil(err)
+
+			outputs, err := loadOutputs(module, config, loadTestHCLFiles(t, config.ModuleRoot))
+			assert.Nil(err)
+
+			actual := map[string]expected{}
+			for _, o := range outputs {
+				actual[o.Name] = expected{description: string(o.Description), deprecated: o.Deprecated}
+			}
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestLoadExampleOutputs(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		enabled bool
+	}{
 		{
-			name:        "sort module items",
-			path:        "full-example",
-			sortenabled: false,
-			sorttype:    print.SortRequired,
-			expected: expected{
-				inputs:    []string{"D", "B", "E", "A", "C", "F", "G"},
-				required:  []string{"A", "F"},
-				optional:  []string{"D", "B", "E", "C", "G"},
-				outputs:   []string{"C", "A", "B"},
-				providers: []string{"tls", "aws", "null"},
-			},
+			name:    "load example outputs disabled",
+			path:    "full-example",
+			enabled: false,
 		},
 		{
-			name:        "sort module items",
-			path:        "full-example",
-			sortenabled: true,
-			sorttype:    print.SortName,
-			expected: expected{
-				inputs:    []string{"A", "B", "C", "D", "E", "F", "G"},
-				required:  []string{"A", "F"},
-				optional:  []string{"B", "C", "D", "E", "G"},
-				outputs:   []string{"A", "B", "C"},
-				providers: []string{"aws", "null", "tls"},
+			name:    "load example outputs without initialized examples",
+			path:    "full-example",
+			enabled: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", tt.path)
+			config.Settings.ExampleOutputs = tt.enabled
+
+			actual, err := loadExampleOutputs(config)
+			assert.Nil(err)
+			assert.Empty(actual)
+		})
+	}
+}
+
+func TestLoadSecurityFindingsDisabled(t *testing.T) {
+	assert := assert.New(t)
+
+	config := print.NewConfig()
+	config.ModuleRoot = filepath.Join("testdata", "full-example")
+	config.Settings.TfsecResults = false
+
+	actual, err := loadSecurityFindings(config)
+	assert.Nil(err)
+	assert.Empty(actual)
+}
+
+func TestLoadVarFileDefaults(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    []string
+		expected map[string]types.Value
+		wantErr  bool
+	}{
+		{
+			name:  "load var file defaults disabled",
+			files: []string{},
+			expected: map[string]types.Value{
+				"region":         nil,
+				"instance_count": nil,
+				"tags":           nil,
+				"name":           nil,
 			},
+			wantErr: false,
 		},
 		{
-			name:        "sort module items",
-			path:        "full-example",
-			sortenabled: true,
-			sorttype:    print.SortRequired,
-			expected: expected{
-				inputs:    []string{"A", "F", "B", "C", "D", "E", "G"},
-				required:  []string{"A", "F"},
-				optional:  []string{"B", "C", "D", "E", "G"},
-				outputs:   []string{"A", "B", "C"},
-				providers: []string{"aws", "null", "tls"},
+			name:  "load var file defaults",
+			files: []string{filepath.Join("envs", "common.tfvars")},
+			expected: map[string]types.Value{
+				"region":         types.String("eu-west-1"),
+				"instance_count": types.Number(2),
+				"tags":           types.Map{"team": "platform"},
+				"name":           nil,
 			},
+			wantErr: false,
 		},
 		{
-			name:        "sort module items",
-			path:        "full-example",
-			sortenabled: true,
-			sorttype:    print.SortType,
-			expected: expected{
-				inputs:    []string{"A", "F", "G", "B", "C", "D", "E"},
-				required:  []string{"A", "F"},
-				optional:  []string{"G", "B", "C", "D", "E"},
-				outputs:   []string{"A", "B", "C"},
-				providers: []string{"aws", "null", "tls"},
+			name:  "load var file defaults in order",
+			files: []string{filepath.Join("envs", "common.tfvars"), filepath.Join("envs", "prod.tfvars.json")},
+			expected: map[string]types.Value{
+				"region":         types.String("eu-west-1"),
+				"instance_count": types.Number(5),
+				"tags":           types.Map{"team": "platform"},
+				"name":           types.String("prod"),
 			},
+			wantErr: false,
+		},
+		{
+			name:     "load var file defaults missing",
+			files:    []string{filepath.Join("envs", "noop.tfvars")},
+			expected: nil,
+			wantErr:  true,
+		},
+		{
+			name:     "load var file defaults invalid",
+			files:    []string{filepath.Join("envs", "invalid.tfvars")},
+			expected: nil,
+			wantErr:  true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			assert := assert.New(t)
-			path := filepath.Join("testdata", tt.path)
 
 			config := print.NewConfig()
-			config.ModuleRoot = path
-			config.Sort.Enabled = tt.sortenabled
-			config.Sort.By = tt.sorttype
-
-			tfmodule, _ := loadModule(path)
-			module, err := loadModuleItems(tfmodule, config)
+			config.ModuleRoot = filepath.Join("testdata", "with-var-files")
+			config.VarFileDefaults = tt.files
 
+			tfmodule, err := loadModule(config.ModuleRoot)
 			assert.Nil(err)
-			sortItems(module, config)
 
-			for i, v := range module.Inputs {
-				assert.Equal(tt.expected.inputs[i], v.Name)
-			}
-			for i, v := range module.RequiredInputs {
-				assert.Equal(tt.expected.required[i], v.Name)
-			}
-			for i, v := range module.OptionalInputs {
-				assert.Equal(tt.expected.optional[i], v.Name)
+			inputs, _, _ := loadInputs(tfmodule, config)
+			err = loadVarFileDefaults(config, inputs)
+
+			if tt.wantErr {
+				assert.NotNil(err)
+				return
 			}
-			for i, v := range module.Outputs {
-				assert.Equal(tt.expected.outputs[i], v.Name)
+			assert.Nil(err)
+
+			actual := map[string]types.Value{}
+			for _, i := range inputs {
+				actual[i.Name] = i.EnvDefault
 			}
-			for i, v := range module.Providers {
-				assert.Equal(tt.expected.providers[i], v.Name)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestLoadTagCompliance(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		enabled  bool
+		expected map[string]*TagCompliance
+	}{
+		{
+			name:    "load tag compliance",
+			path:    "with-tag-policy",
+			enabled: true,
+			expected: map[string]*TagCompliance{
+				"aws_s3_bucket.compliant":     {Status: TagsCompliant},
+				"aws_s3_bucket.non_compliant": {Status: TagsNonCompliant, Missing: []string{"owner"}},
+				"aws_s3_bucket.derived":       {Status: TagsCheckManually},
+				"null_resource.untagged":      {Status: TagsCheckManually},
+				"aws_caller_identity.current": nil,
+			},
+		},
+		{
+			name:    "load tag compliance disabled",
+			path:    "with-tag-policy",
+			enabled: false,
+			expected: map[string]*TagCompliance{
+				"aws_s3_bucket.compliant":     nil,
+				"aws_s3_bucket.non_compliant": nil,
+				"aws_s3_bucket.derived":       nil,
+				"null_resource.untagged":      nil,
+				"aws_caller_identity.current": nil,
+			},
+		},
+		{
+			name:    "load tag compliance without policy",
+			path:    "full-example",
+			enabled: true,
+			expected: map[string]*TagCompliance{
+				"tls_private_key.baz":         nil,
+				"null_resource.foo":           nil,
+				"aws_caller_identity.current": nil,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", tt.path)
+			config.Settings.TagPolicy = tt.enabled
+
+			tfmodule, err := loadModule(config.ModuleRoot)
+			assert.Nil(err)
+
+			resources := loadResources(tfmodule, config)
+			err = loadTagCompliance(config, loadTestHCLFiles(t, config.ModuleRoot), resources)
+			assert.Nil(err)
+
+			actual := map[string]*TagCompliance{}
+			for _, r := range resources {
+				actual[r.Spec()] = r.TagCompliance
 			}
+			assert.Equal(tt.expected, actual)
 		})
 	}
 }
+
+func TestLoadTestResults(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		enabled bool
+	}{
+		{
+			name:    "load test results disabled",
+			path:    "with-tftest",
+			enabled: false,
+		},
+		{
+			name:    "load test results without test files",
+			path:    "full-example",
+			enabled: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", tt.path)
+			config.Settings.RunTests = tt.enabled
+
+			results, err := loadTestResults(config)
+			assert.Nil(err)
+			assert.Empty(results)
+		})
+	}
+}
+
+func TestLoadSensitiveSummary(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		enabled  bool
+		expected *SensitiveSummary
+	}{
+		{
+			name:     "load sensitive summary",
+			path:     "with-sensitive",
+			enabled:  true,
+			expected: &SensitiveSummary{Inputs: 2, Outputs: 1},
+		},
+		{
+			name:     "load sensitive summary disabled",
+			path:     "with-sensitive",
+			enabled:  false,
+			expected: nil,
+		},
+		{
+			name:     "load sensitive summary without sensitive items",
+			path:     "with-output-types",
+			enabled:  true,
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", tt.path)
+			config.Settings.SensitiveSummary = tt.enabled
+
+			tfmodule, err := loadModule(config.ModuleRoot)
+			assert.Nil(err)
+
+			module, err := loadModuleItems(tfmodule, config)
+			assert.Nil(err)
+			assert.Equal(tt.expected, module.SensitiveSummary)
+		})
+	}
+}
+
+func TestLoadRedactedVarFileDefaults(t *testing.T) {
+	assert := assert.New(t)
+
+	config := print.NewConfig()
+	config.ModuleRoot = filepath.Join("testdata", "with-redacted-var-files")
+	config.VarFileDefaults = []string{"prod.tfvars"}
+	config.Sensitive.RedactDefaults = true
+
+	tfmodule, err := loadModule(config.ModuleRoot)
+	assert.Nil(err)
+
+	module, err := loadModuleItems(tfmodule, config)
+	assert.Nil(err)
+
+	expected := map[string][2]types.Value{
+		"region":      {types.String("us-east-1"), types.String("eu-west-1")},
+		"db_password": {types.String("[REDACTED]"), types.String("[REDACTED]")},
+		"license":     {types.String("[REDACTED]"), types.String("[REDACTED]")},
+		"api_token":   {types.ValueOf(nil), types.String("[REDACTED]")},
+		"admin_email": {types.ValueOf(nil), types.String("[REDACTED]")},
+	}
+	actual := map[string][2]types.Value{}
+	for _, i := range module.Inputs {
+		actual[i.Name] = [2]types.Value{i.Default, i.EnvDefault}
+	}
+	assert.Equal(expected, actual)
+}
+
+func TestLoadSensitiveVarFileDefaults(t *testing.T) {
+	assert := assert.New(t)
+
+	config := print.NewConfig()
+	config.ModuleRoot = filepath.Join("testdata", "with-redacted-var-files")
+	config.VarFileDefaults = []string{"prod.tfvars"}
+
+	tfmodule, err := loadModule(config.ModuleRoot)
+	assert.Nil(err)
+
+	module, err := loadModuleItems(tfmodule, config)
+	assert.Nil(err)
+
+	expected := map[string][2]types.Value{
+		"region":      {types.String("us-east-1"), types.String("eu-west-1")},
+		"db_password": {types.String("changeme"), types.String("hunter2")},
+		"license":     {types.ValueOf(""), types.String("0123456789abcdef0123456789abcdef")},
+		"api_token":   {types.ValueOf(nil), types.String("ghp_foo")},
+		"admin_email": {types.ValueOf(nil), types.String("[REDACTED]")},
+	}
+	actual := map[string][2]types.Value{}
+	for _, i := range module.Inputs {
+		actual[i.Name] = [2]types.Value{i.Default, i.EnvDefault}
+	}
+	assert.Equal(expected, actual)
+}
+
+func TestLoadVariableSummary(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		enabled  bool
+		expected *VariableSummary
+	}{
+		{
+			name:     "load variable summary",
+			path:     "with-sensitive",
+			enabled:  true,
+			expected: &VariableSummary{Total: 4, Required: 3, Optional: 1, Sensitive: 2, Deprecated: 0},
+		},
+		{
+			name:     "load variable summary disabled",
+			path:     "with-sensitive",
+			enabled:  false,
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", tt.path)
+			config.Settings.VariableSummaryTable = tt.enabled
+
+			tfmodule, err := loadModule(config.ModuleRoot)
+			assert.Nil(err)
+
+			module, err := loadModuleItems(tfmodule, config)
+			assert.Nil(err)
+			assert.Equal(tt.expected, module.VariableSummary)
+		})
+	}
+}
+
+func TestLoadBackend(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		enabled  bool
+		expected *Backend
+	}{
+		{
+			name:    "load s3 backend",
+			path:    "with-s3-backend",
+			enabled: true,
+			expected: &Backend{
+				Type: "s3",
+				Attributes: []*BackendAttribute{
+					{Name: "bucket", Value: `"<bucket>"`},
+					{Name: "key", Value: `"<key>"`},
+					{Name: "region", Value: `"eu-west-1"`},
+					{Name: "dynamodb_table", Value: `"terraform-locks"`},
+					{Name: "encrypt", Value: "true"},
+				},
+			},
+		},
+		{
+			name:     "load s3 backend disabled",
+			path:     "with-s3-backend",
+			enabled:  false,
+			expected: nil,
+		},
+		{
+			name:     "load local backend",
+			path:     "with-local-backend",
+			enabled:  true,
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", tt.path)
+			config.Settings.S3BackendDocs = tt.enabled
+
+			backend, err := loadBackend(config, loadTestHCLFiles(t, config.ModuleRoot))
+			assert.Nil(err)
+			assert.Equal(tt.expected, backend)
+		})
+	}
+}
+
+func TestLoadProviders(t *testing.T) {
+	type expected struct {
+		providers []string
+	}
+	tests := []struct {
+		name     string
+		path     string
+		lockfile bool
+		expected expected
+	}{
+		{
+			name:     "load module providers from path",
+			path:     "full-example",
+			lockfile: false,
+			expected: expected{
+				providers: []string{"aws->= 2.15.0", "null-", "tls-"},
+			},
+		},
+		{
+			name:     "load module providers from path",
+			path:     "with-lock-file",
+			lockfile: true,
+			expected: expected{
+				providers: []string{"aws-3.42.0", "null-3.1.0", "tls-3.1.0"},
+			},
+		},
+		{
+			name:     "load module providers from path",
+			path:     "with-lock-file",
+			lockfile: false,
+			expected: expected{
+				providers: []string{"aws->= 2.15.0", "null-", "tls-"},
+			},
+		},
+		{
+			name: "load module providers from path",
+			path: "no-providers",
+			expected: expected{
+				providers: []string{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", tt.path)
+			config.Settings.LockFile = tt.lockfile
+
+			module, _ := loadModule(filepath.Join("testdata", tt.path))
+			providers := loadProviders(module, config)
+
+			actual := []string{}
+
+			for _, p := range providers {
+				actual = append(actual, p.FullName()+"-"+string(p.Version))
+			}
+			sort.Strings(actual)
+
+			assert.Equal(tt.expected.providers, actual)
+		})
+	}
+}
+
+func TestLoadCompatibilityMatrix(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		enabled  bool
+		expected []string
+	}{
+		{
+			name:     "load compatibility matrix from path",
+			path:     "with-compatibility-matrix",
+			enabled:  true,
+			expected: []string{">= 0.13: aws >= 3.0, random >= 3.0", ">= 1.0: aws >= 4.0"},
+		},
+		{
+			name:     "load compatibility matrix from path",
+			path:     "with-compatibility-matrix",
+			enabled:  false,
+			expected: []string{},
+		},
+		{
+			name:     "load compatibility matrix from path",
+			path:     "full-example",
+			enabled:  true,
+			expected: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", tt.path)
+			config.Settings.ProviderSourceVersionMatrix = tt.enabled
+
+			matrix, err := loadCompatibilityMatrix(config)
+			assert.Nil(err)
+
+			actual := []string{}
+
+			for _, c := range matrix {
+				providers := []string{}
+				for _, p := range c.Providers {
+					providers = append(providers, p.Name+" "+string(p.Version))
+				}
+				actual = append(actual, string(c.Terraform)+": "+strings.Join(providers, ", "))
+			}
+
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestLoadDeprecations(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		expected map[string]string
+		wantErr  bool
+	}{
+		{
+			name: "load deprecations from file",
+			file: "DEPRECATIONS.md",
+			expected: map[string]string{
+				"A": "Use `B` instead.",
+				"F": "It will be removed in the next major release.",
+			},
+			wantErr: false,
+		},
+		{
+			name:     "load deprecations disabled",
+			file:     "",
+			expected: map[string]string{},
+			wantErr:  false,
+		},
+		{
+			name:     "load deprecations from missing file",
+			file:     "noop.md",
+			expected: map[string]string{},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", "with-deprecations")
+			config.DeprecationsFrom = tt.file
+
+			tfmodule, _ := loadModule(config.ModuleRoot)
+			inputs, _, _ := loadInputs(tfmodule, config)
+
+			err := loadDeprecations(config, inputs)
+
+			if tt.wantErr {
+				assert.NotNil(err)
+				return
+			}
+			assert.Nil(err)
+
+			actual := map[string]string{}
+			for _, input := range inputs {
+				if input.Deprecated != "" {
+					actual[input.Name] = input.Deprecated
+				}
+			}
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestLoadExcludes(t *testing.T) {
+	type expected struct {
+		inputs   []string
+		required []string
+		optional []string
+		outputs  []string
+	}
+	tests := []struct {
+		name      string
+		variables []string
+		outputs   []string
+		file      string
+		expected  expected
+		wantErr   bool
+	}{
+		{
+			name:      "load excludes disabled",
+			variables: []string{},
+			outputs:   []string{},
+			file:      "",
+			expected: expected{
+				inputs:   []string{"api_token", "db_name", "db_password", "region"},
+				required: []string{"api_token", "db_password"},
+				optional: []string{"db_name", "region"},
+				outputs:  []string{"db_endpoint", "db_password", "private_key"},
+			},
+			wantErr: false,
+		},
+		{
+			name:      "load excludes from flags",
+			variables: []string{"db_*"},
+			outputs:   []string{"db_password"},
+			file:      "",
+			expected: expected{
+				inputs:   []string{"api_token", "region"},
+				required: []string{"api_token"},
+				optional: []string{"region"},
+				outputs:  []string{"db_endpoint", "private_key"},
+			},
+			wantErr: false,
+		},
+		{
+			name:      "load excludes from flags and file",
+			variables: []string{"db_password"},
+			outputs:   []string{},
+			file:      "excludes.yml",
+			expected: expected{
+				inputs:   []string{"db_name", "region"},
+				required: []string{},
+				optional: []string{"db_name", "region"},
+				outputs:  []string{"db_endpoint", "db_password"},
+			},
+			wantErr: false,
+		},
+		{
+			name:      "load excludes from missing file",
+			variables: []string{},
+			outputs:   []string{},
+			file:      "noop.yml",
+			expected:  expected{},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", "with-excludes")
+			config.Exclude.Variables = tt.variables
+			config.Exclude.Outputs = tt.outputs
+			config.Exclude.File = tt.file
+
+			tfmodule, _ := loadModule(config.ModuleRoot)
+			module, err := loadModuleItems(tfmodule, config)
+
+			if tt.wantErr {
+				assert.NotNil(err)
+				return
+			}
+			assert.Nil(err)
+
+			config.Sort.Enabled = true
+			config.Sort.By = print.SortName
+			sortItems(module, config)
+
+			names := func(inputs []*Input) []string {
+				result := []string{}
+				for _, input := range inputs {
+					result = append(result, input.Name)
+				}
+				return result
+			}
+			outputs := []string{}
+			for _, output := range module.Outputs {
+				outputs = append(outputs, output.Name)
+			}
+
+			assert.Equal(tt.expected.inputs, names(module.Inputs))
+			assert.Equal(tt.expected.required, names(module.RequiredInputs))
+			assert.Equal(tt.expected.optional, names(module.OptionalInputs))
+			assert.Equal(tt.expected.outputs, outputs)
+		})
+	}
+}
+
+func TestLoadLicense(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		enabled  bool
+		expected string
+	}{
+		{
+			name:     "load license from path",
+			path:     "with-license",
+			enabled:  true,
+			expected: "Apache-2.0",
+		},
+		{
+			name:     "load license from path",
+			path:     "with-license",
+			enabled:  false,
+			expected: "",
+		},
+		{
+			name:     "load license from path",
+			path:     "full-example",
+			enabled:  true,
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", tt.path)
+			config.Settings.License = tt.enabled
+
+			license, err := loadLicense(config)
+			assert.Nil(err)
+			assert.Equal(tt.expected, license)
+		})
+	}
+}
+
+func TestLoadChecks(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		enabled  bool
+		expected []*Check
+	}{
+		{
+			name:    "load checks from path",
+			path:    "with-checks",
+			enabled: true,
+			expected: []*Check{
+				{
+					Name:         "bucket_name",
+					Condition:    types.String(`can(regex("^[a-z0-9.-]{3,63}$", var.bucket))`),
+					ErrorMessage: types.String("Bucket name must be a valid S3 bucket name."),
+				},
+				{
+					Name:         "replicas",
+					Condition:    types.String("var.replicas >= 1 && var.replicas <= 5"),
+					ErrorMessage: types.String(`"Replicas must be between 1 and 5, got ${var.replicas}."`),
+				},
+				{
+					Name:         "replicas",
+					Condition:    types.String("(\n      var.replicas % 2 == 1\n    )"),
+					ErrorMessage: types.String("Replicas must be odd."),
+				},
+			},
+		},
+		{
+			name:     "load checks from path",
+			path:     "with-checks",
+			enabled:  false,
+			expected: []*Check{},
+		},
+		{
+			name:     "load checks from path",
+			path:     "full-example",
+			enabled:  true,
+			expected: []*Check{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", tt.path)
+			config.Settings.ShowChecks = tt.enabled
+
+			checks, err := loadChecks(config, loadTestHCLFiles(t, config.ModuleRoot))
+			assert.Nil(err)
+
+			for _, c := range checks {
+				c.Position = Position{}
+			}
+
+			assert.Equal(tt.expected, checks)
+		})
+	}
+}
+
+func TestLoadComments(t *testing.T) {
+	tests := []struct {
+		name       string
+		path       string
+		fileName   string
+		lineNumber int
+		expected   string
+	}{
+		{
+			name:       "load resource comment from file",
+			path:       "full-example",
+			fileName:   "variables.tf",
+			lineNumber: 2,
+			expected:   "D description",
+		},
+		{
+			name:       "load resource comment from file",
+			path:       "full-example",
+			fileName:   "variables.tf",
+			lineNumber: 16,
+			expected:   "A Description in multiple lines",
+		},
+		{
+			name:       "load resource comment from file with wrong line number",
+			path:       "full-example",
+			fileName:   "variables.tf",
+			lineNumber: 100,
+			expected:   "",
+		},
+		{
+			name:       "load resource comment from non existing file",
+			path:       "full-example",
+			fileName:   "non-exist.tf",
+			lineNumber: 5,
+			expected:   "",
+		},
+		{
+			name:       "load resource comment from non existing file",
+			path:       "non-exist",
+			fileName:   "variables.tf",
+			lineNumber: 5,
+			expected:   "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := loadComments(filepath.Join("testdata", tt.path, tt.fileName), tt.lineNumber)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestReadComments(t *testing.T) {
+	tests := []struct {
+		name         string
+		path         string
+		fileName     string
+		readComments bool
+		expected     string
+	}{
+		{
+			name:         "Validate description when 'ReadComments' is false",
+			path:         "read-comments",
+			fileName:     "variables.tf",
+			readComments: false,
+			expected:     "",
+		},
+		{
+			name:         "Validate description when 'ReadComments' is true",
+			path:         "read-comments",
+			fileName:     "variables.tf",
+			readComments: true,
+			expected:     "B description",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.Settings.ReadComments = tt.readComments
+
+			module, err := loadModule(filepath.Join("testdata", tt.path))
+
+			assert.Nil(err)
+
+			inputs, _, _ := loadInputs(module, config)
+			assert.Equal(1, len(inputs))
+			assert.Equal(tt.expected, string(inputs[0].Description))
+
+			outputs, _ := loadOutputs(module, config, nil)
+			assert.Equal(1, len(outputs))
+			assert.Equal(tt.expected, string(outputs[0].Description))
+		})
+	}
+}
+
+func TestSortItems(t *testing.T) {
+	type expected struct {
+		inputs    []string
+		required  []string
+		optional  []string
+		outputs   []string
+		providers []string
+	}
+	tests := []struct {
+		name        string
+		path        string
+		sortenabled bool
+		sorttype    string
+		expected    expected
+	}{
+		{
+			name:        "sort module items",
+			path:        "full-example",
+			sortenabled: false,
+			sorttype:    "",
+			expected: expected{
+				inputs:    []string{"D", "B", "E", "A", "C", "F", "G"},
+				required:  []string{"A", "F"},
+				optional:  []string{"D", "B", "E", "C", "G"},
+				outputs:   []string{"C", "A", "B"},
+				providers: []string{"tls", "aws", "null"},
+			},
+		},
+		{
+			name:        "sort module items",
+			path:        "full-example",
+			sortenabled: false,
+			sorttype:    print.SortName,
+			expected: expected{
+				inputs:    []string{"D", "B", "E", "A", "C", "F", "G"},
+				required:  []string{"A", "F"},
+				optional:  []string{"D", "B", "E", "C", "G"},
+				outputs:   []string{"C", "A", "B"},
+				providers: []string{"tls", "aws", "null"},
+			},
+		},
+		{
+			name:        "sort module items",
+			path:        "full-example",
+			sortenabled: false,
+			sorttype:    print.SortRequired,
+			expected: expected{
+				inputs:    []string{"D", "B", "E", "A", "C", "F", "G"},
+				required:  []string{"A", "F"},
+				optional:  []string{"D", "B", "E", "C", "G"},
+				outputs:   []string{"C", "A", "B"},
+				providers: []string{"tls", "aws", "null"},
+			},
+		},
+		{
+			name:        "sort module items",
+			path:        "full-example",
+			sortenabled: true,
+			sorttype:    print.SortName,
+			expected: expected{
+				inputs:    []string{"A", "B", "C", "D", "E", "F", "G"},
+				required:  []string{"A", "F"},
+				optional:  []string{"B", "C", "D", "E", "G"},
+				outputs:   []string{"A", "B", "C"},
+				providers: []string{"aws", "null", "tls"},
+			},
+		},
+		{
+			name:        "sort module items",
+			path:        "full-example",
+			sortenabled: true,
+			sorttype:    print.SortRequired,
+			expected: expected{
+				inputs:    []string{"A", "F", "B", "C", "D", "E", "G"},
+				required:  []string{"A", "F"},
+				optional:  []string{"B", "C", "D", "E", "G"},
+				outputs:   []string{"A", "B", "C"},
+				providers: []string{"aws", "null", "tls"},
+			},
+		},
+		{
+			name:        "sort module items",
+			path:        "full-example",
+			sortenabled: true,
+			sorttype:    print.SortType,
+			expected: expected{
+				inputs:    []string{"A", "F", "G", "B", "C", "D", "E"},
+				required:  []string{"A", "F"},
+				optional:  []string{"G", "B", "C", "D", "E"},
+				outputs:   []string{"A", "B", "C"},
+				providers: []string{"aws", "null", "tls"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			path := filepath.Join("testdata", tt.path)
+
+			config := print.NewConfig()
+			config.ModuleRoot = path
+			config.Sort.Enabled = tt.sortenabled
+			config.Sort.By = tt.sorttype
+
+			tfmodule, _ := loadModule(path)
+			module, err := loadModuleItems(tfmodule, config)
+
+			assert.Nil(err)
+			sortItems(module, config)
+
+			for i, v := range module.Inputs {
+				assert.Equal(tt.expected.inputs[i], v.Name)
+			}
+			for i, v := range module.RequiredInputs {
+				assert.Equal(tt.expected.required[i], v.Name)
+			}
+			for i, v := range module.OptionalInputs {
+				assert.Equal(tt.expected.optional[i], v.Name)
+			}
+			for i, v := range module.Outputs {
+				assert.Equal(tt.expected.outputs[i], v.Name)
+			}
+			for i, v := range module.Providers {
+				assert.Equal(tt.expected.providers[i], v.Name)
+			}
+		})
+	}
+}
+
+func TestSortItemsPinnedBottom(t *testing.T) {
+	type expected struct {
+		inputs   []string
+		required []string
+		optional []string
+	}
+	tests := []struct {
+		name        string
+		sortenabled bool
+		pinned      []string
+		expected    expected
+	}{
+		{
+			name:        "pin inputs to bottom with sort disabled",
+			sortenabled: false,
+			pinned:      []string{"C", "A"},
+			expected: expected{
+				inputs:   []string{"D", "B", "E", "F", "G", "C", "A"},
+				required: []string{"F", "A"},
+				optional: []string{"D", "B", "E", "G", "C"},
+			},
+		},
+		{
+			name:        "pin inputs to bottom with sort enabled",
+			sortenabled: true,
+			pinned:      []string{"C", "A"},
+			expected: expected{
+				inputs:   []string{"B", "D", "E", "F", "G", "C", "A"},
+				required: []string{"F", "A"},
+				optional: []string{"B", "D", "E", "G", "C"},
+			},
+		},
+		{
+			name:        "pin unknown inputs to bottom",
+			sortenabled: true,
+			pinned:      []string{"X"},
+			expected: expected{
+				inputs:   []string{"A", "B", "C", "D", "E", "F", "G"},
+				required: []string{"A", "F"},
+				optional: []string{"B", "C", "D", "E", "G"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			path := filepath.Join("testdata", "full-example")
+
+			config := print.NewConfig()
+			config.ModuleRoot = path
+			config.Sort.Enabled = tt.sortenabled
+			config.Sort.By = print.SortName
+			config.Sort.PinnedBottomVariables = tt.pinned
+
+			tfmodule, _ := loadModule(path)
+			module, err := loadModuleItems(tfmodule, config)
+
+			assert.Nil(err)
+			sortItems(module, config)
+
+			assert.Equal(tt.expected.inputs, inputNames(module.Inputs))
+			assert.Equal(tt.expected.required, inputNames(module.RequiredInputs))
+			assert.Equal(tt.expected.optional, inputNames(module.OptionalInputs))
+		})
+	}
+}
+
+func TestSortItemsProvidersRequirements(t *testing.T) {
+	type expected struct {
+		providers    []string
+		requirements []string
+	}
+	tests := []struct {
+		name         string
+		sortenabled  bool
+		providers    string
+		requirements string
+		expected     expected
+	}{
+		{
+			name:         "keep order with sort disabled",
+			sortenabled:  false,
+			providers:    "",
+			requirements: "",
+			expected: expected{
+				providers:    []string{"tls", "aws", "null"},
+				requirements: []string{"terraform", "aws"},
+			},
+		},
+		{
+			name:         "sort providers with sort disabled",
+			sortenabled:  false,
+			providers:    print.SortName,
+			requirements: "",
+			expected: expected{
+				providers:    []string{"aws", "null", "tls"},
+				requirements: []string{"terraform", "aws"},
+			},
+		},
+		{
+			name:         "sort requirements",
+			sortenabled:  true,
+			providers:    "",
+			requirements: print.SortName,
+			expected: expected{
+				providers:    []string{"aws", "null", "tls"},
+				requirements: []string{"aws", "terraform"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			path := filepath.Join("testdata", "full-example")
+
+			config := print.NewConfig()
+			config.ModuleRoot = path
+			config.Sort.Enabled = tt.sortenabled
+			config.Sort.By = print.SortName
+			config.Sort.Providers = tt.providers
+			config.Sort.Requirements = tt.requirements
+
+			tfmodule, _ := loadModule(path)
+			module, err := loadModuleItems(tfmodule, config)
+
+			assert.Nil(err)
+			sortItems(module, config)
+
+			providers := []string{}
+			for _, p := range module.Providers {
+				providers = append(providers, p.Name)
+			}
+			requirements := []string{}
+			for _, r := range module.Requirements {
+				requirements = append(requirements, r.Name)
+			}
+			assert.Equal(tt.expected.providers, providers)
+			assert.Equal(tt.expected.requirements, requirements)
+		})
+	}
+}
+
+func inputNames(inputs []*Input) []string {
+	names := make([]string, 0, len(inputs))
+	for _, input := range inputs {
+		names = append(names, input.Name)
+	}
+	return names
+}
+
+func TestLoadCoreVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		enabled  bool
+		required string
+		pinned   string
+	}{
+		{
+			name:     "load core version from path",
+			path:     "with-core-version/required-version",
+			enabled:  true,
+			required: ">= 1.3",
+			pinned:   "",
+		},
+		{
+			name:     "load core version from path",
+			path:     "with-core-version/terraform-version",
+			enabled:  true,
+			required: "",
+			pinned:   "1.5.7",
+		},
+		{
+			name:     "load core version from path",
+			path:     "with-core-version/both",
+			enabled:  true,
+			required: ">= 1.3",
+			pinned:   "1.5.7",
+		},
+		{
+			name:     "load core version from path",
+			path:     "no-providers",
+			enabled:  true,
+			required: "",
+			pinned:   "",
+		},
+		{
+			name:     "load core version from path",
+			path:     "with-core-version/both",
+			enabled:  false,
+			required: "",
+			pinned:   "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", tt.path)
+			config.Settings.ShowCoreVersion = tt.enabled
+
+			module, _ := loadModule(config.ModuleRoot)
+			required, pinned, err := loadCoreVersion(module, config)
+			assert.Nil(err)
+			assert.Equal(tt.required, required)
+			assert.Equal(tt.pinned, pinned)
+		})
+	}
+}
+
+func TestLoadTestRuns(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		enabled  bool
+		expected []*TestRun
+	}{
+		{
+			name:    "load test runs from path",
+			path:    "with-tftest",
+			enabled: true,
+			expected: []*TestRun{
+				{
+					Name:     "defaults",
+					Filename: "tests/main.tftest.hcl",
+					Variables: []*TestVariable{
+						{Name: "name", Value: types.String("\"global\"")},
+					},
+					Assertions: []*TestAssertion{
+						{Condition: types.String("output.name == \"global\""), ErrorMessage: types.String("Name didn't match.")},
+					},
+				},
+				{
+					Name:     "with_tags",
+					Filename: "tests/main.tftest.hcl",
+					Variables: []*TestVariable{
+						{Name: "name", Value: types.String("\"foo\"")},
+						{Name: "tags", Value: types.String("{\n  Name = \"foo\"\n  Env  = \"dev\"\n}")},
+					},
+					Assertions: []*TestAssertion{
+						{Condition: types.String("output.name == \"foo\""), ErrorMessage: types.String("\"Name must be ${var.name}.\"")},
+						{Condition: types.String("length(var.tags) == 2"), ErrorMessage: types.String("Tags count didn't match.")},
+					},
+				},
+				{
+					Name:       "plan",
+					Filename:   "tests/other.tftest.hcl",
+					Variables:  []*TestVariable{},
+					Assertions: []*TestAssertion{},
+				},
+			},
+		},
+		{
+			name:     "load test runs from path",
+			path:     "with-tftest",
+			enabled:  false,
+			expected: []*TestRun{},
+		},
+		{
+			name:     "load test runs from path",
+			path:     "full-example",
+			enabled:  true,
+			expected: []*TestRun{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", tt.path)
+			config.Settings.TftestExamples = tt.enabled
+
+			runs, err := loadTestRuns(config)
+			assert.Nil(err)
+			assert.Equal(tt.expected, runs)
+		})
+	}
+}
+
+func TestLoadMoved(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		expected []*Moved
+	}{
+		{
+			name:     "load moved from path",
+			path:     "full-example",
+			expected: []*Moved{},
+		},
+		{
+			name: "load moved from path",
+			path: "with-moved/one",
+			expected: []*Moved{
+				{From: types.String("null_resource.foo"), To: types.String("null_resource.bar")},
+			},
+		},
+		{
+			name: "load moved from path",
+			path: "with-moved/multiple",
+			expected: []*Moved{
+				{From: types.String("null_resource.foo"), To: types.String("null_resource.bar[0]")},
+				{From: types.String(`module.foo["key"]`), To: types.String("module.baz")},
+				{From: types.String("module.baz.null_resource.qux"), To: types.String(`module.baz.null_resource.quux["a"]`)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", tt.path)
+
+			moved, err := loadMoved(config, loadTestHCLFiles(t, config.ModuleRoot))
+			assert.Nil(err)
+
+			for _, m := range moved {
+				m.Position = Position{}
+			}
+
+			assert.Equal(tt.expected, moved)
+		})
+	}
+}
+
+func TestLoadJSONFiles(t *testing.T) {
+	assert := assert.New(t)
+
+	config := print.NewConfig()
+	config.ModuleRoot = filepath.Join("testdata", "with-json-files")
+	config.Settings.ShowAttributes = true
+	config.Settings.ShowDefaultsType = true
+	config.Settings.ShowValidations = true
+
+	tfmodule, err := loadModule(config.ModuleRoot)
+	assert.Nil(err)
+
+	module, err := loadModuleItems(tfmodule, config)
+	assert.Nil(err)
+
+	type input struct {
+		sensitive   bool
+		nullable    bool
+		defaultKind string
+		validations []*Condition
+	}
+	expected := map[string]input{
+		"name": {
+			nullable: false,
+			validations: []*Condition{
+				{
+					Expression:   types.String("length(var.name) > 0"),
+					ErrorMessage: types.String("The name must not be empty."),
+				},
+			},
+		},
+		"password": {sensitive: true, nullable: true, validations: []*Condition{}},
+		"size":     {nullable: true, defaultKind: DefaultKindLiteral, validations: []*Condition{}},
+	}
+	actual := make(map[string]input)
+	for _, i := range module.Inputs {
+		actual[i.Name] = input{
+			sensitive:   i.Sensitive,
+			nullable:    i.IsNullable(),
+			defaultKind: i.DefaultKind,
+			validations: i.Validations,
+		}
+	}
+	assert.Equal(expected, actual)
+
+	assert.Equal(1, len(module.Outputs))
+	assert.Equal([]*Condition{
+		{
+			Expression:   types.String("var.size > 0"),
+			ErrorMessage: types.String("The size must be positive."),
+		},
+	}, module.Outputs[0].Preconditions)
+
+	moved, err := loadMoved(config, loadTestHCLFiles(t, config.ModuleRoot))
+	assert.Nil(err)
+	assert.Equal(1, len(moved))
+	assert.Equal(types.String("null_resource.foo"), moved[0].From)
+	assert.Equal(types.String("null_resource.bar"), moved[0].To)
+}
+
+func TestLoadChangelog(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		enabled  bool
+		versions int
+		expected string
+	}{
+		{
+			name:     "load changelog from file",
+			path:     "with-changelog",
+			enabled:  true,
+			versions: 0,
+			expected: "## v0.2.0\n\n- Add `foo`\n\n## v0.1.0\n\n- Initial release",
+		},
+		{
+			name:     "load changelog with limited versions",
+			path:     "with-changelog",
+			enabled:  true,
+			versions: 1,
+			expected: "## v0.2.0\n\n- Add `foo`",
+		},
+		{
+			name:     "load changelog disabled",
+			path:     "with-changelog",
+			enabled:  false,
+			versions: 0,
+			expected: "",
+		},
+		{
+			name:     "load changelog from missing file",
+			path:     "full-example",
+			enabled:  true,
+			versions: 0,
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", tt.path)
+			config.Changelog.Enabled = tt.enabled
+			config.Changelog.Versions = tt.versions
+
+			actual, err := loadChangelog(config)
+
+			assert.Nil(err)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestLoadPurpose(t *testing.T) {
+	tests := []struct {
+		name     string
+		enabled  bool
+		header   bool
+		expected string
+	}{
+		{
+			name:     "load purpose from header",
+			enabled:  true,
+			header:   true,
+			expected: "Example of 'foo_bar' module in `foo_bar.tf`.",
+		},
+		{
+			name:     "load purpose from hidden header",
+			enabled:  true,
+			header:   false,
+			expected: "Example of 'foo_bar' module in `foo_bar.tf`.",
+		},
+		{
+			name:     "load purpose disabled",
+			enabled:  false,
+			header:   true,
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", "full-example")
+			config.Sections.Header = tt.header
+			config.Settings.ModulePurpose = tt.enabled
+
+			header, err := loadHeader(config)
+			assert.Nil(err)
+
+			actual, err := loadPurpose(config, header)
+
+			assert.Nil(err)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestLoadEphemeralResources(t *testing.T) {
+	tests := []struct {
+		name     string
+		enabled  bool
+		expected []*EphemeralResource
+	}{
+		{
+			name:    "load ephemeral resources from path",
+			enabled: true,
+			expected: []*EphemeralResource{
+				{
+					Type:           "password",
+					Name:           "db",
+					ProviderName:   "random",
+					ProviderSource: "hashicorp/random",
+					Version:        types.String("latest"),
+					Description:    types.String("Password of the database"),
+				},
+				{
+					Type:           "secretsmanager_secret_version",
+					Name:           "db",
+					ProviderName:   "aws",
+					ProviderSource: "hashicorp/aws",
+					Version:        types.String("5.80.0"),
+					Description:    types.String(""),
+				},
+			},
+		},
+		{
+			name:     "load ephemeral resources disabled",
+			enabled:  false,
+			expected: []*EphemeralResource{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", "with-ephemeral-resources")
+			config.Settings.ReadComments = true
+			config.Settings.ShowEphemeralResources = tt.enabled
+
+			module, err := loadModule(config.ModuleRoot)
+			assert.Nil(err)
+
+			actual, err := loadEphemeralResources(module, config, loadTestHCLFiles(t, config.ModuleRoot))
+			assert.Nil(err)
+
+			for _, r := range actual {
+				r.Position = Position{}
+			}
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestLoadTerraformVersion(t *testing.T) {
+	tests := []struct {
+		name       string
+		path       string
+		version    string
+		checks     int
+		ephemeral  int
+		moved      int
+		conditions int
+	}{
+		{
+			name:      "load module targeting older version",
+			path:      "terraform-0.14",
+			version:   "0.14",
+			checks:    0,
+			ephemeral: 0,
+		},
+		{
+			name:      "load checks with older version",
+			path:      "with-checks",
+			version:   "1.4",
+			checks:    0,
+			ephemeral: 0,
+		},
+		{
+			name:      "load checks with supported version",
+			path:      "with-checks",
+			version:   "1.5",
+			checks:    3,
+			ephemeral: 0,
+		},
+		{
+			name:      "load ephemeral resources with older version",
+			path:      "with-ephemeral-resources",
+			version:   "1.9",
+			checks:    0,
+			ephemeral: 0,
+		},
+		{
+			name:      "load ephemeral resources with supported version",
+			path:      "with-ephemeral-resources",
+			version:   "1.10",
+			checks:    0,
+			ephemeral: 2,
+		},
+		{
+			name:    "load moved blocks with older version",
+			path:    "with-moved/one",
+			version: "1.0",
+			moved:   0,
+		},
+		{
+			name:    "load moved blocks with supported version",
+			path:    "with-moved/one",
+			version: "1.1",
+			moved:   1,
+		},
+		{
+			name:       "load output conditions with older version",
+			path:       "with-output-conditions",
+			version:    "1.1",
+			conditions: 0,
+		},
+		{
+			name:       "load output conditions with supported version",
+			path:       "with-output-conditions",
+			version:    "1.2",
+			conditions: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.DefaultConfig()
+			config.ModuleRoot = filepath.Join("testdata", tt.path)
+			config.Cache.Enabled = false
+			config.TerraformVersion = tt.version
+			config.Settings.ShowChecks = true
+			config.Settings.ShowEphemeralResources = true
+
+			module, err := LoadWithOptions(config)
+
+			assert.Nil(err)
+			assert.Equal(tt.checks, len(module.Checks))
+			assert.Equal(tt.ephemeral, len(module.EphemeralResources))
+			assert.Equal(tt.moved, len(module.Moved))
+
+			conditions := 0
+			for _, o := range module.Outputs {
+				conditions += len(o.Preconditions) + len(o.Postconditions)
+			}
+			assert.Equal(tt.conditions, conditions)
+		})
+	}
+}
+
+func TestLoadExamplePlan(t *testing.T) {
+	// fake 'terraform' which fails the plan if the var file doesn't exist
+	// and shows the plan of testdata
+	bin := t.TempDir()
+	script := strings.Join([]string{
+		"#!/bin/sh",
+		`case "$1" in`,
+		"plan)",
+		`  for arg in "$@"; do`,
+		`    case "$arg" in -var-file=*) [ -f "${arg#-var-file=}" ] || { echo "Error: Failed to read variables file" >&2; exit 1; } ;; esac`,
+		"  done ;;",
+		"show) cat plan.json ;;",
+		"esac",
+	}, "\n")
+	if err := os.WriteFile(filepath.Join(bin, "terraform"), []byte(script), 0755); err != nil { //nolint:gosec
+		t.Fatal(err)
+	}
+
+	path := os.Getenv("PATH")
+	os.Setenv("PATH", bin+string(os.PathListSeparator)+path) //nolint:errcheck,gosec
+	defer os.Setenv("PATH", path)                            //nolint:errcheck,gosec
+
+	tests := map[string]struct {
+		vars     string
+		expected []*PlannedResource
+		errMsg   string
+	}{
+		"RelativeToModuleRoot": {
+			vars: "example.tfvars",
+			expected: []*PlannedResource{
+				{Type: "aws_instance", Count: 2},
+				{Type: "aws_security_group", Count: 1},
+			},
+		},
+		"PlanFailed": {
+			vars:     "missing.tfvars",
+			expected: nil,
+			errMsg:   "caught error while running the terraform plan: exit status 1, Error: Failed to read variables file",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", "example-plan")
+			config.ExamplePlan.Enabled = true
+			config.ExamplePlan.Vars = tt.vars
+
+			actual, err := loadExamplePlan(config)
+			if tt.errMsg != "" {
+				assert.NotNil(err)
+				assert.Equal(tt.errMsg, err.Error())
+			} else {
+				assert.Nil(err)
+			}
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestLoadProviderVersionChanges(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not available")
+	}
+
+	dir := t.TempDir()
+	module := filepath.Join(dir, "modules", "foo")
+	if err := os.MkdirAll(module, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	git := func(args ...string) {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com", "-c", "commit.gpgsign=false"}, args...)...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %s", args, out)
+		}
+	}
+	commit := func(content string) {
+		if err := os.WriteFile(filepath.Join(module, ".terraform.lock.hcl"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		git("add", "-A")
+		git("commit", "-q", "-m", "update lock file")
+	}
+
+	git("init", "-q")
+	if err := os.WriteFile(filepath.Join(module, "main.tf"), []byte(""), 0644); err != nil {
+		t.Fatal(err)
+	}
+	git("add", "-A")
+	git("commit", "-q", "-m", "add module")
+	commit("provider \"registry.terraform.io/hashicorp/aws\" {\n  version = \"5.79.0\"\n}\n")
+	commit("provider \"registry.terraform.io/hashicorp/aws\" {\n  version = \"5.80.0\"\n}\n\nprovider \"registry.terraform.io/hashicorp/tls\" {\n  version = \"4.0.6\"\n}\n")
+
+	tests := []struct {
+		name     string
+		enabled  bool
+		from     string
+		to       string
+		expected []*ProviderVersionChange
+		wantErr  bool
+	}{
+		{
+			name:    "load provider version changes",
+			enabled: true,
+			from:    "HEAD~1",
+			to:      "HEAD",
+			expected: []*ProviderVersionChange{
+				{Name: "hashicorp/aws", OldVersion: "5.79.0", NewVersion: "5.80.0"},
+				{Name: "hashicorp/tls", OldVersion: "", NewVersion: "4.0.6"},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "load provider version changes of added lock file",
+			enabled: true,
+			from:    "HEAD~2",
+			to:      "HEAD",
+			expected: []*ProviderVersionChange{
+				{Name: "hashicorp/aws", OldVersion: "", NewVersion: "5.80.0"},
+				{Name: "hashicorp/tls", OldVersion: "", NewVersion: "4.0.6"},
+			},
+			wantErr: false,
+		},
+		{
+			name:     "load provider version changes disabled",
+			enabled:  false,
+			from:     "HEAD~1",
+			to:       "HEAD",
+			expected: []*ProviderVersionChange{},
+			wantErr:  false,
+		},
+		{
+			name:     "load provider version changes of unknown ref",
+			enabled:  true,
+			from:     "noop",
+			to:       "HEAD",
+			expected: nil,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = module
+			config.LockDiff.Enabled = tt.enabled
+			config.LockDiff.From = tt.from
+			config.LockDiff.To = tt.to
+
+			actual, err := loadProviderVersionChanges(config)
+
+			if tt.wantErr {
+				assert.NotNil(err)
+			} else {
+				assert.Nil(err)
+				assert.Equal(tt.expected, actual)
+			}
+		})
+	}
+}
+
+func loadTestHCLFiles(t *testing.T, dir string) []*hcl.File {
+	files, err := loadHCLFiles(dir)
+	assert.Nil(t, err)
+	return files
+}
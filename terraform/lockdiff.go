@@ -0,0 +1,90 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsimple"
+)
+
+// lockFile is the name of the dependency lock file of the module.
+const lockFile = ".terraform.lock.hcl"
+
+// ProviderVersionChange represents the change of locked version of a provider
+// in the dependency lock file between two Git refs. 'OldVersion' is empty if
+// the provider is added, and 'NewVersion' is empty if it's removed.
+type ProviderVersionChange struct {
+	Name       string `json:"name" toml:"name" xml:"name" yaml:"name"`
+	OldVersion string `json:"old_version" toml:"old_version" xml:"old_version" yaml:"old_version"`
+	NewVersion string `json:"new_version" toml:"new_version" xml:"new_version" yaml:"new_version"`
+}
+
+// parseLockFile reads the content of '.terraform.lock.hcl' and returns the
+// locked version of the providers by their source address, without hostname
+// of the public Terraform Registry (e.g. 'hashicorp/aws').
+func parseLockFile(content []byte) (map[string]string, error) {
+	type provider struct {
+		Name    string   `hcl:"name,label"`
+		Version string   `hcl:"version,optional"`
+		Remain  hcl.Body `hcl:",remain"`
+	}
+	type lockfile struct {
+		Provider []provider `hcl:"provider,block"`
+	}
+	var lf lockfile
+
+	versions := make(map[string]string)
+
+	if len(content) == 0 {
+		return versions, nil
+	}
+	if err := hclsimple.Decode(lockFile, content, nil, &lf); err != nil {
+		return nil, fmt.Errorf("unable to decode dependency lock file, %w", err)
+	}
+
+	for _, p := range lf.Provider {
+		versions[strings.TrimPrefix(p.Name, "registry.terraform.io/")] = p.Version
+	}
+	return versions, nil
+}
+
+// diffLockFiles returns the providers which their locked version is changed,
+// added or removed between 'from' and 'to' versions, sorted by name.
+func diffLockFiles(from map[string]string, to map[string]string) []*ProviderVersionChange {
+	names := make([]string, 0, len(from)+len(to))
+	for name := range from {
+		names = append(names, name)
+	}
+	for name := range to {
+		if _, ok := from[name]; !ok {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	changes := make([]*ProviderVersionChange, 0)
+	for _, name := range names {
+		if from[name] == to[name] {
+			continue
+		}
+		changes = append(changes, &ProviderVersionChange{
+			Name:       name,
+			OldVersion: from[name],
+			NewVersion: to[name],
+		})
+	}
+	return changes
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLockFile(t *testing.T) {
+	tests := map[string]struct {
+		content  string
+		expected map[string]string
+		wantErr  bool
+	}{
+		"Empty": {
+			content:  "",
+			expected: map[string]string{},
+			wantErr:  false,
+		},
+		"Providers": {
+			content: `
+provider "registry.terraform.io/hashicorp/aws" {
+  version     = "5.80.0"
+  constraints = ">= 5.0.0"
+  hashes = [
+    "h1:quV6hK7ewiHWBznGWCb/gJ6JAPm6UtouBUrhAjv6oRY=",
+  ]
+}
+
+provider "example.com/acme/foo" {
+  version = "1.2.3"
+}
+`,
+			expected: map[string]string{
+				"hashicorp/aws":        "5.80.0",
+				"example.com/acme/foo": "1.2.3",
+			},
+			wantErr: false,
+		},
+		"Invalid": {
+			content:  `provider {`,
+			expected: nil,
+			wantErr:  true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual, err := parseLockFile([]byte(tt.content))
+
+			if tt.wantErr {
+				assert.NotNil(err)
+			} else {
+				assert.Nil(err)
+				assert.Equal(tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestDiffLockFiles(t *testing.T) {
+	assert := assert.New(t)
+
+	from := map[string]string{
+		"hashicorp/aws":    "5.79.0",
+		"hashicorp/null":   "3.2.0",
+		"hashicorp/random": "3.6.0",
+	}
+	to := map[string]string{
+		"hashicorp/aws":  "5.80.0",
+		"hashicorp/null": "3.2.0",
+		"hashicorp/tls":  "4.0.6",
+	}
+
+	actual := diffLockFiles(from, to)
+
+	assert.Equal([]*ProviderVersionChange{
+		{Name: "hashicorp/aws", OldVersion: "5.79.0", NewVersion: "5.80.0"},
+		{Name: "hashicorp/random", OldVersion: "3.6.0", NewVersion: ""},
+		{Name: "hashicorp/tls", OldVersion: "", NewVersion: "4.0.6"},
+	}, actual)
+}
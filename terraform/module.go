@@ -27,6 +27,31 @@ type Module struct {
 	Requirements []*Requirement `json:"requirements" toml:"requirements" xml:"requirements>requirement" yaml:"requirements"`
 	Resources    []*Resource    `json:"resources" toml:"resources" xml:"resources>resource" yaml:"resources"`
 
+	RequiredCoreVersion string `json:"required_core_version,omitempty" toml:"required_core_version,omitempty" xml:"required_core_version,omitempty" yaml:"required_core_version,omitempty"`
+	PinnedCoreVersion   string `json:"pinned_core_version,omitempty" toml:"pinned_core_version,omitempty" xml:"pinned_core_version,omitempty" yaml:"pinned_core_version,omitempty"`
+
+	Purpose             string                   `json:"purpose,omitempty" toml:"purpose,omitempty" xml:"purpose,omitempty" yaml:"purpose,omitempty"`
+	License             string                   `json:"license,omitempty" toml:"license,omitempty" xml:"-" yaml:"license,omitempty"`
+	Checks              []*Check                 `json:"checks,omitempty" toml:"checks,omitempty" xml:"-" yaml:"checks,omitempty"`
+	TestRuns            []*TestRun               `json:"test_runs,omitempty" toml:"test_runs,omitempty" xml:"-" yaml:"test_runs,omitempty"`
+	TestResults         []*TestSuite             `json:"test_results,omitempty" toml:"test_results,omitempty" xml:"-" yaml:"test_results,omitempty"`
+	Moved               []*Moved                 `json:"moved,omitempty" toml:"moved,omitempty" xml:"-" yaml:"moved,omitempty"`
+	ModuleCallTree      []*ModuleCallNode        `json:"module_call_tree,omitempty" toml:"module_call_tree,omitempty" xml:"-" yaml:"module_call_tree,omitempty"`
+	EphemeralResources  []*EphemeralResource     `json:"ephemeral_resources,omitempty" toml:"ephemeral_resources,omitempty" xml:"-" yaml:"ephemeral_resources,omitempty"`
+	Summary             *Summary                 `json:"summary,omitempty" toml:"summary,omitempty" xml:"summary,omitempty" yaml:"summary,omitempty"`
+	SensitiveSummary    *SensitiveSummary        `json:"sensitive_summary,omitempty" toml:"sensitive_summary,omitempty" xml:"sensitive_summary,omitempty" yaml:"sensitive_summary,omitempty"`
+	VariableSummary     *VariableSummary         `json:"variable_summary,omitempty" toml:"variable_summary,omitempty" xml:"variable_summary,omitempty" yaml:"variable_summary,omitempty"`
+	HealthScore         *HealthScore             `json:"health_score,omitempty" toml:"health_score,omitempty" xml:"health_score,omitempty" yaml:"health_score,omitempty"`
+	CompatibilityMatrix []*Compatibility         `json:"compatibility_matrix,omitempty" toml:"compatibility_matrix,omitempty" xml:"-" yaml:"compatibility_matrix,omitempty"`
+	ExamplePlan         []*PlannedResource       `json:"example_plan,omitempty" toml:"example_plan,omitempty" xml:"-" yaml:"example_plan,omitempty"`
+	ExampleOutputs      []*ExampleOutput         `json:"example_outputs,omitempty" toml:"example_outputs,omitempty" xml:"-" yaml:"example_outputs,omitempty"`
+	ExampleValidations  []*ExampleValidation     `json:"example_validations,omitempty" toml:"example_validations,omitempty" xml:"-" yaml:"example_validations,omitempty"`
+	CostEstimate        *CostEstimate            `json:"cost_estimate,omitempty" toml:"cost_estimate,omitempty" xml:"cost_estimate,omitempty" yaml:"cost_estimate,omitempty"`
+	SecurityFindings    []*SecurityFinding       `json:"security_findings,omitempty" toml:"security_findings,omitempty" xml:"-" yaml:"security_findings,omitempty"`
+	LockChanges         []*ProviderVersionChange `json:"provider_version_changes,omitempty" toml:"provider_version_changes,omitempty" xml:"-" yaml:"provider_version_changes,omitempty"`
+	Backend             *Backend                 `json:"backend,omitempty" toml:"backend,omitempty" xml:"backend,omitempty" yaml:"backend,omitempty"`
+	Changelog           string                   `json:"changelog,omitempty" toml:"changelog,omitempty" xml:"changelog,omitempty" yaml:"changelog,omitempty"`
+
 	RequiredInputs []*Input `json:"-" toml:"-" xml:"-" yaml:"-"`
 	OptionalInputs []*Input `json:"-" toml:"-" xml:"-" yaml:"-"`
 }
@@ -56,17 +81,121 @@ func (m *Module) HasOutputs() bool {
 	return len(m.Outputs) > 0
 }
 
+// HasInputValidations indicates if any of the inputs has validation.
+func (m *Module) HasInputValidations() bool {
+	for _, i := range m.Inputs {
+		if len(i.Validations) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// HasOutputConditions indicates if any of the outputs has precondition or
+// postcondition.
+func (m *Module) HasOutputConditions() bool {
+	for _, o := range m.Outputs {
+		if o.HasConditions() {
+			return true
+		}
+	}
+	return false
+}
+
 // HasProviders indicates if the module has providers.
 func (m *Module) HasProviders() bool {
 	return len(m.Providers) > 0
 }
 
+// HasTagCompliance indicates if compliance of tags of any of the resources is
+// checked against the tag policy.
+func (m *Module) HasTagCompliance() bool {
+	for _, r := range m.Resources {
+		if r.TagCompliance != nil {
+			return true
+		}
+	}
+	return false
+}
+
 // HasRequirements indicates if the module has requirements.
 func (m *Module) HasRequirements() bool {
 	return len(m.Requirements) > 0
 }
 
+// HasPinnedCoreVersion indicates if the module has exact version of Terraform
+// pinned in '.terraform-version' file.
+func (m *Module) HasPinnedCoreVersion() bool {
+	return len(m.PinnedCoreVersion) > 0
+}
+
+// Version returns the Terraform version of the module, which is the pinned
+// version if any, otherwise the 'required_version' constraint.
+func (m *Module) Version() string {
+	if m.HasPinnedCoreVersion() {
+		return m.PinnedCoreVersion
+	}
+	return m.RequiredCoreVersion
+}
+
 // HasResources indicates if the module has resources.
 func (m *Module) HasResources() bool {
 	return len(m.Resources) > 0
 }
+
+// HasLicense indicates if the module has a known license.
+func (m *Module) HasLicense() bool {
+	return len(m.License) > 0
+}
+
+// HasChecks indicates if the module has checks.
+func (m *Module) HasChecks() bool {
+	return len(m.Checks) > 0
+}
+
+// HasMoved indicates if the module has moved blocks.
+func (m *Module) HasMoved() bool {
+	return len(m.Moved) > 0
+}
+
+// HasTestRuns indicates if the module has 'run' blocks in its test files.
+func (m *Module) HasTestRuns() bool {
+	return len(m.TestRuns) > 0
+}
+
+// HasExamplePlan indicates if the module has summary of example plan.
+func (m *Module) HasExamplePlan() bool {
+	return len(m.ExamplePlan) > 0
+}
+
+// HasExampleOutputs indicates if the module has output values of its examples.
+func (m *Module) HasExampleOutputs() bool {
+	return len(m.ExampleOutputs) > 0
+}
+
+// HasExampleValidations indicates if the module has validation results of its
+// examples.
+func (m *Module) HasExampleValidations() bool {
+	return len(m.ExampleValidations) > 0
+}
+
+// HasSecurityFindings indicates if the module has any security finding.
+func (m *Module) HasSecurityFindings() bool {
+	return len(m.SecurityFindings) > 0
+}
+
+// HasModuleCallTree indicates if the module has tree of nested module calls.
+func (m *Module) HasModuleCallTree() bool {
+	return len(m.ModuleCallTree) > 0
+}
+
+// HasCompatibilityMatrix indicates if the module has compatibility matrix.
+func (m *Module) HasCompatibilityMatrix() bool {
+	return len(m.CompatibilityMatrix) > 0
+}
+
+// CompatibilityProviders returns sorted list of provider names found in
+// compatibility matrix of the module.
+func (m *Module) CompatibilityProviders() []string {
+	return compatibilities(m.CompatibilityMatrix).providerNames()
+}
@@ -12,7 +12,9 @@ package terraform
 
 import (
 	"fmt"
+	"regexp"
 	"sort"
+	"strings"
 
 	"github.com/terraform-docs/terraform-docs/internal/types"
 	"github.com/terraform-docs/terraform-docs/print"
@@ -24,6 +26,7 @@ type ModuleCall struct {
 	Source      string       `json:"source" toml:"source" xml:"source" yaml:"source"`
 	Version     string       `json:"version" toml:"version" xml:"version" yaml:"version"`
 	Description types.String `json:"description" toml:"description" xml:"description" yaml:"description"`
+	DocsURL     string       `json:"docs_url,omitempty" toml:"docs_url,omitempty" xml:"docs_url,omitempty" yaml:"docs_url,omitempty"`
 	Position    Position     `json:"-" toml:"-" xml:"-" yaml:"-"`
 }
 
@@ -35,6 +38,57 @@ func (mc *ModuleCall) FullName() string {
 	return mc.Source
 }
 
+// registrySource matches the source address of a module in the public Terraform
+// Registry, i.e. '<NAMESPACE>/<NAME>/<PROVIDER>' optionally prefixed with the
+// hostname of the registry and followed by a subdirectory.
+var registrySource = regexp.MustCompile(`^(?:registry\.terraform\.io/)?([0-9A-Za-z][0-9A-Za-z_-]*)/([0-9A-Za-z][0-9A-Za-z_-]*)/([0-9a-z]+)(?://.*)?$`)
+
+// RegistryURL returns URL of the modulecall in the public Terraform Registry,
+// or empty string if its source is not a registry address (e.g. Git or local
+// path).
+func (mc *ModuleCall) RegistryURL() string {
+	matches := registrySource.FindStringSubmatch(mc.Source)
+	if matches == nil {
+		return ""
+	}
+	return fmt.Sprintf("https://registry.terraform.io/modules/%s/%s/%s", matches[1], matches[2], matches[3])
+}
+
+// gitHosts are the hosts which their sources are Git repositories even without
+// 'git::' prefix, as detected by Terraform.
+var gitHosts = []string{"github.com/", "bitbucket.org/"}
+
+// RepositoryURL returns URL of the Git repository of the modulecall, without
+// its subdirectory and ref, or empty string if its source is not a Git address
+// (e.g. registry or local path).
+func (mc *ModuleCall) RepositoryURL() string {
+	git := strings.HasPrefix(mc.Source, "git::") || strings.HasPrefix(mc.Source, "git@")
+	for _, host := range gitHosts {
+		git = git || strings.HasPrefix(mc.Source, host)
+	}
+	if !git {
+		return ""
+	}
+
+	source := normalizeSource(mc.Source)
+	if query := strings.Index(source, "?"); query != -1 {
+		source = source[:query]
+	}
+	if slashes := strings.Index(source, "//"); slashes != -1 {
+		source = source[:slashes]
+	}
+	source = strings.TrimSuffix(strings.TrimSuffix(source, "/"), ".git")
+	if source == "" {
+		return ""
+	}
+	return "https://" + source
+}
+
+// IsLocal indicates if the source of the modulecall is a local path.
+func (mc *ModuleCall) IsLocal() bool {
+	return isLocalSource(mc.Source)
+}
+
 func sortModulecallsByName(x []*ModuleCall) {
 	sort.Slice(x, func(i, j int) bool {
 		return x[i].Name < x[j].Name
@@ -45,6 +45,110 @@ func TestModulecallName(t *testing.T) {
 	}
 }
 
+func TestModulecallRegistryURL(t *testing.T) {
+	tests := map[string]struct {
+		source   string
+		expected string
+	}{
+		"Registry": {
+			source:   "terraform-aws-modules/vpc/aws",
+			expected: "https://registry.terraform.io/modules/terraform-aws-modules/vpc/aws",
+		},
+		"RegistryWithHostname": {
+			source:   "registry.terraform.io/hashicorp/consul/aws",
+			expected: "https://registry.terraform.io/modules/hashicorp/consul/aws",
+		},
+		"RegistryWithSubdirectory": {
+			source:   "hashicorp/consul/aws//modules/consul-cluster",
+			expected: "https://registry.terraform.io/modules/hashicorp/consul/aws",
+		},
+		"PrivateRegistry": {
+			source:   "app.terraform.io/example-corp/k8s-cluster/azurerm",
+			expected: "",
+		},
+		"Git": {
+			source:   "git::https://example.com/vpc.git",
+			expected: "",
+		},
+		"GitHub": {
+			source:   "github.com/hashicorp/example",
+			expected: "",
+		},
+		"LocalPath": {
+			source:   "./modules/foo",
+			expected: "",
+		},
+		"ParentPath": {
+			source:   "../foo/bar",
+			expected: "",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			module := ModuleCall{Name: "foo", Source: tt.source}
+			assert.Equal(tt.expected, module.RegistryURL())
+		})
+	}
+}
+
+func TestModulecallRepositoryURL(t *testing.T) {
+	tests := map[string]struct {
+		source   string
+		expected string
+	}{
+		"Registry": {
+			source:   "terraform-aws-modules/vpc/aws",
+			expected: "",
+		},
+		"Git": {
+			source:   "git::https://example.com/vpc.git",
+			expected: "https://example.com/vpc",
+		},
+		"GitWithRef": {
+			source:   "git::https://example.com/vpc.git?ref=v1.2.0",
+			expected: "https://example.com/vpc",
+		},
+		"GitWithSubdirectory": {
+			source:   "git::https://example.com/network.git//modules/vpc?ref=v1.2.0",
+			expected: "https://example.com/network",
+		},
+		"GitSSH": {
+			source:   "git::ssh://git@example.com/storage.git",
+			expected: "https://example.com/storage",
+		},
+		"GitHub": {
+			source:   "github.com/hashicorp/example",
+			expected: "https://github.com/hashicorp/example",
+		},
+		"GitHubSCP": {
+			source:   "git@github.com:hashicorp/example.git",
+			expected: "https://github.com/hashicorp/example",
+		},
+		"Bitbucket": {
+			source:   "bitbucket.org/hashicorp/terraform-consul-aws",
+			expected: "https://bitbucket.org/hashicorp/terraform-consul-aws",
+		},
+		"Archive": {
+			source:   "https://example.com/vpc-module.zip",
+			expected: "",
+		},
+		"LocalPath": {
+			source:   "./modules/foo",
+			expected: "",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			module := ModuleCall{Name: "foo", Source: tt.source}
+			assert.Equal(tt.expected, module.RepositoryURL())
+		})
+	}
+}
+
 func TestModulecallSort(t *testing.T) {
 	modules := sampleModulecalls()
 	tests := map[string]struct {
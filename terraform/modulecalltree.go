@@ -0,0 +1,73 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/terraform-docs/terraform-config-inspect/tfconfig"
+)
+
+// ModuleCallNode represents a module call in the tree of nested module calls of
+// the module. Module calls of the called module are only resolved if its source
+// is a local path (e.g. './modules/foo').
+type ModuleCallNode struct {
+	Name     string            `json:"name" toml:"name" xml:"name" yaml:"name"`
+	Source   string            `json:"source" toml:"source" xml:"source" yaml:"source"`
+	Children []*ModuleCallNode `json:"children,omitempty" toml:"children,omitempty" xml:"children>module,omitempty" yaml:"children,omitempty"`
+}
+
+// isLocalSource indicates if the source address of a module call is a local
+// path, which is always prefixed with './' or '../'.
+func isLocalSource(source string) bool {
+	return strings.HasPrefix(source, "./") || strings.HasPrefix(source, "../")
+}
+
+// newModuleCallNodes returns the module calls of 'tfmodule' in 'dir', by their
+// name, with their nested module calls up to 'maxDepth' levels ('depth' is the
+// level of the module calls of 'tfmodule'). Called modules which can't be read,
+// or are already called by one of their ancestors, are not resolved.
+func newModuleCallNodes(tfmodule *tfconfig.Module, dir string, depth int, maxDepth int, ancestors map[string]bool) []*ModuleCallNode {
+	names := make([]string, 0, len(tfmodule.ModuleCalls))
+	for name := range tfmodule.ModuleCalls {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	nodes := make([]*ModuleCallNode, 0, len(names))
+	for _, name := range names {
+		call := tfmodule.ModuleCalls[name]
+		node := &ModuleCallNode{
+			Name:   call.Name,
+			Source: call.Source,
+		}
+		nodes = append(nodes, node)
+
+		if depth >= maxDepth || !isLocalSource(call.Source) {
+			continue
+		}
+		path := filepath.Join(dir, call.Source)
+		if ancestors[path] || !tfconfig.IsModuleDir(path) {
+			continue
+		}
+		child, diags := tfconfig.LoadModule(path)
+		if diags.HasErrors() {
+			continue
+		}
+
+		ancestors[path] = true
+		node.Children = newModuleCallNodes(child, path, depth+1, maxDepth, ancestors)
+		delete(ancestors, path)
+	}
+	return nodes
+}
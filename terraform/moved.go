@@ -0,0 +1,24 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+// Moved represents a 'moved' block of Terraform module, which declares the change
+// of address of a resource or a module call. 'From' and 'To' addresses are kept
+// as is, they're not evaluated.
+type Moved struct {
+	From     types.String `json:"from" toml:"from" xml:"from" yaml:"from"`
+	To       types.String `json:"to" toml:"to" xml:"to" yaml:"to"`
+	Position Position     `json:"-" toml:"-" xml:"-" yaml:"-"`
+}
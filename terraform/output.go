@@ -23,19 +23,42 @@ import (
 type Output struct {
 	Name        string       `json:"name" toml:"name" xml:"name" yaml:"name"`
 	Description types.String `json:"description" toml:"description" xml:"description" yaml:"description"`
+	Type        types.String `json:"type,omitempty" toml:"type,omitempty" xml:"type,omitempty" yaml:"type,omitempty"`
 	Value       types.Value  `json:"value,omitempty" toml:"value,omitempty" xml:"value,omitempty" yaml:"value,omitempty"`
 	Sensitive   bool         `json:"sensitive,omitempty" toml:"sensitive,omitempty" xml:"sensitive,omitempty" yaml:"sensitive,omitempty"`
+	Deprecated  string       `json:"deprecated,omitempty" toml:"deprecated,omitempty" xml:"deprecated,omitempty" yaml:"deprecated,omitempty"`
+	Consumers   []string     `json:"consumers,omitempty" toml:"consumers,omitempty" xml:"consumers>consumer,omitempty" yaml:"consumers,omitempty"`
 	Position    Position     `json:"-" toml:"-" xml:"-" yaml:"-"`
 	ShowValue   bool         `json:"-" toml:"-" xml:"-" yaml:"-"`
+
+	ShowSensitive bool `json:"-" toml:"-" xml:"-" yaml:"-"`
+
+	Preconditions  []*Condition `json:"preconditions" toml:"preconditions,omitempty" xml:"-" yaml:"preconditions"`
+	Postconditions []*Condition `json:"postconditions" toml:"postconditions,omitempty" xml:"-" yaml:"postconditions"`
 }
 
 type withvalue struct {
 	Name        string       `json:"name" toml:"name" xml:"name" yaml:"name"`
 	Description types.String `json:"description" toml:"description" xml:"description" yaml:"description"`
+	Type        types.String `json:"type,omitempty" toml:"type,omitempty" xml:"type,omitempty" yaml:"type,omitempty"`
 	Value       types.Value  `json:"value" toml:"value" xml:"value" yaml:"value"`
 	Sensitive   bool         `json:"sensitive" toml:"sensitive" xml:"sensitive" yaml:"sensitive"`
+	Deprecated  string       `json:"deprecated,omitempty" toml:"deprecated,omitempty" xml:"deprecated,omitempty" yaml:"deprecated,omitempty"`
+	Consumers   []string     `json:"consumers,omitempty" toml:"consumers,omitempty" xml:"consumers>consumer,omitempty" yaml:"consumers,omitempty"`
 	Position    Position     `json:"-" toml:"-" xml:"-" yaml:"-"`
 	ShowValue   bool         `json:"-" toml:"-" xml:"-" yaml:"-"`
+
+	ShowSensitive bool `json:"-" toml:"-" xml:"-" yaml:"-"`
+
+	Preconditions  []*Condition `json:"preconditions" toml:"preconditions,omitempty" xml:"-" yaml:"preconditions"`
+	Postconditions []*Condition `json:"postconditions" toml:"postconditions,omitempty" xml:"-" yaml:"postconditions"`
+}
+
+// Condition represents a 'precondition' or 'postcondition' of Terraform output,
+// or a 'validation' of Terraform variable.
+type Condition struct {
+	Expression   types.String `json:"expression" toml:"expression" xml:"expression" yaml:"expression"`
+	ErrorMessage types.String `json:"error_message" toml:"error_message" xml:"error_message" yaml:"error_message"`
 }
 
 // GetValue returns JSON representation of the 'Value', which is an 'interface'.
@@ -56,6 +79,12 @@ func (o *Output) GetValue() string {
 	return value // everything else
 }
 
+// HasConditions indicates if a Terraform output has any precondition or
+// postcondition.
+func (o *Output) HasConditions() bool {
+	return len(o.Preconditions) > 0 || len(o.Postconditions) > 0
+}
+
 // HasDefault indicates if a Terraform output has a default value set.
 func (o *Output) HasDefault() bool {
 	if !o.ShowValue || o.Value == nil {
@@ -67,7 +96,8 @@ func (o *Output) HasDefault() bool {
 // MarshalJSON custom yaml marshal function to take '--output-values' flag into
 // consideration. It means if the flag is not set Value and Sensitive fields are
 // set to 'omitempty', otherwise if output values are being shown 'omitempty' gets
-// explicitly removed to show even empty and false values.
+// explicitly removed to show even empty and false values. Sensitive is kept with
+// '--show-attributes' even if output values are not shown.
 func (o *Output) MarshalJSON() ([]byte, error) {
 	fn := func(oo interface{}) ([]byte, error) {
 		buf := new(bytes.Buffer)
@@ -78,11 +108,14 @@ func (o *Output) MarshalJSON() ([]byte, error) {
 		}
 		return buf.Bytes(), nil
 	}
+	o.normalizeConditions()
 	if o.ShowValue {
 		return fn(withvalue(*o))
 	}
-	o.Value = nil       // explicitly make empty
-	o.Sensitive = false // explicitly make empty
+	o.Value = nil // explicitly make empty
+	if !o.ShowSensitive {
+		o.Sensitive = false // explicitly make empty
+	}
 	return fn(*o)
 }
 
@@ -100,9 +133,23 @@ func (o *Output) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	}
 	fn(o.Name, "name")               //nolint:errcheck,gosec
 	fn(o.Description, "description") //nolint:errcheck,gosec
+	if o.Type != "" {
+		fn(o.Type, "type") //nolint:errcheck,gosec
+	}
 	if o.ShowValue {
 		fn(o.Value, "value")         //nolint:errcheck,gosec
 		fn(o.Sensitive, "sensitive") //nolint:errcheck,gosec
+	} else if o.ShowSensitive && o.Sensitive {
+		fn(o.Sensitive, "sensitive") //nolint:errcheck,gosec
+	}
+	if o.Deprecated != "" {
+		fn(o.Deprecated, "deprecated") //nolint:errcheck,gosec
+	}
+	if len(o.Consumers) > 0 {
+		consumers := struct {
+			Consumer []string `xml:"consumer"`
+		}{o.Consumers}
+		fn(consumers, "consumers") //nolint:errcheck,gosec
 	}
 	return e.EncodeToken(start.End())
 }
@@ -110,16 +157,31 @@ func (o *Output) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 // MarshalYAML custom yaml marshal function to take '--output-values' flag into
 // consideration. It means if the flag is not set Value and Sensitive fields are
 // set to 'omitempty', otherwise if output values are being shown 'omitempty' gets
-// explicitly removed to show even empty and false values.
+// explicitly removed to show even empty and false values. Sensitive is kept with
+// '--show-attributes' even if output values are not shown.
 func (o *Output) MarshalYAML() (interface{}, error) {
+	o.normalizeConditions()
 	if o.ShowValue {
 		return withvalue(*o), nil
 	}
-	o.Value = nil       // explicitly make empty
-	o.Sensitive = false // explicitly make empty
+	o.Value = nil // explicitly make empty
+	if !o.ShowSensitive {
+		o.Sensitive = false // explicitly make empty
+	}
 	return *o, nil
 }
 
+// normalizeConditions makes sure preconditions and postconditions are always
+// rendered as an array (possibly empty) and not as 'null'.
+func (o *Output) normalizeConditions() {
+	if o.Preconditions == nil {
+		o.Preconditions = make([]*Condition, 0)
+	}
+	if o.Postconditions == nil {
+		o.Postconditions = make([]*Condition, 0)
+	}
+}
+
 // output is used for unmarshalling `terraform outputs --json` into
 type output struct {
 	Sensitive bool        `json:"sensitive"`
@@ -122,62 +122,73 @@ func TestOutputMarshalJSON(t *testing.T) {
 		{
 			name:     "output marshal JSON",
 			output:   outputs[0],
-			expected: "{\"name\":\"output\",\"description\":\"description\",\"value\":null,\"sensitive\":false}\n",
+			expected: "{\"name\":\"output\",\"description\":\"description\",\"value\":null,\"sensitive\":false,\"preconditions\":[],\"postconditions\":[]}\n",
 		},
 		{
 			name:     "output marshal JSON",
 			output:   outputs[1],
-			expected: "{\"name\":\"output\",\"description\":\"description\"}\n",
+			expected: "{\"name\":\"output\",\"description\":\"description\",\"preconditions\":[],\"postconditions\":[]}\n",
 		},
 		{
 			name:     "output marshal JSON",
 			output:   outputs[2],
-			expected: "{\"name\":\"output\",\"description\":\"description\",\"value\":false,\"sensitive\":false}\n",
+			expected: "{\"name\":\"output\",\"description\":\"description\",\"value\":false,\"sensitive\":false,\"preconditions\":[],\"postconditions\":[]}\n",
 		},
 		{
 			name:     "output marshal JSON",
 			output:   outputs[3],
-			expected: "{\"name\":\"output\",\"description\":\"description\",\"value\":\"\",\"sensitive\":false}\n",
+			expected: "{\"name\":\"output\",\"description\":\"description\",\"value\":\"\",\"sensitive\":false,\"preconditions\":[],\"postconditions\":[]}\n",
 		},
 		{
 			name:     "output marshal JSON",
 			output:   outputs[4],
-			expected: "{\"name\":\"output\",\"description\":\"description\",\"value\":\"foo\",\"sensitive\":false}\n",
+			expected: "{\"name\":\"output\",\"description\":\"description\",\"value\":\"foo\",\"sensitive\":false,\"preconditions\":[],\"postconditions\":[]}\n",
 		},
 		{
 			name:     "output marshal JSON",
 			output:   outputs[5],
-			expected: "{\"name\":\"output\",\"description\":\"description\"}\n",
+			expected: "{\"name\":\"output\",\"description\":\"description\",\"preconditions\":[],\"postconditions\":[]}\n",
 		},
 		{
 			name:     "output marshal JSON",
 			output:   outputs[6],
-			expected: "{\"name\":\"output\",\"description\":\"description\",\"value\":\"<sensitive>\",\"sensitive\":true}\n",
+			expected: "{\"name\":\"output\",\"description\":\"description\",\"value\":\"<sensitive>\",\"sensitive\":true,\"preconditions\":[],\"postconditions\":[]}\n",
 		},
 		{
 			name:     "output marshal JSON",
 			output:   outputs[7],
-			expected: "{\"name\":\"output\",\"description\":\"description\",\"value\":[\"a\",\"b\",\"c\"],\"sensitive\":false}\n",
+			expected: "{\"name\":\"output\",\"description\":\"description\",\"value\":[\"a\",\"b\",\"c\"],\"sensitive\":false,\"preconditions\":[],\"postconditions\":[]}\n",
 		},
 		{
 			name:     "output marshal JSON",
 			output:   outputs[8],
-			expected: "{\"name\":\"output\",\"description\":\"description\",\"value\":[],\"sensitive\":false}\n",
+			expected: "{\"name\":\"output\",\"description\":\"description\",\"value\":[],\"sensitive\":false,\"preconditions\":[],\"postconditions\":[]}\n",
 		},
 		{
 			name:     "output marshal JSON",
 			output:   outputs[9],
-			expected: "{\"name\":\"output\",\"description\":\"description\",\"value\":{\"a\":1,\"b\":2,\"c\":3},\"sensitive\":false}\n",
+			expected: "{\"name\":\"output\",\"description\":\"description\",\"value\":{\"a\":1,\"b\":2,\"c\":3},\"sensitive\":false,\"preconditions\":[],\"postconditions\":[]}\n",
 		},
 		{
 			name:     "output marshal JSON",
 			output:   outputs[10],
-			expected: "{\"name\":\"output\",\"description\":\"description\",\"value\":{},\"sensitive\":false}\n",
+			expected: "{\"name\":\"output\",\"description\":\"description\",\"value\":{},\"sensitive\":false,\"preconditions\":[],\"postconditions\":[]}\n",
 		},
 		{
 			name:     "output marshal JSON",
 			output:   outputs[11],
-			expected: "{\"name\":\"output\",\"description\":\"description\",\"value\":null,\"sensitive\":false}\n",
+			expected: "{\"name\":\"output\",\"description\":\"description\",\"value\":null,\"sensitive\":false,\"preconditions\":[],\"postconditions\":[]}\n",
+		},
+		{
+			name: "output marshal JSON with sensitive attribute",
+			output: Output{
+				Name:          "output",
+				Description:   types.String("description"),
+				Value:         types.ValueOf("foo"),
+				Sensitive:     true,
+				ShowSensitive: true,
+			},
+			expected: "{\"name\":\"output\",\"description\":\"description\",\"sensitive\":true,\"preconditions\":[],\"postconditions\":[]}\n",
 		},
 	}
 	for _, tt := range tests {
@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"path/filepath"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// consumedOutputs returns the names of the outputs of the module in 'module'
+// directory which are referenced by the module in 'dir' (e.g. 'module.vpc.vpc_id'),
+// through its module calls of local source resolving to 'module'. Directories
+// which aren't valid Terraform modules don't consume anything.
+func consumedOutputs(dir string, module string) map[string]bool {
+	consumed := make(map[string]bool)
+
+	filenames, err := filepath.Glob(filepath.Join(dir, "*.tf"))
+	if err != nil {
+		return consumed
+	}
+
+	parser := hclparse.NewParser()
+	bodies := make([]*hclsyntax.Body, 0, len(filenames))
+	calls := make(map[string]bool)
+
+	for _, filename := range filenames {
+		file, diags := parser.ParseHCLFile(filename)
+		if diags.HasErrors() {
+			return consumed
+		}
+		body, ok := file.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+		bodies = append(bodies, body)
+
+		for _, block := range body.Blocks {
+			if block.Type != "module" || len(block.Labels) != 1 {
+				continue
+			}
+			attr, ok := block.Body.Attributes["source"]
+			if !ok {
+				continue
+			}
+			var source string
+			if diags := gohcl.DecodeExpression(attr.Expr, nil, &source); diags.HasErrors() || !isLocalSource(source) {
+				continue
+			}
+			if filepath.Join(dir, source) == module {
+				calls[block.Labels[0]] = true
+			}
+		}
+	}
+	if len(calls) == 0 {
+		return consumed
+	}
+
+	for _, body := range bodies {
+		hclsyntax.VisitAll(body, func(node hclsyntax.Node) hcl.Diagnostics { //nolint:errcheck,gosec
+			if expr, ok := node.(*hclsyntax.ScopeTraversalExpr); ok {
+				if name, ok := moduleOutput(expr.Traversal, calls); ok {
+					consumed[name] = true
+				}
+			}
+			return nil
+		})
+	}
+
+	return consumed
+}
+
+// moduleOutput returns the name of the output referenced by 'traversal' if it
+// references an output of any of the module 'calls', e.g. 'module.vpc.vpc_id'
+// or 'module.vpc[0].vpc_id'.
+func moduleOutput(traversal hcl.Traversal, calls map[string]bool) (string, bool) {
+	if len(traversal) < 3 || traversal.RootName() != "module" {
+		return "", false
+	}
+	call, ok := traversal[1].(hcl.TraverseAttr)
+	if !ok || !calls[call.Name] {
+		return "", false
+	}
+	for _, step := range traversal[2:] {
+		switch s := step.(type) {
+		case hcl.TraverseIndex:
+			continue // instance of module call with 'count' or 'for_each'
+		case hcl.TraverseAttr:
+			return s.Name, true
+		default:
+			return "", false
+		}
+	}
+	return "", false
+}
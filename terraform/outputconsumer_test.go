@@ -0,0 +1,71 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModuleOutput(t *testing.T) {
+	calls := map[string]bool{"vpc": true}
+	tests := map[string]struct {
+		expr     string
+		expected string
+		ok       bool
+	}{
+		"Output": {
+			expr:     "module.vpc.vpc_id",
+			expected: "vpc_id",
+			ok:       true,
+		},
+		"OutputAttribute": {
+			expr:     "module.vpc.subnets.id",
+			expected: "subnets",
+			ok:       true,
+		},
+		"OutputOfInstance": {
+			expr:     `module.vpc["main"].vpc_id`,
+			expected: "vpc_id",
+			ok:       true,
+		},
+		"OtherModuleCall": {
+			expr:     "module.db.vpc_id",
+			expected: "",
+			ok:       false,
+		},
+		"ModuleCall": {
+			expr:     "module.vpc",
+			expected: "",
+			ok:       false,
+		},
+		"Variable": {
+			expr:     "var.vpc_id",
+			expected: "",
+			ok:       false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			traversal, diags := hclsyntax.ParseTraversalAbs([]byte(tt.expr), "", hcl.InitialPos)
+			assert.False(diags.HasErrors())
+
+			actual, ok := moduleOutput(traversal, calls)
+			assert.Equal(tt.expected, actual)
+			assert.Equal(tt.ok, ok)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// outputTypeAny is the type of the outputs which their value can't be inferred
+// without evaluating the module, e.g. attributes of resources.
+const outputTypeAny = "any"
+
+// inferOutputType returns the type of the 'value' expression of an output as
+// Terraform would infer it, e.g. 'string' for "foo" or 'number' for 42. Only
+// the expressions which can be evaluated without any context (i.e. literals
+// and collections of them) are inferred, everything else is 'any'.
+func inferOutputType(expr hcl.Expression) string {
+	// a template with interpolation (e.g. "${var.name}-suffix") is always
+	// a string, unless it's the only part of it (e.g. "${var.name}")
+	if template, ok := expr.(*hclsyntax.TemplateExpr); ok && len(template.Parts) > 1 {
+		return "string"
+	}
+
+	value, diags := expr.Value(nil)
+	if diags.HasErrors() || value.IsNull() || !value.IsKnown() {
+		return outputTypeAny
+	}
+
+	ty := value.Type()
+	switch {
+	case ty.IsPrimitiveType():
+		return ty.FriendlyName()
+	case ty.IsTupleType():
+		return "tuple"
+	case ty.IsObjectType():
+		return "object"
+	}
+	return outputTypeAny
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInferOutputType(t *testing.T) {
+	tests := map[string]struct {
+		expr     string
+		expected string
+	}{
+		"String": {
+			expr:     `"foo"`,
+			expected: "string",
+		},
+		"Number": {
+			expr:     `42`,
+			expected: "number",
+		},
+		"Bool": {
+			expr:     `true`,
+			expected: "bool",
+		},
+		"Null": {
+			expr:     `null`,
+			expected: "any",
+		},
+		"Template": {
+			expr:     `"${var.name}-suffix"`,
+			expected: "string",
+		},
+		"Interpolation": {
+			expr:     `"${var.name}"`,
+			expected: "any",
+		},
+		"Tuple": {
+			expr:     `["a", "b"]`,
+			expected: "tuple",
+		},
+		"Object": {
+			expr:     `{ name = "foo" }`,
+			expected: "object",
+		},
+		"Arithmetic": {
+			expr:     `1 + 2`,
+			expected: "number",
+		},
+		"ResourceAttribute": {
+			expr:     `aws_instance.this.id`,
+			expected: "any",
+		},
+		"FunctionCall": {
+			expr:     `join(",", var.list)`,
+			expected: "any",
+		},
+		"TupleOfReferences": {
+			expr:     `[aws_instance.this.id]`,
+			expected: "any",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			expr, diags := hclsyntax.ParseExpression([]byte(tt.expr), "main.tf", hcl.InitialPos)
+			assert.False(diags.HasErrors())
+
+			actual := inferOutputType(expr)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// PlannedResource represents the number of resources of a specific type which
+// are going to be created by 'terraform plan' of example inputs.
+type PlannedResource struct {
+	Type  string `json:"type" toml:"type" xml:"type" yaml:"type"`
+	Count int    `json:"count" toml:"count" xml:"count" yaml:"count"`
+}
+
+// parsePlan reads the output of 'terraform show -json' and returns the number
+// of resources to be created, grouped by their type and sorted by name.
+func parsePlan(content []byte) ([]*PlannedResource, error) {
+	type plan struct {
+		ResourceChanges []struct {
+			Type   string `json:"type"`
+			Change struct {
+				Actions []string `json:"actions"`
+			} `json:"change"`
+		} `json:"resource_changes"`
+	}
+	var p plan
+
+	if err := json.Unmarshal(content, &p); err != nil {
+		return nil, fmt.Errorf("unable to decode example plan, %w", err)
+	}
+
+	counts := make(map[string]int)
+	for _, rc := range p.ResourceChanges {
+		for _, action := range rc.Change.Actions {
+			if action == "create" {
+				counts[rc.Type]++
+				break
+			}
+		}
+	}
+
+	names := make([]string, 0, len(counts))
+	for n := range counts {
+		names = append(names, n)
+	}
+
+	sort.Strings(names)
+
+	resources := make([]*PlannedResource, 0, len(names))
+	for _, name := range names {
+		resources = append(resources, &PlannedResource{
+			Type:  name,
+			Count: counts[name],
+		})
+	}
+	return resources, nil
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePlan(t *testing.T) {
+	tests := map[string]struct {
+		content  func() []byte
+		expected []*PlannedResource
+		wantErr  bool
+	}{
+		"FromFile": {
+			content: func() []byte {
+				content, _ := ioutil.ReadFile(filepath.Join("testdata", "example-plan", "plan.json"))
+				return content
+			},
+			expected: []*PlannedResource{
+				{Type: "aws_instance", Count: 2},
+				{Type: "aws_security_group", Count: 1},
+			},
+			wantErr: false,
+		},
+		"NoChanges": {
+			content: func() []byte {
+				return []byte(`{"format_version": "0.2"}`)
+			},
+			expected: []*PlannedResource{},
+			wantErr:  false,
+		},
+		"Invalid": {
+			content: func() []byte {
+				return []byte(`not a json`)
+			},
+			expected: nil,
+			wantErr:  true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual, err := parsePlan(tt.content())
+
+			if tt.wantErr {
+				assert.NotNil(err)
+			} else {
+				assert.Nil(err)
+			}
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
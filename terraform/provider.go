@@ -13,6 +13,7 @@ package terraform
 import (
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/terraform-docs/terraform-docs/internal/types"
 )
@@ -22,9 +23,17 @@ type Provider struct {
 	Name     string       `json:"name" toml:"name" xml:"name" yaml:"name"`
 	Alias    types.String `json:"alias" toml:"alias" xml:"alias" yaml:"alias"`
 	Version  types.String `json:"version" toml:"version" xml:"version" yaml:"version"`
+	Source   string       `json:"source,omitempty" toml:"source,omitempty" xml:"source,omitempty" yaml:"source,omitempty"`
 	Position Position     `json:"-" toml:"-" xml:"-" yaml:"-"`
 }
 
+// officialNamespace is the namespace of the providers maintained by HashiCorp.
+const officialNamespace = "hashicorp"
+
+// defaultRegistryHost is the hostname of the public Terraform Registry, which
+// is implied if the source of the provider doesn't have any.
+const defaultRegistryHost = "registry.terraform.io"
+
 // FullName returns full name of the provider, with alias if available
 func (p *Provider) FullName() string {
 	if p.Alias != "" {
@@ -33,8 +42,28 @@ func (p *Provider) FullName() string {
 	return p.Name
 }
 
+// Namespace returns the namespace of the source of the provider (e.g. 'hashicorp'
+// of 'hashicorp/aws'), or empty if the source is unknown.
+func (p *Provider) Namespace() string {
+	segments := strings.Split(p.Source, "/")
+	if len(segments) < 2 {
+		return ""
+	}
+	return strings.ToLower(segments[len(segments)-2])
+}
+
+// IsOfficial indicates if the provider is maintained by HashiCorp, i.e. its
+// source is in 'hashicorp' namespace of the public Terraform Registry.
+func (p *Provider) IsOfficial() bool {
+	segments := strings.Split(p.Source, "/")
+	if len(segments) > 3 || p.Namespace() != officialNamespace {
+		return false
+	}
+	return len(segments) == 2 || strings.EqualFold(segments[0], defaultRegistryHost)
+}
+
 func sortProvidersByName(x []*Provider) {
-	sort.Slice(x, func(i, j int) bool {
+	sort.SliceStable(x, func(i, j int) bool {
 		if x[i].Name == x[j].Name {
 			return x[i].Name == x[j].Name && x[i].Alias < x[j].Alias
 		}
@@ -50,6 +50,55 @@ func TestProviderName(t *testing.T) {
 	}
 }
 
+func TestProviderNamespace(t *testing.T) {
+	tests := map[string]struct {
+		source    string
+		namespace string
+		official  bool
+	}{
+		"Unknown": {
+			source:    "",
+			namespace: "",
+			official:  false,
+		},
+		"Official": {
+			source:    "hashicorp/aws",
+			namespace: "hashicorp",
+			official:  true,
+		},
+		"OfficialWithHost": {
+			source:    "registry.terraform.io/hashicorp/aws",
+			namespace: "hashicorp",
+			official:  true,
+		},
+		"OfficialCaseInsensitive": {
+			source:    "Registry.Terraform.io/HashiCorp/aws",
+			namespace: "hashicorp",
+			official:  true,
+		},
+		"Community": {
+			source:    "integrations/github",
+			namespace: "integrations",
+			official:  false,
+		},
+		"PrivateRegistry": {
+			source:    "registry.acme.com/hashicorp/aws",
+			namespace: "hashicorp",
+			official:  false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			provider := Provider{Name: "provider", Source: tt.source}
+
+			assert.Equal(tt.namespace, provider.Namespace())
+			assert.Equal(tt.official, provider.IsOfficial())
+		})
+	}
+}
+
 func TestProvidersSort(t *testing.T) {
 	providers := sampleProviders()
 	tests := map[string]struct {
@@ -0,0 +1,37 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"regexp"
+	"strings"
+)
+
+// sentenceEnd matches the period which ends a sentence, i.e. the one followed
+// by whitespace or end of the line, to not end it on e.g. 'main.tf'.
+var sentenceEnd = regexp.MustCompile(`\.(\s|$)`)
+
+// parsePurpose returns the first sentence of the 'header', up to the first
+// period or line break, to be used as one line summary of the module. Leading
+// blank lines and Markdown headings (e.g. title of the module) are skipped.
+func parsePurpose(header string) string {
+	for _, line := range strings.Split(header, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if loc := sentenceEnd.FindStringIndex(line); loc != nil {
+			return line[:loc[0]+1]
+		}
+		return line
+	}
+	return ""
+}
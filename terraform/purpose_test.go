@@ -0,0 +1,57 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePurpose(t *testing.T) {
+	tests := map[string]struct {
+		header   string
+		expected string
+	}{
+		"Empty": {
+			header:   "",
+			expected: "",
+		},
+		"Sentences": {
+			header:   "Creates a VPC. It also creates subnets.",
+			expected: "Creates a VPC.",
+		},
+		"LineBreak": {
+			header:   "Creates a VPC\nwith subnets.",
+			expected: "Creates a VPC",
+		},
+		"FileName": {
+			header:   "Example of module in `main.tf` file. More details.",
+			expected: "Example of module in `main.tf` file.",
+		},
+		"Heading": {
+			header:   "# terraform-aws-vpc\n\n  Creates a VPC.\r\n",
+			expected: "Creates a VPC.",
+		},
+		"OnlyHeading": {
+			header:   "# terraform-aws-vpc\n",
+			expected: "",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := parsePurpose(tt.header)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+// redactedValue replaces the default value of the sensitive inputs.
+const redactedValue = "[REDACTED]"
+
+// defaultSensitivePatterns are used to detect the sensitive inputs if no
+// patterns are provided by '--sensitive-patterns', i.e. the names commonly
+// used for credentials and 32 characters long hex strings (e.g. API keys).
+var defaultSensitivePatterns = []string{
+	`(?i)(password|passwd|secret|token|api_?key|private_?key)`,
+	`\b[0-9a-fA-F]{32}\b`,
+}
+
+// compileSensitivePatterns returns the compiled 'patterns', or the default
+// ones if 'patterns' is empty.
+func compileSensitivePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	if len(patterns) == 0 {
+		patterns = defaultSensitivePatterns
+	}
+	result := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid sensitive pattern, %w", pattern, err)
+		}
+		result = append(result, re)
+	}
+	return result, nil
+}
+
+// isSensitiveInput indicates if either the name, the default value or the value
+// merged from var files (i.e. 'EnvDefault') of the 'input' matches any of the
+// 'patterns'. The values are matched as they're rendered, except strings which
+// are matched without quotes.
+func isSensitiveInput(input *Input, patterns []*regexp.Regexp) bool {
+	values := []string{input.GetValue()}
+	if s, ok := input.Default.(types.String); ok {
+		values[0] = string(s)
+	}
+	if input.EnvDefault != nil {
+		if s, ok := input.EnvDefault.(types.String); ok {
+			values = append(values, string(s))
+		} else {
+			values = append(values, input.GetEnvValue())
+		}
+	}
+	for _, re := range patterns {
+		if re.MatchString(input.Name) {
+			return true
+		}
+		for _, value := range values {
+			if re.MatchString(value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// redactDefaults replaces the default value and the value merged from var files
+// of the sensitive 'inputs' with '[REDACTED]', the inputs without any of them
+// are left as they are.
+func redactDefaults(inputs []*Input, patterns []*regexp.Regexp) {
+	for _, input := range inputs {
+		hasDefault := input.Default != nil && input.Default.HasDefault()
+		if !hasDefault && input.EnvDefault == nil {
+			continue
+		}
+		if !isSensitiveInput(input, patterns) {
+			continue
+		}
+		if hasDefault {
+			input.Default = types.String(redactedValue)
+		}
+		if input.EnvDefault != nil {
+			input.EnvDefault = types.String(redactedValue)
+		}
+	}
+}
+
+// redactSensitiveEnvDefaults replaces the value merged from var files of the
+// 'inputs' declared with 'sensitive = true' with '[REDACTED]', regardless of
+// the sensitive patterns.
+func redactSensitiveEnvDefaults(inputs []*Input, sensitive map[string]bool) {
+	for _, input := range inputs {
+		if input.EnvDefault != nil && sensitive[input.Name] {
+			input.EnvDefault = types.String(redactedValue)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"strings"
+
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+// Cloud providers which their region names are detected.
+const (
+	CloudAWS   = "aws"
+	CloudGCP   = "gcp"
+	CloudAzure = "azure"
+)
+
+// regionNames are the names of the variables commonly used for region of each
+// cloud provider. 'region' itself is ambiguous and is handled separately.
+var regionNames = map[string]string{
+	"aws_region":     CloudAWS,
+	"gcp_region":     CloudGCP,
+	"google_region":  CloudGCP,
+	"azure_region":   CloudAzure,
+	"azure_location": CloudAzure,
+	"azurerm_region": CloudAzure,
+}
+
+// cloudProviders are the names of the Terraform providers of each cloud, which
+// are used to detect the cloud of 'region' variable without known default.
+var cloudProviders = map[string]string{
+	"aws":     CloudAWS,
+	"google":  CloudGCP,
+	"azurerm": CloudAzure,
+}
+
+// knownRegions are the region names of each cloud provider. Azure regions are
+// matched without spaces and case insensitive, e.g. 'East US' is 'eastus'.
+var knownRegions = map[string][]string{
+	CloudAWS: {
+		"af-south-1", "ap-east-1", "ap-northeast-1", "ap-northeast-2", "ap-northeast-3",
+		"ap-south-1", "ap-south-2", "ap-southeast-1", "ap-southeast-2", "ap-southeast-3",
+		"ap-southeast-4", "ca-central-1", "ca-west-1", "eu-central-1", "eu-central-2",
+		"eu-north-1", "eu-south-1", "eu-south-2", "eu-west-1", "eu-west-2", "eu-west-3",
+		"il-central-1", "me-central-1", "me-south-1", "sa-east-1", "us-east-1", "us-east-2",
+		"us-gov-east-1", "us-gov-west-1", "us-west-1", "us-west-2",
+	},
+	CloudGCP: {
+		"africa-south1", "asia-east1", "asia-east2", "asia-northeast1", "asia-northeast2",
+		"asia-northeast3", "asia-south1", "asia-south2", "asia-southeast1", "asia-southeast2",
+		"australia-southeast1", "australia-southeast2", "europe-central2", "europe-north1",
+		"europe-southwest1", "europe-west1", "europe-west10", "europe-west12", "europe-west2",
+		"europe-west3", "europe-west4", "europe-west6", "europe-west8", "europe-west9",
+		"me-central1", "me-central2", "me-west1", "northamerica-northeast1",
+		"northamerica-northeast2", "southamerica-east1", "southamerica-west1", "us-central1",
+		"us-east1", "us-east4", "us-east5", "us-south1", "us-west1", "us-west2", "us-west3",
+		"us-west4",
+	},
+	CloudAzure: {
+		"australiacentral", "australiaeast", "australiasoutheast", "brazilsouth",
+		"canadacentral", "canadaeast", "centralindia", "centralus", "eastasia", "eastus",
+		"eastus2", "francecentral", "germanywestcentral", "italynorth", "japaneast",
+		"japanwest", "koreacentral", "northcentralus", "northeurope", "norwayeast",
+		"polandcentral", "qatarcentral", "southafricanorth", "southcentralus", "southeastasia",
+		"southindia", "swedencentral", "switzerlandnorth", "uaenorth", "uksouth", "ukwest",
+		"westcentralus", "westeurope", "westindia", "westus", "westus2", "westus3",
+	},
+}
+
+// detectRegionCloud returns the cloud provider of the region of 'input', or an
+// empty string if it's not a region. The default value is matched first against
+// the known regions, then the name of the input. 'region' input is detected by
+// the only cloud provider used in the module, if any.
+func detectRegionCloud(input *Input, providers []*Provider) string {
+	if s, ok := input.Default.(types.String); ok {
+		if cloud := regionCloud(string(s)); cloud != "" {
+			return cloud
+		}
+	}
+	if cloud, ok := regionNames[input.Name]; ok {
+		return cloud
+	}
+	if input.Name != "region" {
+		return ""
+	}
+	var cloud string
+	for _, p := range providers {
+		c, ok := cloudProviders[p.Name]
+		if !ok {
+			continue
+		}
+		if cloud != "" && cloud != c {
+			return "" // multiple clouds, can't tell which one
+		}
+		cloud = c
+	}
+	return cloud
+}
+
+// regionCloud returns the cloud provider of the 'region' name, or an empty
+// string if it's not a known region.
+func regionCloud(region string) string {
+	region = strings.ToLower(strings.ReplaceAll(region, " ", ""))
+	for _, cloud := range []string{CloudAWS, CloudGCP, CloudAzure} {
+		for _, r := range knownRegions[cloud] {
+			if r == region {
+				return cloud
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+func TestDetectRegionCloud(t *testing.T) {
+	tests := map[string]struct {
+		input     *Input
+		providers []string
+		expected  string
+	}{
+		"AWSDefault": {
+			input:    &Input{Name: "location", Default: types.String("eu-west-1")},
+			expected: CloudAWS,
+		},
+		"GCPDefault": {
+			input:    &Input{Name: "location", Default: types.String("us-central1")},
+			expected: CloudGCP,
+		},
+		"AzureDefault": {
+			input:    &Input{Name: "location", Default: types.String("West Europe")},
+			expected: CloudAzure,
+		},
+		"DefaultOverName": {
+			input:    &Input{Name: "aws_region", Default: types.String("eastus")},
+			expected: CloudAzure,
+		},
+		"UnknownDefault": {
+			input:    &Input{Name: "name", Default: types.String("us-east-9")},
+			expected: "",
+		},
+		"NonStringDefault": {
+			input:    &Input{Name: "count", Default: types.Number(1)},
+			expected: "",
+		},
+		"AWSName": {
+			input:    &Input{Name: "aws_region", Default: types.Nil{}},
+			expected: CloudAWS,
+		},
+		"GCPName": {
+			input:    &Input{Name: "gcp_region", Default: types.Nil{}},
+			expected: CloudGCP,
+		},
+		"RegionWithProvider": {
+			input:     &Input{Name: "region", Default: types.Nil{}},
+			providers: []string{"null", "google"},
+			expected:  CloudGCP,
+		},
+		"RegionWithoutProvider": {
+			input:     &Input{Name: "region", Default: types.Nil{}},
+			providers: []string{"null"},
+			expected:  "",
+		},
+		"RegionWithMultipleProviders": {
+			input:     &Input{Name: "region", Default: types.Nil{}},
+			providers: []string{"aws", "azurerm"},
+			expected:  "",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			providers := make([]*Provider, 0, len(tt.providers))
+			for _, p := range tt.providers {
+				providers = append(providers, &Provider{Name: p})
+			}
+
+			actual := detectRegionCloud(tt.input, providers)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
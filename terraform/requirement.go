@@ -11,7 +11,10 @@ the root directory of this source tree.
 package terraform
 
 import (
+	"sort"
+
 	"github.com/terraform-docs/terraform-docs/internal/types"
+	"github.com/terraform-docs/terraform-docs/print"
 )
 
 // Requirement represents a requirement for Terraform module.
@@ -19,3 +22,20 @@ type Requirement struct {
 	Name    string       `json:"name" toml:"name" xml:"name" yaml:"name"`
 	Version types.String `json:"version" toml:"version" xml:"version" yaml:"version"`
 }
+
+func sortRequirementsByName(x []*Requirement) {
+	sort.SliceStable(x, func(i, j int) bool {
+		return x[i].Name < x[j].Name
+	})
+}
+
+type requirements []*Requirement
+
+// sort sorts the requirements by name if 'by' is 'name', otherwise they're kept
+// as they're loaded, i.e. terraform first followed by the providers. Multiple
+// constraints of the same requirement keep their order.
+func (rr requirements) sort(by string) {
+	if by == print.SortName {
+		sortRequirementsByName(rr)
+	}
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/types"
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+func TestRequirementsSort(t *testing.T) {
+	tests := map[string]struct {
+		by       string
+		expected []string
+	}{
+		"ByName": {
+			by:       print.SortName,
+			expected: []string{"aws >= 4.0", "aws < 6.0", "null", "random", "terraform >= 1.0"},
+		},
+		"AsLoaded": {
+			by:       "",
+			expected: []string{"terraform >= 1.0", "random", "aws >= 4.0", "null", "aws < 6.0"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			rr := requirements{
+				{Name: "terraform", Version: types.String(">= 1.0")},
+				{Name: "random", Version: types.String("")},
+				{Name: "aws", Version: types.String(">= 4.0")},
+				{Name: "null", Version: types.String("")},
+				{Name: "aws", Version: types.String("< 6.0")},
+			}
+			rr.sort(tt.by)
+
+			actual := make([]string, len(rr))
+			for i, r := range rr {
+				actual[i] = r.Name
+				if r.Version != "" {
+					actual[i] += " " + string(r.Version)
+				}
+			}
+
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
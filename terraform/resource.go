@@ -28,6 +28,8 @@ type Resource struct {
 	Version        types.String `json:"version" toml:"version" xml:"version" yaml:"version"`
 	Description    types.String `json:"description" toml:"description" xml:"description" yaml:"description"`
 	Position       Position     `json:"-" toml:"-" xml:"-" yaml:"-"`
+
+	TagCompliance *TagCompliance `json:"tag_compliance,omitempty" toml:"tag_compliance,omitempty" xml:"tag_compliance,omitempty" yaml:"tag_compliance,omitempty"`
 }
 
 // Spec returns the resource spec addresses a specific resource in the config.
@@ -62,10 +64,17 @@ func (r *Resource) URL() string {
 		return ""
 	}
 
-	if strings.Count(r.ProviderSource, "/") > 1 {
+	return providerDocsURL(r.ProviderSource, string(r.Version), kind, r.Type)
+}
+
+// providerDocsURL returns the URL of documentation of the given 'kind' of item
+// (e.g. 'resources') of the provider in the Terraform Registry, or empty string
+// if the provider is not from the registry.
+func providerDocsURL(source string, version string, kind string, name string) string {
+	if strings.Count(source, "/") > 1 {
 		return ""
 	}
-	return fmt.Sprintf("https://registry.terraform.io/providers/%s/%s/docs/%s/%s", r.ProviderSource, r.Version, kind, r.Type)
+	return fmt.Sprintf("https://registry.terraform.io/providers/%s/%s/docs/%s/%s", source, version, kind, name)
 }
 
 func sortResourcesByType(x []*Resource) {
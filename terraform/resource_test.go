@@ -91,6 +91,16 @@ func TestResourceURL(t *testing.T) {
 			},
 			expectValue: "https://registry.terraform.io/providers/hashicorp/tls/latest/docs/resources/private_key",
 		},
+		"Data source URL construction": {
+			resource: Resource{
+				Type:           "caller_identity",
+				ProviderName:   "aws",
+				ProviderSource: "hashicorp/aws",
+				Mode:           "data",
+				Version:        types.String("latest"),
+			},
+			expectValue: "https://registry.terraform.io/providers/hashicorp/aws/latest/docs/data-sources/caller_identity",
+		},
 		"Unable to construct URL": {
 			resource: Resource{
 				Type:           "custom",
@@ -0,0 +1,119 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Severities of the security findings reported by 'tfsec'.
+const (
+	SeverityCritical = "CRITICAL"
+	SeverityHigh     = "HIGH"
+	SeverityMedium   = "MEDIUM"
+	SeverityLow      = "LOW"
+)
+
+// severityOrder is the order of the severities, from the most severe one.
+var severityOrder = map[string]int{
+	SeverityCritical: 0,
+	SeverityHigh:     1,
+	SeverityMedium:   2,
+	SeverityLow:      3,
+}
+
+// SecurityFinding represents a security issue of the module found by 'tfsec'.
+type SecurityFinding struct {
+	RuleID      string `json:"rule_id" toml:"rule_id" xml:"rule_id" yaml:"rule_id"`
+	Severity    string `json:"severity" toml:"severity" xml:"severity" yaml:"severity"`
+	Description string `json:"description" toml:"description" xml:"description" yaml:"description"`
+	File        string `json:"file" toml:"file" xml:"file" yaml:"file"`
+	Line        int    `json:"line" toml:"line" xml:"line" yaml:"line"`
+}
+
+// Location returns the file and line of the finding, e.g. "main.tf:12".
+func (f *SecurityFinding) Location() string {
+	if f.Line == 0 {
+		return f.File
+	}
+	return fmt.Sprintf("%s:%d", f.File, f.Line)
+}
+
+// parseSecurityFindings reads the output of 'tfsec --format json' and returns
+// the findings sorted by severity (the most severe first), file and line. Files
+// are relative to 'root', which is the absolute path of the module.
+func parseSecurityFindings(content []byte, root string) ([]*SecurityFinding, error) {
+	type result struct {
+		RuleID          string `json:"rule_id"`
+		LongID          string `json:"long_id"`
+		RuleDescription string `json:"rule_description"`
+		Description     string `json:"description"`
+		Severity        string `json:"severity"`
+		Location        struct {
+			Filename  string `json:"filename"`
+			StartLine int    `json:"start_line"`
+		} `json:"location"`
+	}
+	var report struct {
+		Results []result `json:"results"`
+	}
+
+	if err := json.Unmarshal(content, &report); err != nil {
+		return nil, fmt.Errorf("unable to decode security findings, %w", err)
+	}
+
+	items := make([]*SecurityFinding, 0, len(report.Results))
+	for _, r := range report.Results {
+		id := r.RuleID
+		if id == "" {
+			id = r.LongID
+		}
+		description := r.Description
+		if description == "" {
+			description = r.RuleDescription
+		}
+		file := r.Location.Filename
+		if rel, err := filepath.Rel(root, file); err == nil && !strings.HasPrefix(rel, "..") {
+			file = rel
+		}
+		items = append(items, &SecurityFinding{
+			RuleID:      id,
+			Severity:    strings.ToUpper(r.Severity),
+			Description: description,
+			File:        filepath.ToSlash(file),
+			Line:        r.Location.StartLine,
+		})
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Severity != items[j].Severity {
+			return severityRank(items[i].Severity) < severityRank(items[j].Severity)
+		}
+		if items[i].File != items[j].File {
+			return items[i].File < items[j].File
+		}
+		return items[i].Line < items[j].Line
+	})
+
+	return items, nil
+}
+
+// severityRank returns the order of 'severity', unknown severities are the least
+// severe ones.
+func severityRank(severity string) int {
+	if rank, ok := severityOrder[severity]; ok {
+		return rank
+	}
+	return len(severityOrder)
+}
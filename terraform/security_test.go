@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSecurityFindings(t *testing.T) {
+	tests := map[string]struct {
+		content  func() []byte
+		expected []*SecurityFinding
+		wantErr  bool
+	}{
+		"FromFile": {
+			content: func() []byte {
+				content, _ := ioutil.ReadFile(filepath.Join("testdata", "security-findings", "tfsec.json"))
+				return content
+			},
+			expected: []*SecurityFinding{
+				{
+					RuleID:      "AVD-AWS-0107",
+					Severity:    SeverityCritical,
+					Description: "Security group rule allows ingress from public internet.",
+					File:        "network/security.tf",
+					Line:        5,
+				},
+				{
+					RuleID:      "AVD-AWS-0086",
+					Severity:    SeverityHigh,
+					Description: "No public access block so not blocking public acls",
+					File:        "main.tf",
+					Line:        1,
+				},
+				{
+					RuleID:      "AVD-AWS-0088",
+					Severity:    SeverityHigh,
+					Description: "Bucket does not have encryption enabled",
+					File:        "main.tf",
+					Line:        12,
+				},
+				{
+					RuleID:      "AVD-AWS-0089",
+					Severity:    SeverityMedium,
+					Description: "S3 Bucket does not have logging enabled.",
+					File:        "main.tf",
+					Line:        1,
+				},
+			},
+			wantErr: false,
+		},
+		"NoFindings": {
+			content: func() []byte {
+				return []byte(`{"results": null}`)
+			},
+			expected: []*SecurityFinding{},
+			wantErr:  false,
+		},
+		"Invalid": {
+			content: func() []byte {
+				return []byte(`not json`)
+			},
+			expected: nil,
+			wantErr:  true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual, err := parseSecurityFindings(tt.content(), "/work/module")
+			if tt.wantErr {
+				assert.NotNil(err)
+			} else {
+				assert.Nil(err)
+				assert.Equal(tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSecurityFindingLocation(t *testing.T) {
+	tests := map[string]struct {
+		finding  *SecurityFinding
+		expected string
+	}{
+		"WithLine": {
+			finding:  &SecurityFinding{File: "main.tf", Line: 12},
+			expected: "main.tf:12",
+		},
+		"WithoutLine": {
+			finding:  &SecurityFinding{File: "main.tf"},
+			expected: "main.tf",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, tt.finding.Location())
+		})
+	}
+}
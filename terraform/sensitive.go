@@ -0,0 +1,33 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"fmt"
+)
+
+// SensitiveSummary represents the counts of inputs and outputs of Terraform
+// module which are declared with 'sensitive = true'.
+type SensitiveSummary struct {
+	Inputs  int `json:"inputs" toml:"inputs" xml:"inputs" yaml:"inputs"`
+	Outputs int `json:"outputs" toml:"outputs" xml:"outputs" yaml:"outputs"`
+}
+
+// Callout returns the counts of sensitive inputs and outputs as one line
+// callout, e.g. "⚠️ This module handles sensitive data: 3 sensitive inputs
+// and 2 sensitive outputs. Handle with care."
+func (s *SensitiveSummary) Callout() string {
+	return fmt.Sprintf(
+		"⚠️ This module handles sensitive data: %s and %s. Handle with care.",
+		plural(s.Inputs, "sensitive input"),
+		plural(s.Outputs, "sensitive output"),
+	)
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSensitiveSummaryCallout(t *testing.T) {
+	tests := map[string]struct {
+		summary  SensitiveSummary
+		expected string
+	}{
+		"Singular": {
+			summary:  SensitiveSummary{Inputs: 1, Outputs: 1},
+			expected: "⚠️ This module handles sensitive data: 1 sensitive input and 1 sensitive output. Handle with care.",
+		},
+		"Plural": {
+			summary:  SensitiveSummary{Inputs: 3, Outputs: 2},
+			expected: "⚠️ This module handles sensitive data: 3 sensitive inputs and 2 sensitive outputs. Handle with care.",
+		},
+		"NoOutputs": {
+			summary:  SensitiveSummary{Inputs: 2, Outputs: 0},
+			expected: "⚠️ This module handles sensitive data: 2 sensitive inputs and 0 sensitive outputs. Handle with care.",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, tt.summary.Callout())
+		})
+	}
+}
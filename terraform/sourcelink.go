@@ -0,0 +1,121 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+// sourceLink contains the parts of the source address of a module call which
+// are available to the templates of 'source-link-templates', e.g. for source
+// 'github.com/gruntwork-io/terraform-aws-vpc//modules/vpc-app?ref=v0.26.0':
+//
+//	Repo:   terraform-aws-vpc
+//	Module: vpc-app
+//	Path:   modules/vpc-app
+//	Ref:    v0.26.0
+type sourceLink struct {
+	Repo   string
+	Module string
+	Path   string
+	Ref    string
+}
+
+// normalizeSource returns the Git source address without the forced getter,
+// scheme and user (e.g. 'git::git@github.com:org/repo.git' is returned as
+// 'github.com/org/repo.git'), so that it can be matched against the sources of
+// 'source-link-templates'.
+func normalizeSource(source string) string {
+	source = strings.TrimPrefix(source, "git::")
+	for _, scheme := range []string{"https://", "http://", "ssh://"} {
+		source = strings.TrimPrefix(source, scheme)
+	}
+	if strings.HasPrefix(source, "git@") {
+		source = strings.TrimPrefix(source, "git@")
+		// scp-like address, i.e. 'github.com:org/repo.git'
+		if colon := strings.Index(source, ":"); colon != -1 && !strings.Contains(source[:colon], "/") {
+			source = source[:colon] + "/" + source[colon+1:]
+		}
+	}
+	return source
+}
+
+// newSourceLink returns the parts of 'source' after the 'prefix' it's matched
+// with, or nil if it doesn't match. The name of the module is the last element
+// of the subdirectory, or the name of the repository if there's none.
+func newSourceLink(prefix string, source string, version string) *sourceLink {
+	source = normalizeSource(source)
+	if !strings.HasPrefix(source, prefix) {
+		return nil
+	}
+	rest := strings.TrimPrefix(source, prefix)
+	if query := strings.Index(rest, "?"); query != -1 {
+		rest = rest[:query]
+	}
+
+	repo, subdir := rest, ""
+	if slashes := strings.Index(rest, "//"); slashes != -1 {
+		repo, subdir = rest[:slashes], strings.Trim(rest[slashes+2:], "/")
+	}
+	repo = strings.TrimSuffix(strings.Trim(repo, "/"), ".git")
+	if repo == "" {
+		return nil
+	}
+
+	module := repo
+	if subdir != "" {
+		module = path.Base(subdir)
+	}
+
+	return &sourceLink{
+		Repo:   repo,
+		Module: module,
+		Path:   subdir,
+		Ref:    version,
+	}
+}
+
+// sourceLinkURL returns the URL of documentation of 'modulecall' rendered with
+// the first template of 'source-link-templates' (by their name) which its source
+// matches the source of the module call, or an empty string if none of them
+// matches.
+func sourceLinkURL(config *print.Config, modulecall *ModuleCall) (string, error) {
+	links := config.SourceLinks
+	names := make([]string, 0, len(links))
+	for name := range links {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		link := links[name]
+		data := newSourceLink(link.Source, modulecall.Source, modulecall.Version)
+		if data == nil {
+			continue
+		}
+		tmpl, err := template.New(name).Parse(link.URL)
+		if err != nil {
+			return "", fmt.Errorf("unable to parse source link template '%s', %w", name, err)
+		}
+		var url strings.Builder
+		if err := tmpl.Execute(&url, data); err != nil {
+			return "", fmt.Errorf("unable to render source link template '%s', %w", name, err)
+		}
+		return url.String(), nil
+	}
+	return "", nil
+}
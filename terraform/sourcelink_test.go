@@ -0,0 +1,147 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+func TestNormalizeSource(t *testing.T) {
+	tests := map[string]struct {
+		source   string
+		expected string
+	}{
+		"GitHub": {
+			source:   "github.com/gruntwork-io/terraform-aws-vpc//modules/vpc-app",
+			expected: "github.com/gruntwork-io/terraform-aws-vpc//modules/vpc-app",
+		},
+		"HTTPS": {
+			source:   "git::https://github.com/gruntwork-io/terraform-aws-vpc.git//modules/vpc-app",
+			expected: "github.com/gruntwork-io/terraform-aws-vpc.git//modules/vpc-app",
+		},
+		"SSH": {
+			source:   "git::ssh://git@github.com/gruntwork-io/terraform-aws-vpc.git//modules/vpc-app",
+			expected: "github.com/gruntwork-io/terraform-aws-vpc.git//modules/vpc-app",
+		},
+		"SCP": {
+			source:   "git::git@github.com:gruntwork-io/terraform-aws-vpc.git//modules/vpc-app",
+			expected: "github.com/gruntwork-io/terraform-aws-vpc.git//modules/vpc-app",
+		},
+		"Registry": {
+			source:   "terraform-aws-modules/vpc/aws",
+			expected: "terraform-aws-modules/vpc/aws",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, normalizeSource(tt.source))
+		})
+	}
+}
+
+func TestNewSourceLink(t *testing.T) {
+	tests := map[string]struct {
+		source   string
+		version  string
+		expected *sourceLink
+	}{
+		"Subdirectory": {
+			source:   "git::git@github.com:gruntwork-io/terraform-aws-vpc.git//modules/vpc-app",
+			version:  "v0.26.0",
+			expected: &sourceLink{Repo: "terraform-aws-vpc", Module: "vpc-app", Path: "modules/vpc-app", Ref: "v0.26.0"},
+		},
+		"WithoutSubdirectory": {
+			source:   "github.com/gruntwork-io/terraform-aws-utilities",
+			version:  "",
+			expected: &sourceLink{Repo: "terraform-aws-utilities", Module: "terraform-aws-utilities", Path: "", Ref: ""},
+		},
+		"WithQuery": {
+			source:   "github.com/gruntwork-io/terraform-aws-eks//modules/eks-cluster-control-plane?depth=1",
+			version:  "v0.65.0",
+			expected: &sourceLink{Repo: "terraform-aws-eks", Module: "eks-cluster-control-plane", Path: "modules/eks-cluster-control-plane", Ref: "v0.65.0"},
+		},
+		"OtherOrganization": {
+			source:   "github.com/acme/terraform-aws-vpc//modules/vpc-app",
+			version:  "",
+			expected: nil,
+		},
+		"OrganizationOnly": {
+			source:   "github.com/gruntwork-io/",
+			version:  "",
+			expected: nil,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, newSourceLink("github.com/gruntwork-io/", tt.source, tt.version))
+		})
+	}
+}
+
+func TestSourceLinkURL(t *testing.T) {
+	tests := map[string]struct {
+		modulecall *ModuleCall
+		expected   string
+	}{
+		"Gruntwork": {
+			modulecall: &ModuleCall{Name: "vpc", Source: "git::git@github.com:gruntwork-io/terraform-aws-vpc.git//modules/vpc-app", Version: "v0.26.0"},
+			expected:   "https://docs.gruntwork.io/reference/modules/terraform-aws-vpc/vpc-app/",
+		},
+		"Registry": {
+			modulecall: &ModuleCall{Name: "vpc", Source: "terraform-aws-modules/vpc/aws", Version: "5.0.0"},
+			expected:   "",
+		},
+		"Local": {
+			modulecall: &ModuleCall{Name: "local", Source: "./modules/local"},
+			expected:   "",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.DefaultConfig()
+
+			actual, err := sourceLinkURL(config, tt.modulecall)
+			assert.Nil(err)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestSourceLinkURLWithoutTemplates(t *testing.T) {
+	assert := assert.New(t)
+
+	config := print.DefaultConfig()
+	config.SourceLinks = nil
+
+	actual, err := sourceLinkURL(config, &ModuleCall{Name: "vpc", Source: "github.com/gruntwork-io/terraform-aws-vpc"})
+	assert.Nil(err)
+	assert.Equal("", actual)
+}
+
+func TestSourceLinkURLTemplateError(t *testing.T) {
+	assert := assert.New(t)
+
+	config := print.DefaultConfig()
+	link := config.SourceLinks["gruntwork"]
+	link.URL = "https://docs.gruntwork.io/{{ .Missing }}"
+	config.SourceLinks["gruntwork"] = link
+
+	_, err := sourceLinkURL(config, &ModuleCall{Name: "vpc", Source: "github.com/gruntwork-io/terraform-aws-vpc"})
+	assert.NotNil(err)
+}
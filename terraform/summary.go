@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"fmt"
+)
+
+// Summary represents the counts of items of Terraform module, to give a quick
+// sense of its complexity.
+type Summary struct {
+	Inputs               int `json:"inputs" toml:"inputs" xml:"inputs" yaml:"inputs"`
+	RequiredInputs       int `json:"required_inputs" toml:"required_inputs" xml:"required_inputs" yaml:"required_inputs"`
+	Outputs              int `json:"outputs" toml:"outputs" xml:"outputs" yaml:"outputs"`
+	Resources            int `json:"resources" toml:"resources" xml:"resources" yaml:"resources"`
+	ProviderRequirements int `json:"provider_requirements" toml:"provider_requirements" xml:"provider_requirements" yaml:"provider_requirements"`
+}
+
+// NewSummary returns Summary of the given module. Requirement of Terraform
+// itself is not counted as provider requirement.
+func NewSummary(module *Module) *Summary {
+	summary := &Summary{
+		Inputs:    len(module.Inputs),
+		Outputs:   len(module.Outputs),
+		Resources: len(module.Resources),
+	}
+	for _, input := range module.Inputs {
+		if input.Required {
+			summary.RequiredInputs++
+		}
+	}
+	for _, requirement := range module.Requirements {
+		if requirement.Name != "terraform" {
+			summary.ProviderRequirements++
+		}
+	}
+	return summary
+}
+
+// Sentence returns the summary as one line of prose, e.g. "This module has
+// 12 inputs (5 required), 4 outputs, 8 resources, and 2 provider requirements."
+func (s *Summary) Sentence() string {
+	return fmt.Sprintf(
+		"This module has %s (%d required), %s, %s, and %s.",
+		plural(s.Inputs, "input"),
+		s.RequiredInputs,
+		plural(s.Outputs, "output"),
+		plural(s.Resources, "resource"),
+		plural(s.ProviderRequirements, "provider requirement"),
+	)
+}
+
+func plural(count int, noun string) string {
+	if count == 1 {
+		return fmt.Sprintf("%d %s", count, noun)
+	}
+	return fmt.Sprintf("%d %ss", count, noun)
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSummary(t *testing.T) {
+	assert := assert.New(t)
+
+	module := &Module{
+		Inputs: []*Input{
+			{Name: "a", Required: true},
+			{Name: "b", Required: false},
+			{Name: "c", Required: true},
+		},
+		Outputs: []*Output{
+			{Name: "d"},
+		},
+		Requirements: []*Requirement{
+			{Name: "terraform"},
+			{Name: "aws"},
+		},
+		Resources: []*Resource{
+			{Type: "instance", ProviderName: "aws", Mode: "managed"},
+			{Type: "caller_identity", ProviderName: "aws", Mode: "data"},
+		},
+	}
+
+	assert.Equal(&Summary{
+		Inputs:               3,
+		RequiredInputs:       2,
+		Outputs:              1,
+		Resources:            2,
+		ProviderRequirements: 1,
+	}, NewSummary(module))
+}
+
+func TestSummarySentence(t *testing.T) {
+	tests := map[string]struct {
+		summary  Summary
+		expected string
+	}{
+		"Empty": {
+			summary:  Summary{},
+			expected: "This module has 0 inputs (0 required), 0 outputs, 0 resources, and 0 provider requirements.",
+		},
+		"Singular": {
+			summary:  Summary{Inputs: 1, RequiredInputs: 1, Outputs: 1, Resources: 1, ProviderRequirements: 1},
+			expected: "This module has 1 input (1 required), 1 output, 1 resource, and 1 provider requirement.",
+		},
+		"Plural": {
+			summary:  Summary{Inputs: 12, RequiredInputs: 5, Outputs: 4, Resources: 8, ProviderRequirements: 2},
+			expected: "This module has 12 inputs (5 required), 4 outputs, 8 resources, and 2 provider requirements.",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, tt.summary.Sentence())
+		})
+	}
+}
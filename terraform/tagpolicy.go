@@ -0,0 +1,85 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"gopkg.in/yaml.v3"
+)
+
+// Statuses of compliance of tags of resources with the tag policy.
+const (
+	TagsCompliant     = "compliant"
+	TagsNonCompliant  = "non-compliant"
+	TagsCheckManually = "check-manually"
+)
+
+// TagCompliance represents compliance of 'tags' of a resource with the tags
+// required by the tag policy of the module (i.e. 'tag_policy.yml').
+type TagCompliance struct {
+	Status  string   `json:"status" toml:"status" xml:"status" yaml:"status"`
+	Missing []string `json:"missing,omitempty" toml:"missing,omitempty" xml:"missing>tag,omitempty" yaml:"missing,omitempty"`
+}
+
+// tagPolicy represents the content of 'tag_policy.yml', e.g.
+//
+//	required-tags:
+//	  - environment
+//	  - owner
+type tagPolicy struct {
+	RequiredTags []string `yaml:"required-tags"`
+}
+
+// parseTagPolicy reads the tags required by the tag policy from 'content'.
+func parseTagPolicy(content []byte) ([]string, error) {
+	var policy tagPolicy
+	if err := yaml.Unmarshal(content, &policy); err != nil {
+		return nil, fmt.Errorf("unable to decode tag policy, %w", err)
+	}
+	return policy.RequiredTags, nil
+}
+
+// checkTags returns compliance of the 'tags' attribute of a resource with the
+// 'required' tags. Only literal objects (e.g. '{ owner = "team" }') can be
+// checked, the resources without 'tags' or with tags derived from anything else
+// (e.g. 'var.tags' or 'merge(...)') have to be checked manually.
+func checkTags(attr *hcl.Attribute, required []string) *TagCompliance {
+	if attr == nil {
+		return &TagCompliance{Status: TagsCheckManually}
+	}
+	items, diags := hcl.ExprMap(attr.Expr)
+	if diags.HasErrors() {
+		return &TagCompliance{Status: TagsCheckManually}
+	}
+
+	keys := make(map[string]bool)
+	for _, item := range items {
+		var key string
+		if diags := gohcl.DecodeExpression(item.Key, nil, &key); diags.HasErrors() {
+			return &TagCompliance{Status: TagsCheckManually}
+		}
+		keys[key] = true
+	}
+
+	missing := make([]string, 0)
+	for _, tag := range required {
+		if !keys[tag] {
+			missing = append(missing, tag)
+		}
+	}
+	if len(missing) > 0 {
+		return &TagCompliance{Status: TagsNonCompliant, Missing: missing}
+	}
+	return &TagCompliance{Status: TagsCompliant}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTagPolicy(t *testing.T) {
+	tests := map[string]struct {
+		content  string
+		expected []string
+		wantErr  bool
+	}{
+		"Empty": {
+			content:  "",
+			expected: nil,
+			wantErr:  false,
+		},
+		"RequiredTags": {
+			content:  "required-tags:\n  - environment\n  - owner\n",
+			expected: []string{"environment", "owner"},
+			wantErr:  false,
+		},
+		"Invalid": {
+			content:  "required-tags: {",
+			expected: nil,
+			wantErr:  true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual, err := parseTagPolicy([]byte(tt.content))
+			if tt.wantErr {
+				assert.NotNil(err)
+			} else {
+				assert.Nil(err)
+				assert.Equal(tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCheckTags(t *testing.T) {
+	required := []string{"environment", "owner", "cost-centre"}
+	tests := map[string]struct {
+		tags     string
+		expected *TagCompliance
+	}{
+		"Missing": {
+			tags:     "",
+			expected: &TagCompliance{Status: TagsCheckManually},
+		},
+		"Compliant": {
+			tags:     `{ environment = "prod", owner = var.owner, "cost-centre" = "42", extra = "x" }`,
+			expected: &TagCompliance{Status: TagsCompliant},
+		},
+		"NonCompliant": {
+			tags:     `{ environment = "prod" }`,
+			expected: &TagCompliance{Status: TagsNonCompliant, Missing: []string{"owner", "cost-centre"}},
+		},
+		"Variable": {
+			tags:     `var.tags`,
+			expected: &TagCompliance{Status: TagsCheckManually},
+		},
+		"Function": {
+			tags:     `merge(var.tags, { owner = "team" })`,
+			expected: &TagCompliance{Status: TagsCheckManually},
+		},
+		"NonLiteralKey": {
+			tags:     `{ (var.key) = "value" }`,
+			expected: &TagCompliance{Status: TagsCheckManually},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			var attr *hcl.Attribute
+			if tt.tags != "" {
+				expr, diags := hclsyntax.ParseExpression([]byte(tt.tags), "main.tf", hcl.InitialPos)
+				assert.False(diags.HasErrors())
+				attr = &hcl.Attribute{Name: "tags", Expr: expr}
+			}
+
+			assert.Equal(tt.expected, checkTags(attr, required))
+		})
+	}
+}
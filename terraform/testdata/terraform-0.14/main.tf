@@ -0,0 +1,27 @@
+terraform {
+  required_version = "~> 0.14.0"
+
+  required_providers {
+    null = {
+      source  = "hashicorp/null"
+      version = "3.0.0"
+    }
+  }
+}
+
+variable "name" {
+  description = "Name of the resource"
+  type        = string
+  sensitive   = true
+}
+
+resource "null_resource" "foo" {
+  triggers = {
+    name = var.name
+  }
+}
+
+output "id" {
+  description = "ID of the resource"
+  value       = null_resource.foo.id
+}
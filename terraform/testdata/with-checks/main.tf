@@ -0,0 +1,31 @@
+variable "bucket" {
+  type = string
+}
+
+variable "replicas" {
+  type    = number
+  default = 1
+}
+
+resource "null_resource" "foo" {}
+
+check "bucket_name" {
+  assert {
+    condition     = can(regex("^[a-z0-9.-]{3,63}$", var.bucket))
+    error_message = "Bucket name must be a valid S3 bucket name."
+  }
+}
+
+check "replicas" {
+  assert {
+    condition     = var.replicas >= 1 && var.replicas <= 5
+    error_message = "Replicas must be between 1 and 5, got ${var.replicas}."
+  }
+
+  assert {
+    condition = (
+      var.replicas % 2 == 1
+    )
+    error_message = "Replicas must be odd."
+  }
+}
@@ -0,0 +1,30 @@
+terraform {
+  required_version = ">= 1.10"
+
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "5.80.0"
+    }
+    random = {
+      source = "hashicorp/random"
+    }
+  }
+}
+
+provider "aws" {
+  alias  = "west"
+  region = "us-west-2"
+}
+
+# Password of the database
+ephemeral "random_password" "db" {
+  length = 16
+}
+
+ephemeral "aws_secretsmanager_secret_version" "db" {
+  provider  = aws.west
+  secret_id = "db-password"
+}
+
+resource "aws_instance" "foo" {}
@@ -0,0 +1,36 @@
+variable "db_password" {
+  description = "password of the database"
+  sensitive   = true
+}
+
+variable "db_name" {
+  description = "name of the database"
+  default     = "app"
+}
+
+variable "api_token" {
+  description = "token of the API"
+  sensitive   = true
+}
+
+variable "region" {
+  description = "region of the resources"
+  default     = "eu-west-1"
+}
+
+output "db_password" {
+  description = "password of the database"
+  value       = var.db_password
+  sensitive   = true
+}
+
+output "db_endpoint" {
+  description = "endpoint of the database"
+  value       = "db.example.com"
+}
+
+output "private_key" {
+  description = "private key of the instance"
+  value       = "key"
+  sensitive   = true
+}
@@ -0,0 +1,23 @@
+variable "password" {
+  type      = string
+  sensitive = true
+}
+
+variable "name" {
+  type     = string
+  nullable = false
+}
+
+variable "size" {
+  type    = number
+  default = 1
+}
+
+output "password" {
+  value     = var.password
+  sensitive = true
+}
+
+output "name" {
+  value = var.name
+}
@@ -0,0 +1,28 @@
+variable "name" {
+  type = string
+
+  validation {
+    condition     = length(var.name) > 0
+    error_message = "The name must not be empty."
+  }
+
+  validation {
+    condition     = can(regex("^[a-z]+$", var.name))
+    error_message = "The name of ${var.name} must be lowercase."
+  }
+}
+
+variable "size" {
+  type    = number
+  default = 1
+
+  validation {
+    condition     = var.size > 0
+    error_message = "The size must be positive."
+  }
+}
+
+variable "none" {
+  type    = string
+  default = "bar"
+}
@@ -0,0 +1,9 @@
+terraform {
+  required_version = ">= 1.5"
+}
+
+check "health" {
+  assert {
+    error_message = "The name must not be empty."
+  }
+}
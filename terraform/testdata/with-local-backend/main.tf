@@ -0,0 +1,9 @@
+terraform {
+  backend "local" {
+    path = "terraform.tfstate"
+  }
+}
+
+variable "name" {
+  type = string
+}
@@ -0,0 +1,12 @@
+module "network" {
+  source = "./modules/network"
+}
+
+module "registry" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "~> 5.0"
+}
+
+module "missing" {
+  source = "./modules/missing"
+}
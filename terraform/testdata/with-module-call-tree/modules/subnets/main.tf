@@ -0,0 +1,3 @@
+variable "cidr_blocks" {
+  type = list(string)
+}
@@ -0,0 +1,7 @@
+module "subnets" {
+  source = "../subnets"
+}
+
+module "network" {
+  source = "../network"
+}
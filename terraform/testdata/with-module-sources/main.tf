@@ -0,0 +1,16 @@
+module "registry" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "~> 5.0"
+}
+
+module "git" {
+  source = "git::https://example.com/vpc.git?ref=v1.2.0"
+}
+
+module "local" {
+  source = "./modules/local"
+}
+
+module "gruntwork" {
+  source = "git::git@github.com:gruntwork-io/terraform-aws-vpc.git//modules/vpc-app?ref=v0.26.0"
+}
@@ -0,0 +1,17 @@
+resource "null_resource" "bar" {
+  count = 2
+}
+
+module "baz" {
+  source = "./baz"
+}
+
+moved {
+  from = null_resource.foo
+  to   = null_resource.bar[0]
+}
+
+moved {
+  from = module.foo["key"]
+  to   = module.baz
+}
@@ -0,0 +1,4 @@
+moved {
+  from = module.baz.null_resource.qux
+  to   = module.baz.null_resource.quux["a"]
+}
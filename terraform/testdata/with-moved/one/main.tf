@@ -0,0 +1,6 @@
+resource "null_resource" "bar" {}
+
+moved {
+  from = null_resource.foo
+  to   = null_resource.bar
+}
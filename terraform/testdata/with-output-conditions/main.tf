@@ -0,0 +1,35 @@
+variable "name" {
+  type = string
+}
+
+resource "null_resource" "foo" {}
+
+output "id" {
+  description = "The id of the resource."
+  value       = null_resource.foo.id
+
+  lifecycle {
+    precondition {
+      condition     = length(var.name) > 0
+      error_message = "The name must not be empty."
+    }
+
+    postcondition {
+      condition     = self != null
+      error_message = "The id of ${var.name} must be known."
+    }
+  }
+}
+
+output "name" {
+  value = var.name
+
+  precondition {
+    condition     = var.name != "foo"
+    error_message = "The name can't be foo."
+  }
+}
+
+output "none" {
+  value = "bar"
+}
@@ -0,0 +1,9 @@
+module "network" {
+  source = "../vpc"
+}
+
+resource "null_resource" "app" {
+  triggers = {
+    vpc_id = module.network.vpc_id
+  }
+}
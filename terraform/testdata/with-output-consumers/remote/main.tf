@@ -0,0 +1,7 @@
+module "vpc" {
+  source = "terraform-aws-modules/vpc/aws"
+}
+
+output "unused" {
+  value = module.vpc.unused
+}
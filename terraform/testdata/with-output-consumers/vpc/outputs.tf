@@ -0,0 +1,14 @@
+output "vpc_id" {
+  description = "ID of the VPC."
+  value       = "vpc-0123"
+}
+
+output "subnet_ids" {
+  description = "IDs of the subnets."
+  value       = ["subnet-1", "subnet-2"]
+}
+
+output "unused" {
+  description = "Not consumed by any module."
+  value       = true
+}
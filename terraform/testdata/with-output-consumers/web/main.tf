@@ -0,0 +1,12 @@
+module "vpc" {
+  source = "../vpc"
+  count  = 1
+}
+
+locals {
+  subnets = join(",", module.vpc[0].subnet_ids)
+}
+
+output "vpc_id" {
+  value = module.vpc[0].vpc_id
+}
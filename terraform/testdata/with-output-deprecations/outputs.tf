@@ -0,0 +1,21 @@
+# @deprecated Use module.new_module.endpoint instead
+output "old_endpoint" {
+  value = "https://old.example.com"
+}
+
+# The name of the bucket.
+# @deprecated
+output "old_bucket" {
+  value = "old-bucket"
+}
+
+# The current endpoint.
+output "endpoint" {
+  value = "https://example.com"
+}
+
+# @deprecated Will be removed.
+output "described" {
+  description = "Described in the block itself."
+  value       = "foo"
+}
@@ -0,0 +1,29 @@
+output "name" {
+  value = "foo"
+}
+
+output "port" {
+  value = 8080
+}
+
+output "enabled" {
+  value = true
+}
+
+output "url" {
+  value = "https://${var.domain}/"
+}
+
+output "zones" {
+  value = ["a", "b"]
+}
+
+output "tags" {
+  value = {
+    Name = "foo"
+  }
+}
+
+output "id" {
+  value = aws_instance.this.id
+}
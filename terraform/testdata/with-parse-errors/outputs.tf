@@ -0,0 +1,7 @@
+output "name" {
+  value = var.name
+}
+
+output "bar" {
+  value = var.bar
+}}
@@ -0,0 +1,7 @@
+variable "name" {
+  default = "foo"
+}
+
+variable "bar" {
+  default = 
+}
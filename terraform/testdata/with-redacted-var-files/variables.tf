@@ -0,0 +1,23 @@
+variable "region" {
+  description = "Region to deploy into."
+  default     = "us-east-1"
+}
+
+variable "db_password" {
+  description = "Password of the database."
+  default     = "changeme"
+}
+
+variable "license" {
+  description = "License of the product."
+  default     = ""
+}
+
+variable "api_token" {
+  description = "Token of the API."
+}
+
+variable "admin_email" {
+  description = "Email of the administrator."
+  sensitive   = true
+}
@@ -0,0 +1,13 @@
+terraform {
+  required_version = ">= 1.0"
+
+  backend "s3" {
+    region         = "eu-west-1"
+    dynamodb_table = "terraform-locks"
+    encrypt        = true
+  }
+}
+
+variable "name" {
+  type = string
+}
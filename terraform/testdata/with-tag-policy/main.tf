@@ -0,0 +1,30 @@
+resource "aws_s3_bucket" "compliant" {
+  bucket = "compliant"
+
+  tags = {
+    environment = "prod"
+    owner       = "platform"
+  }
+}
+
+resource "aws_s3_bucket" "non_compliant" {
+  bucket = "non-compliant"
+
+  tags = {
+    environment = "prod"
+  }
+}
+
+resource "aws_s3_bucket" "derived" {
+  bucket = "derived"
+  tags   = var.tags
+}
+
+resource "null_resource" "untagged" {}
+
+data "aws_caller_identity" "current" {}
+
+variable "tags" {
+  type    = map(string)
+  default = {}
+}
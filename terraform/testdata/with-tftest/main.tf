@@ -0,0 +1,12 @@
+variable "name" {
+  type = string
+}
+
+variable "tags" {
+  type    = map(string)
+  default = {}
+}
+
+output "name" {
+  value = var.name
+}
@@ -0,0 +1,30 @@
+variables {
+  name = "global"
+}
+
+run "defaults" {
+  assert {
+    condition     = output.name == "global"
+    error_message = "Name didn't match."
+  }
+}
+
+run "with_tags" {
+  variables {
+    tags = {
+      Name = "foo"
+      Env  = "dev"
+    }
+    name = "foo"
+  }
+
+  assert {
+    condition     = output.name == "foo"
+    error_message = "Name must be ${var.name}."
+  }
+
+  assert {
+    condition     = length(var.tags) == 2
+    error_message = "Tags count didn't match."
+  }
+}
@@ -0,0 +1,18 @@
+variable "region" {
+  description = "Region to deploy into."
+  default     = "us-east-1"
+}
+
+variable "instance_count" {
+  description = "Number of instances."
+  default     = 1
+}
+
+variable "tags" {
+  description = "Tags of the resources."
+  default     = {}
+}
+
+variable "name" {
+  description = "Name of the deployment."
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// Statuses of the tests reported by 'terraform test -json'.
+const (
+	TestStatusPass  = "pass"
+	TestStatusFail  = "fail"
+	TestStatusError = "error"
+	TestStatusSkip  = "skip"
+)
+
+// TestSuite represents the results of 'run' blocks of a Terraform test file
+// reported by 'terraform test -json'. Only the names and statuses of the tests
+// are kept, their diagnostics and outputs are never included.
+type TestSuite struct {
+	Path    string        `json:"path" toml:"path" xml:"path" yaml:"path"`
+	Passed  int           `json:"passed" toml:"passed" xml:"passed" yaml:"passed"`
+	Failed  int           `json:"failed" toml:"failed" xml:"failed" yaml:"failed"`
+	Skipped int           `json:"skipped" toml:"skipped" xml:"skipped" yaml:"skipped"`
+	Tests   []*TestResult `json:"tests" toml:"tests" xml:"tests>test" yaml:"tests"`
+}
+
+// TestResult represents the result of a 'run' block of a Terraform test file.
+type TestResult struct {
+	Name   string `json:"name" toml:"name" xml:"name" yaml:"name"`
+	Status string `json:"status" toml:"status" xml:"status" yaml:"status"`
+}
+
+// parseTestResults reads the output of 'terraform test -json', which is one
+// JSON message per line, and returns the results of the tests grouped by their
+// test file. The last reported status of each test wins, and errored tests are
+// counted as failed. Messages other than 'test_run' are ignored.
+func parseTestResults(content []byte) ([]*TestSuite, error) {
+	type message struct {
+		Type    string `json:"type"`
+		TestRun *struct {
+			Path   string `json:"path"`
+			Run    string `json:"run"`
+			Status string `json:"status"`
+		} `json:"test_run"`
+	}
+
+	suites := make(map[string]*TestSuite)
+	results := make(map[string]map[string]*TestResult)
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var m message
+		if err := json.Unmarshal(line, &m); err != nil {
+			return nil, fmt.Errorf("unable to decode test results, %w", err)
+		}
+		if m.Type != "test_run" || m.TestRun == nil || m.TestRun.Status == "" || m.TestRun.Status == "pending" {
+			continue
+		}
+		if _, ok := suites[m.TestRun.Path]; !ok {
+			suites[m.TestRun.Path] = &TestSuite{Path: m.TestRun.Path, Tests: make([]*TestResult, 0)}
+			results[m.TestRun.Path] = make(map[string]*TestResult)
+		}
+		result, ok := results[m.TestRun.Path][m.TestRun.Run]
+		if !ok {
+			result = &TestResult{Name: m.TestRun.Run}
+			results[m.TestRun.Path][m.TestRun.Run] = result
+			suites[m.TestRun.Path].Tests = append(suites[m.TestRun.Path].Tests, result)
+		}
+		result.Status = m.TestRun.Status
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read test results, %w", err)
+	}
+
+	items := make([]*TestSuite, 0, len(suites))
+	for _, suite := range suites {
+		for _, test := range suite.Tests {
+			switch test.Status {
+			case TestStatusPass:
+				suite.Passed++
+			case TestStatusFail, TestStatusError:
+				suite.Failed++
+			case TestStatusSkip:
+				suite.Skipped++
+			}
+		}
+		items = append(items, suite)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Path < items[j].Path
+	})
+
+	return items, nil
+}
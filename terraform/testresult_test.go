@@ -0,0 +1,83 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTestResults(t *testing.T) {
+	tests := map[string]struct {
+		content  string
+		expected []*TestSuite
+		wantErr  bool
+	}{
+		"Empty": {
+			content:  "",
+			expected: []*TestSuite{},
+			wantErr:  false,
+		},
+		"Results": {
+			content: `{"@level":"info","@message":"Found 2 files and 4 run blocks","type":"test_abstract","test_abstract":{"tests/main.tftest.hcl":["valid","invalid"],"tests/other.tftest.hcl":["skipped","errored"]}}
+{"@level":"info","@message":"tests/main.tftest.hcl... in progress","type":"test_file","test_file":{"path":"tests/main.tftest.hcl","progress":"starting"}}
+{"@level":"info","@message":"  \"valid\"... in progress","type":"test_run","test_run":{"path":"tests/main.tftest.hcl","run":"valid","progress":"starting"}}
+{"@level":"info","@message":"  \"valid\"... pass","type":"test_run","test_run":{"path":"tests/main.tftest.hcl","run":"valid","progress":"complete","status":"pass"}}
+{"@level":"error","@message":"Error: Test assertion failed","type":"diagnostic","diagnostic":{"severity":"error","summary":"Test assertion failed","detail":"password is secret"}}
+{"@level":"info","@message":"  \"invalid\"... fail","type":"test_run","test_run":{"path":"tests/main.tftest.hcl","run":"invalid","progress":"complete","status":"fail"}}
+{"@level":"info","@message":"  \"skipped\"... skip","type":"test_run","test_run":{"path":"tests/other.tftest.hcl","run":"skipped","progress":"complete","status":"skip"}}
+{"@level":"info","@message":"  \"errored\"... pending","type":"test_run","test_run":{"path":"tests/other.tftest.hcl","run":"errored","status":"pending"}}
+{"@level":"info","@message":"  \"errored\"... fail","type":"test_run","test_run":{"path":"tests/other.tftest.hcl","run":"errored","progress":"complete","status":"error"}}
+{"@level":"info","@message":"Failure! 1 passed, 2 failed, 1 skipped.","type":"test_summary","test_summary":{"status":"fail","passed":1,"failed":1,"errored":1,"skipped":1}}
+`,
+			expected: []*TestSuite{
+				{
+					Path:   "tests/main.tftest.hcl",
+					Passed: 1,
+					Failed: 1,
+					Tests: []*TestResult{
+						{Name: "valid", Status: TestStatusPass},
+						{Name: "invalid", Status: TestStatusFail},
+					},
+				},
+				{
+					Path:    "tests/other.tftest.hcl",
+					Failed:  1,
+					Skipped: 1,
+					Tests: []*TestResult{
+						{Name: "skipped", Status: TestStatusSkip},
+						{Name: "errored", Status: TestStatusError},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"Invalid": {
+			content:  "Error: Failed to load plugin schemas",
+			expected: nil,
+			wantErr:  true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual, err := parseTestResults([]byte(tt.content))
+			if tt.wantErr {
+				assert.NotNil(err)
+			} else {
+				assert.Nil(err)
+				assert.Equal(tt.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+// TestRun represents a 'run' block of Terraform test files (i.e. 'tests/*.tftest.hcl'
+// available in Terraform 1.6+), which is rendered as an usage example of the module.
+type TestRun struct {
+	Name       string           `json:"name" toml:"name" xml:"name" yaml:"name"`
+	Filename   string           `json:"filename" toml:"filename" xml:"filename" yaml:"filename"`
+	Variables  []*TestVariable  `json:"variables" toml:"variables" xml:"variables>variable" yaml:"variables"`
+	Assertions []*TestAssertion `json:"assertions" toml:"assertions" xml:"assertions>assertion" yaml:"assertions"`
+}
+
+// TestVariable represents an assignment of the 'variables' block of a 'run' block.
+// Value is the expression as is, it's not evaluated.
+type TestVariable struct {
+	Name  string       `json:"name" toml:"name" xml:"name" yaml:"name"`
+	Value types.String `json:"value" toml:"value" xml:"value" yaml:"value"`
+}
+
+// TestAssertion represents an 'assert' block of a 'run' block.
+type TestAssertion struct {
+	Condition    types.String `json:"condition" toml:"condition" xml:"condition" yaml:"condition"`
+	ErrorMessage types.String `json:"error_message" toml:"error_message" xml:"error_message" yaml:"error_message"`
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+// parseVarFile returns the values of the variables assigned in the tfvars file,
+// either in HCL (e.g. 'prod.tfvars') or JSON (e.g. 'prod.tfvars.json') syntax.
+// Only the values which can be evaluated without any context are supported, as
+// Terraform itself does.
+func parseVarFile(filename string) (map[string]types.Value, error) {
+	parser := hclparse.NewParser()
+
+	var file *hcl.File
+	var diags hcl.Diagnostics
+	if strings.HasSuffix(filename, ".json") {
+		file, diags = parser.ParseJSONFile(filename)
+	} else {
+		file, diags = parser.ParseHCLFile(filename)
+	}
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	attrs, diags := file.Body.JustAttributes()
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	values := make(map[string]types.Value, len(attrs))
+	for name, attr := range attrs {
+		value, diags := attr.Expr.Value(nil)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+
+		content, err := ctyjson.SimpleJSONValue{Value: value}.MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert value of '%s', %w", name, err)
+		}
+
+		var raw interface{}
+		if err := json.Unmarshal(content, &raw); err != nil {
+			return nil, fmt.Errorf("unable to convert value of '%s', %w", name, err)
+		}
+		values[name] = types.ValueOf(raw)
+	}
+
+	return values, nil
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+// VariableSummary represents the counts of inputs of Terraform module, as a
+// quick overview right before the full list of them.
+type VariableSummary struct {
+	Total      int `json:"total" toml:"total" xml:"total" yaml:"total"`
+	Required   int `json:"required" toml:"required" xml:"required" yaml:"required"`
+	Optional   int `json:"optional" toml:"optional" xml:"optional" yaml:"optional"`
+	Sensitive  int `json:"sensitive" toml:"sensitive" xml:"sensitive" yaml:"sensitive"`
+	Deprecated int `json:"deprecated" toml:"deprecated" xml:"deprecated" yaml:"deprecated"`
+}
+
+// NewVariableSummary returns VariableSummary of the given inputs, with the names
+// of the variables declared with 'sensitive = true' in 'sensitive'.
+func NewVariableSummary(inputs []*Input, sensitive map[string]bool) *VariableSummary {
+	summary := &VariableSummary{
+		Total: len(inputs),
+	}
+	for _, input := range inputs {
+		if input.Required {
+			summary.Required++
+		} else {
+			summary.Optional++
+		}
+		if sensitive[input.Name] {
+			summary.Sensitive++
+		}
+		if input.Deprecated != "" {
+			summary.Deprecated++
+		}
+	}
+	return summary
+}
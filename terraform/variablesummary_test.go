@@ -0,0 +1,52 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewVariableSummary(t *testing.T) {
+	tests := map[string]struct {
+		inputs    []*Input
+		sensitive map[string]bool
+		expected  *VariableSummary
+	}{
+		"NoInputs": {
+			inputs:    []*Input{},
+			sensitive: map[string]bool{},
+			expected:  &VariableSummary{},
+		},
+		"Inputs": {
+			inputs: []*Input{
+				{Name: "a", Required: true},
+				{Name: "b", Required: true, Deprecated: "use 'a' instead"},
+				{Name: "c", Required: false},
+			},
+			sensitive: map[string]bool{"a": true, "c": false, "d": true},
+			expected: &VariableSummary{
+				Total:      3,
+				Required:   2,
+				Optional:   1,
+				Sensitive:  1,
+				Deprecated: 1,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, NewVariableSummary(tt.inputs, tt.sensitive))
+		})
+	}
+}
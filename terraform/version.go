@@ -0,0 +1,41 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	goversion "github.com/hashicorp/go-version"
+
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+// Versions of Terraform which the blocks parsed by terraform-docs itself (i.e.
+// not by terraform-config-inspect) are introduced in.
+const (
+	movedBlocksSince        = "1.1.0"
+	outputConditionsSince   = "1.2.0"
+	checkBlocksSince        = "1.5.0"
+	ephemeralResourcesSince = "1.10.0"
+)
+
+// supportsFeature indicates if the target version of Terraform of the module
+// (i.e. 'terraform-version') supports the feature introduced in 'since'. All
+// the features are supported if the target version is not set, so modules are
+// parsed with all the known features by default.
+func supportsFeature(config *print.Config, since string) bool {
+	if config.TerraformVersion == "" {
+		return true
+	}
+	target, err := goversion.NewSemver(config.TerraformVersion)
+	if err != nil {
+		return true
+	}
+	return target.Core().GreaterThanOrEqual(goversion.Must(goversion.NewSemver(since)))
+}
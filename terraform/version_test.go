@@ -0,0 +1,68 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+func TestSupportsFeature(t *testing.T) {
+	tests := map[string]struct {
+		version  string
+		since    string
+		expected bool
+	}{
+		"NotSet": {
+			version:  "",
+			since:    ephemeralResourcesSince,
+			expected: true,
+		},
+		"Older": {
+			version:  "0.14",
+			since:    checkBlocksSince,
+			expected: false,
+		},
+		"Same": {
+			version:  "1.5.0",
+			since:    checkBlocksSince,
+			expected: true,
+		},
+		"Newer": {
+			version:  "1.10.2",
+			since:    ephemeralResourcesSince,
+			expected: true,
+		},
+		"PreRelease": {
+			version:  "1.10.0-beta1",
+			since:    ephemeralResourcesSince,
+			expected: true,
+		},
+		"MinorOlder": {
+			version:  "1.9.8",
+			since:    ephemeralResourcesSince,
+			expected: false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.TerraformVersion = tt.version
+
+			assert.Equal(tt.expected, supportsFeature(config, tt.since))
+		})
+	}
+}